@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	stripe "github.com/stripe/stripe-go/v72"
 	"github.com/textileio/go-threads/core/thread"
@@ -39,6 +40,19 @@ func (c *Client) CheckHealth(ctx context.Context) error {
 	return err
 }
 
+// Now returns billingd's current time.
+// A zero time is returned if billingd doesn't report it.
+func (c *Client) Now(ctx context.Context) (time.Time, error) {
+	res, err := c.c.CheckHealth(ctx, &pb.CheckHealthRequest{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if res.UnixNano == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, res.UnixNano), nil
+}
+
 func (c *Client) CreateCustomer(
 	ctx context.Context,
 	key thread.PubKey,
@@ -156,13 +170,50 @@ func (c *Client) IncCustomerUsage(
 	ctx context.Context,
 	key thread.PubKey,
 	productUsage map[string]int64,
+	opts ...UsageOption,
 ) (*pb.IncCustomerUsageResponse, error) {
+	args := &UsageOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
 	return c.c.IncCustomerUsage(ctx, &pb.IncCustomerUsageRequest{
+		Key:            key.String(),
+		ProductUsage:   productUsage,
+		IdempotencyKey: args.IdempotencyKey,
+		Labels:         args.Labels,
+		BucketKey:      args.BucketKey,
+	})
+}
+
+// SetCustomerUsage overwrites the totals of the given products, e.g., to correct stored_data after data corruption.
+// The difference from the current totals is recorded like an increment.
+func (c *Client) SetCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+) (*pb.SetCustomerUsageResponse, error) {
+	return c.c.SetCustomerUsage(ctx, &pb.SetCustomerUsageRequest{
 		Key:          key.String(),
 		ProductUsage: productUsage,
 	})
 }
 
+// GetCustomerUsageHistory returns a time-ordered series of usage increments for each product
+// recorded between periodStart and periodEnd. The current invoice period is used if both are zero.
+func (c *Client) GetCustomerUsageHistory(
+	ctx context.Context,
+	key thread.PubKey,
+	periodStart, periodEnd int64,
+) (*pb.GetCustomerUsageHistoryResponse, error) {
+	return c.c.GetCustomerUsageHistory(ctx, &pb.GetCustomerUsageHistoryRequest{
+		Key: key.String(),
+		Period: &pb.Period{
+			UnixStart: periodStart,
+			UnixEnd:   periodEnd,
+		},
+	})
+}
+
 func (c *Client) ReportCustomerUsage(ctx context.Context, key thread.PubKey) error {
 	_, err := c.c.ReportCustomerUsage(ctx, &pb.ReportCustomerUsageRequest{
 		Key: key.String(),
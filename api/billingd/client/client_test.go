@@ -332,6 +332,171 @@ func incCustomerUsage(t *testing.T, test usageTest) {
 	assert.Equal(t, test.unitPrice*2, cus.DailyUsage[test.key].Cost)
 }
 
+func TestClient_IncCustomerUsageIdempotent(t *testing.T) {
+	t.Parallel()
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	res, err := c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib}, client.WithIdempotencyKey("event-1"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(mib), res.DailyUsage["stored_data"].Total)
+
+	// Replaying the same event is ignored
+	res, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib}, client.WithIdempotencyKey("event-1"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(mib), res.DailyUsage["stored_data"].Total)
+
+	// A new event is applied
+	res, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib}, client.WithIdempotencyKey("event-2"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*mib), res.DailyUsage["stored_data"].Total)
+}
+
+func TestClient_IncCustomerUsagePartial(t *testing.T) {
+	t.Parallel()
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	// Usage beyond the free quota fails to apply, but the rest is applied.
+	product := getProduct(t, "stored_data")
+	res, err := c.IncCustomerUsage(context.Background(), key, map[string]int64{
+		"stored_data":    product.FreeQuotaSize + 1,
+		"network_egress": mib,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, res.FailedUsage, "stored_data")
+	assert.Equal(t, int64(mib), res.DailyUsage["network_egress"].Total)
+
+	// Usage that fails to apply entirely is an error.
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": product.FreeQuotaSize + 1})
+	require.Error(t, err)
+}
+
+func TestClient_SetCustomerUsage(t *testing.T) {
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": 5 * mib})
+	require.NoError(t, err)
+
+	// Correct an inflated total
+	res, err := c.SetCustomerUsage(context.Background(), key, map[string]int64{"stored_data": 2 * mib})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*mib), res.DailyUsage["stored_data"].Total)
+	cus, err := c.GetCustomer(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*mib), cus.DailyUsage["stored_data"].Total)
+
+	// Setting the current total is a no-op
+	_, err = c.SetCustomerUsage(context.Background(), key, map[string]int64{"stored_data": 2 * mib})
+	require.NoError(t, err)
+	cus, err = c.GetCustomer(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*mib), cus.DailyUsage["stored_data"].Total)
+
+	_, err = c.SetCustomerUsage(context.Background(), key, map[string]int64{"stored_data": -1})
+	require.Error(t, err)
+}
+
+func TestClient_GetCustomerUsageHistory(t *testing.T) {
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	start := time.Now().Unix()
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib})
+	require.NoError(t, err)
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": 2 * mib, "instance_reads": 10})
+	require.NoError(t, err)
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": -mib})
+	require.NoError(t, err)
+
+	res, err := c.GetCustomerUsageHistory(context.Background(), key, start, time.Now().Add(time.Minute).Unix())
+	require.NoError(t, err)
+	require.Len(t, res.Usage, 2)
+
+	stored := res.Usage["stored_data"].Records
+	require.Len(t, stored, 3)
+	assert.Equal(t, int64(mib), stored[0].Inc)
+	assert.Equal(t, int64(mib), stored[0].Total)
+	assert.Equal(t, int64(2*mib), stored[1].Inc)
+	assert.Equal(t, int64(3*mib), stored[1].Total)
+	assert.Equal(t, int64(-mib), stored[2].Inc)
+	assert.Equal(t, int64(2*mib), stored[2].Total)
+	for i := 1; i < len(stored); i++ {
+		assert.GreaterOrEqual(t, stored[i].UnixTime, stored[i-1].UnixTime)
+	}
+
+	reads := res.Usage["instance_reads"].Records
+	require.Len(t, reads, 1)
+	assert.Equal(t, int64(10), reads[0].Total)
+
+	// Nothing was recorded before the window
+	res, err = c.GetCustomerUsageHistory(context.Background(), key, start-3600, start)
+	require.NoError(t, err)
+	assert.Empty(t, res.Usage)
+}
+
+func TestClient_IncCustomerUsageLabels(t *testing.T) {
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	start := time.Now().Unix()
+	labels := map[string]string{"region": "us-east-1", "environment": "production"}
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib}, client.WithLabels(labels))
+	require.NoError(t, err)
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib})
+	require.NoError(t, err)
+
+	res, err := c.GetCustomerUsageHistory(context.Background(), key, start, time.Now().Add(time.Minute).Unix())
+	require.NoError(t, err)
+	stored := res.Usage["stored_data"].Records
+	require.Len(t, stored, 2)
+	assert.Equal(t, labels, stored[0].Labels)
+	assert.Empty(t, stored[1].Labels)
+}
+
+func TestClient_IncCustomerUsageBucketKey(t *testing.T) {
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	start := time.Now().Unix()
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": 2 * mib}, client.WithBucketKey("a"))
+	require.NoError(t, err)
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": -mib}, client.WithBucketKey("a"))
+	require.NoError(t, err)
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib}, client.WithBucketKey("b"))
+	require.NoError(t, err)
+	_, err = c.IncCustomerUsage(context.Background(), key, map[string]int64{"stored_data": mib})
+	require.NoError(t, err)
+
+	cus, err := c.GetCustomer(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3*mib), cus.DailyUsage["stored_data"].Total)
+	require.Len(t, cus.BucketUsage, 2)
+	assert.Equal(t, int64(mib), cus.BucketUsage["a"].Totals["stored_data"])
+	assert.Equal(t, int64(mib), cus.BucketUsage["b"].Totals["stored_data"])
+
+	res, err := c.GetCustomerUsageHistory(context.Background(), key, start, time.Now().Add(time.Minute).Unix())
+	require.NoError(t, err)
+	stored := res.Usage["stored_data"].Records
+	require.Len(t, stored, 4)
+	assert.Equal(t, "a", stored[0].BucketKey)
+	assert.Equal(t, "b", stored[2].BucketKey)
+	assert.Empty(t, stored[3].BucketKey)
+}
+
 func getProduct(t *testing.T, key string) *service.Product {
 	for _, p := range service.Products {
 		if p.Key == key {
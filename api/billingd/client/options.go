@@ -22,6 +22,45 @@ func WithParent(key thread.PubKey, email string, accountType mdb.AccountType) Op
 	}
 }
 
+// UsageOptions are applied to usage reported with IncCustomerUsage.
+type UsageOptions struct {
+	IdempotencyKey string
+	Labels         map[string]string
+	BucketKey      string
+}
+
+type UsageOption func(*UsageOptions)
+
+// WithIdempotencyKey is used to report usage that billingd applies at most once,
+// i.e., usage reported again with the same key is ignored.
+func WithIdempotencyKey(key string) UsageOption {
+	return func(args *UsageOptions) {
+		args.IdempotencyKey = key
+	}
+}
+
+// WithLabels is used to tag usage, e.g., with the region it was reported from,
+// so that it can be split by label in usage history. Labels are merged with
+// those added by other options.
+func WithLabels(labels map[string]string) UsageOption {
+	return func(args *UsageOptions) {
+		if args.Labels == nil {
+			args.Labels = make(map[string]string)
+		}
+		for k, v := range labels {
+			args.Labels[k] = v
+		}
+	}
+}
+
+// WithBucketKey is used to attribute usage to a bucket, e.g., a stored_data delta,
+// so that billingd can keep per-bucket subtotals for the customer.
+func WithBucketKey(key string) UsageOption {
+	return func(args *UsageOptions) {
+		args.BucketKey = key
+	}
+}
+
 type listOptions struct {
 	offset int64
 	limit  int64
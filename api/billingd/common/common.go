@@ -19,13 +19,20 @@ var (
 
 	// ErrSubscriptionPaymentRequired indicates the subscription is in a terminal status as a result of failed payment.
 	ErrSubscriptionPaymentRequired = errors.New("subscription payment required")
+
+	// ErrInvalidEmail indicates a customer's email was rejected by the payment provider.
+	ErrInvalidEmail = errors.New("invalid email")
+
+	// ErrCustomerExists indicates a customer already exists.
+	ErrCustomerExists = errors.New("customer already exists")
 )
 
 // StatusCheck returns a non-nil error if the subscription status is considered healthy.
 func StatusCheck(status string) error {
 	switch stripe.SubscriptionStatus(status) {
 	case stripe.SubscriptionStatusActive,
-		stripe.SubscriptionStatusIncomplete:
+		stripe.SubscriptionStatusIncomplete,
+		stripe.SubscriptionStatusTrialing:
 		return nil
 	case stripe.SubscriptionStatusCanceled:
 		return ErrSubscriptionCanceled
@@ -29,6 +29,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/net/http2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -37,6 +39,9 @@ const (
 
 	reporterTimeout = time.Hour
 
+	// usageKeyTTL is how long usage idempotency keys are remembered.
+	usageKeyTTL = 24 * time.Hour
+
 	defaultPageSize = 25
 	maxPageSize     = 1000
 
@@ -50,7 +55,7 @@ var (
 	log = logging.Logger("billing")
 
 	// ErrCustomerExists indicates a customer already exists.
-	ErrCustomerExists = errors.New("customer already exists")
+	ErrCustomerExists = common.ErrCustomerExists
 )
 
 type Product struct {
@@ -126,6 +131,28 @@ var Products = []Product{
 		FreeQuotaInterval:        FreeQuotaDaily,
 		UnitSize:                 100,
 	},
+	// Cheap reads, e.g., existence checks, can be metered apart from full reads with a larger allowance.
+	{
+		Key:                      "instance_cheap_reads",
+		Name:                     "ThreadDB cheap reads",
+		Price:                    0.1 / 1000000,
+		PriceType:                PriceTypeIncremental,
+		FreeQuotaSize:            500000,
+		FreeQuotaGracePeriodSize: 10000000,
+		FreeQuotaInterval:        FreeQuotaDaily,
+		UnitSize:                 1000,
+	},
+	// Stored objects are counted so that the hub can cap them, but they're not billed.
+	{
+		Key:                      "object_count",
+		Name:                     "Stored objects",
+		PriceType:                PriceTypeTemporal,
+		FreeQuotaSize:            math.MaxInt64,
+		FreeQuotaGracePeriodSize: math.MaxInt64,
+		FreeQuotaInterval:        FreeQuotaMonthly,
+		Units:                    "objects",
+		UnitSize:                 1,
+	},
 }
 
 type Customer struct {
@@ -144,6 +171,25 @@ type Customer struct {
 	InvoicePeriod Period `bson:"invoice_period"`
 
 	DailyUsage map[string]Usage `bson:"daily_usage"`
+	// BucketUsage holds running subtotals of usage reported with a bucket key, keyed by bucket and product.
+	BucketUsage map[string]map[string]int64 `bson:"bucket_usage,omitempty"`
+}
+
+// UsageEvent records a single usage increment for a customer.
+type UsageEvent struct {
+	CustomerKey string            `bson:"customer_key"`
+	ProductKey  string            `bson:"product_key"`
+	Inc         int64             `bson:"inc"`
+	Total       int64             `bson:"total"`
+	Labels      map[string]string `bson:"labels,omitempty"`
+	BucketKey   string            `bson:"bucket_key,omitempty"`
+	CreatedAt   int64             `bson:"created_at"`
+}
+
+// UsageKey records an idempotency key that has been applied to a customer's usage.
+type UsageKey struct {
+	ID        string    `bson:"_id"`
+	CreatedAt time.Time `bson:"created_at"`
 }
 
 type Period struct {
@@ -181,6 +227,8 @@ type Service struct {
 
 	pdb *mongo.Collection
 	cdb *mongo.Collection
+	udb *mongo.Collection
+	kdb *mongo.Collection
 
 	products map[string]Product
 }
@@ -262,6 +310,23 @@ func NewService(ctx context.Context, config Config) (*Service, error) {
 	for _, index := range indexes {
 		log.Infof("created index: %s", index)
 	}
+	udb := db.Collection("usage_events")
+	index, err := udb.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"customer_key", 1}, {"created_at", 1}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("created index: %s", index)
+	kdb := db.Collection("usage_keys")
+	index, err = kdb.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"created_at", 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(usageKeyTTL.Seconds())),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("created index: %s", index)
 
 	s := &Service{
 		config:    config,
@@ -270,6 +335,8 @@ func NewService(ctx context.Context, config Config) (*Service, error) {
 		reporter:  cron.New(),
 		pdb:       pdb,
 		cdb:       cdb,
+		udb:       udb,
+		kdb:       kdb,
 		products:  make(map[string]Product),
 	}
 	s.gateway, err = gateway.NewGateway(gateway.Config{
@@ -444,7 +511,7 @@ func (s *Service) Stop() error {
 
 func (s *Service) CheckHealth(_ context.Context, _ *pb.CheckHealthRequest) (*pb.CheckHealthResponse, error) {
 	log.Debugf("health check okay")
-	return &pb.CheckHealthResponse{}, nil
+	return &pb.CheckHealthResponse{UnixNano: time.Now().UnixNano()}, nil
 }
 
 func (s *Service) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (
@@ -486,6 +553,10 @@ func (s *Service) createCustomer(
 		Email: stripe.String(params.Email),
 	})
 	if err != nil {
+		var serr *stripe.Error
+		if errors.As(err, &serr) && serr.Code == stripe.ErrorCodeEmailInvalid {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: %s", common.ErrInvalidEmail, params.Email)
+		}
 		return nil, err
 	}
 
@@ -570,9 +641,81 @@ func (s *Service) createSubscription(cus *Customer) error {
 	return nil
 }
 
+// backfillSubscription adds subscription items to a customer's subscription for products that were
+// added after it was created, e.g., instance_cheap_reads and object_count, so that their usage is recorded
+// and reported.
+// The caller must hold the customer's lock.
+func (s *Service) backfillSubscription(ctx context.Context, cus *Customer) error {
+	var missing []Product
+	for k, p := range s.products {
+		if _, ok := cus.DailyUsage[k]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) == 0 || cus.CustomerID == "" || common.StatusCheck(cus.SubscriptionStatus) != nil {
+		return nil
+	}
+	iter := s.stripe.Subscriptions.List(&stripe.SubscriptionListParams{
+		Customer: cus.CustomerID,
+	})
+	if !iter.Next() {
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("subscription not found for %s", cus.Key)
+	}
+	sub := iter.Subscription()
+	if cus.DailyUsage == nil {
+		cus.DailyUsage = make(map[string]Usage)
+	}
+	update := bson.M{}
+	for _, p := range missing {
+		free, err := s.stripe.SubscriptionItems.New(&stripe.SubscriptionItemParams{
+			Subscription: stripe.String(sub.ID),
+			Price:        stripe.String(p.FreePriceID),
+		})
+		if err != nil {
+			return err
+		}
+		paid, err := s.stripe.SubscriptionItems.New(&stripe.SubscriptionItemParams{
+			Subscription: stripe.String(sub.ID),
+			Price:        stripe.String(p.PaidPriceID),
+		})
+		if err != nil {
+			return err
+		}
+		u := Usage{FreeItemID: free.ID, PaidItemID: paid.ID}
+		cus.DailyUsage[p.Key] = u
+		update["daily_usage."+p.Key] = u
+	}
+	if _, err := s.cdb.UpdateOne(ctx, bson.M{"_id": cus.Key}, bson.M{"$set": update}); err != nil {
+		return err
+	}
+	log.Debugf("backfilled %d products for %s", len(missing), cus.Key)
+	return nil
+}
+
+// getBackfilledCustomer gets a customer whose subscription has been backfilled with new products.
+// A failure to backfill is logged, and the customer is returned without the new products.
+// The caller must hold the customer's lock.
+func (s *Service) getBackfilledCustomer(ctx context.Context, key string) (*Customer, error) {
+	cus, err := s.getCustomer(ctx, "_id", key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.backfillSubscription(ctx, cus); err != nil {
+		log.Errorf("backfilling subscription for %s: %v", key, err)
+	}
+	return cus, nil
+}
+
 func (s *Service) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest) (
 	*pb.GetCustomerResponse, error) {
-	doc, err := s.getCustomer(ctx, "_id", req.Key)
+	lck := s.semaphores.Get(customerLock(req.Key))
+	lck.Acquire()
+	defer lck.Release()
+
+	doc, err := s.getBackfilledCustomer(ctx, req.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -702,9 +845,21 @@ func (s *Service) customerToPb(ctx context.Context, doc *Customer) (*pb.GetCusto
 		InvoicePeriod:      periodToPb(doc.InvoicePeriod),
 		DailyUsage:         s.dailyUsageToPb(doc.DailyUsage),
 		Dependents:         deps,
+		BucketUsage:        bucketUsageToPb(doc.BucketUsage),
 	}, nil
 }
 
+func bucketUsageToPb(usage map[string]map[string]int64) map[string]*pb.BucketUsage {
+	if len(usage) == 0 {
+		return nil
+	}
+	res := make(map[string]*pb.BucketUsage)
+	for k, totals := range usage {
+		res[k] = &pb.BucketUsage{Totals: totals}
+	}
+	return res
+}
+
 func (s *Service) GetCustomerSession(ctx context.Context, req *pb.GetCustomerSessionRequest) (
 	*pb.GetCustomerSessionResponse, error) {
 	doc, err := s.getCustomer(ctx, "_id", req.Key)
@@ -871,6 +1026,9 @@ func (s *Service) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomerRequ
 	if _, err := s.cdb.DeleteOne(ctx, bson.M{"_id": req.Key}); err != nil {
 		return nil, err
 	}
+	if _, err := s.udb.DeleteMany(ctx, bson.M{"customer_key": req.Key}); err != nil {
+		return nil, err
+	}
 	log.Debugf("deleted customer %s", req.Key)
 	return &pb.DeleteCustomerResponse{}, nil
 }
@@ -935,6 +1093,43 @@ func (s *Service) IncCustomerUsage(
 	return s.handleCustomerUsage(ctx, req.Key, req)
 }
 
+// SetCustomerUsage overwrites the totals of the given products with the provided values.
+// The difference from the current totals is applied like an increment, i.e., it's recorded
+// in usage history and propagated to the customer's parent.
+func (s *Service) SetCustomerUsage(
+	ctx context.Context,
+	req *pb.SetCustomerUsageRequest,
+) (*pb.SetCustomerUsageResponse, error) {
+	lck := s.semaphores.Get(customerLock(req.Key))
+	lck.Acquire()
+	defer lck.Release()
+
+	cus, err := s.getBackfilledCustomer(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	inc := &pb.IncCustomerUsageRequest{
+		Key:          req.Key,
+		ProductUsage: make(map[string]int64),
+	}
+	for k, total := range req.ProductUsage {
+		if total < 0 {
+			return nil, fmt.Errorf("%s total must not be negative", k)
+		}
+		if usage, ok := cus.DailyUsage[k]; ok {
+			inc.ProductUsage[k] = total - usage.Total
+		}
+	}
+	res, err := s.incCustomerUsage(ctx, cus, inc)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.FailedUsage) > 0 {
+		return nil, fmt.Errorf("setting usage: %v", res.FailedUsage)
+	}
+	return &pb.SetCustomerUsageResponse{DailyUsage: res.DailyUsage}, nil
+}
+
 func (s *Service) handleCustomerUsage(
 	ctx context.Context,
 	key string,
@@ -944,40 +1139,111 @@ func (s *Service) handleCustomerUsage(
 	lck.Acquire()
 	defer lck.Release()
 
-	cus, err := s.getCustomer(ctx, "_id", key)
+	cus, err := s.getBackfilledCustomer(ctx, key)
 	if err != nil {
 		return nil, err
 	}
+	if req.IdempotencyKey == "" {
+		return s.incCustomerUsage(ctx, cus, req)
+	}
+	usageKey := key + "/" + req.IdempotencyKey
+	if err := s.kdb.FindOne(ctx, bson.M{"_id": usageKey}).Err(); err == nil {
+		log.Debugf("%s: usage with key %s was already applied", key, req.IdempotencyKey)
+		return s.currentUsage(cus, req), nil
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	res, err := s.incCustomerUsage(ctx, cus, req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.kdb.InsertOne(ctx, &UsageKey{ID: usageKey, CreatedAt: time.Now()}); err != nil {
+		log.Errorf("recording usage key for %s: %v", key, err)
+	}
+	return res, nil
+}
+
+// currentUsage returns a customer's current usage of the products in req without applying it.
+func (s *Service) currentUsage(cus *Customer, req *pb.IncCustomerUsageRequest) *pb.IncCustomerUsageResponse {
+	res := &pb.IncCustomerUsageResponse{
+		DailyUsage: make(map[string]*pb.Usage),
+	}
+	start, end := getCurrentDayBounds()
+	for k := range req.ProductUsage {
+		product, ok := s.products[k]
+		if !ok {
+			continue
+		}
+		if usage, ok := cus.DailyUsage[k]; ok {
+			res.DailyUsage[k] = getUsage(product, usage.Total, Period{UnixStart: start, UnixEnd: end})
+		}
+	}
+	return res
+}
+
+// incCustomerUsage applies usage increments to a customer and its parent.
+// Products are applied separately. If some fail to apply while others are applied, the failed products
+// are returned in the response's failed usage, so they can be retried on their own. If none are applied,
+// the first error is returned. The caller must hold the customer's lock.
+func (s *Service) incCustomerUsage(
+	ctx context.Context,
+	cus *Customer,
+	req *pb.IncCustomerUsageRequest,
+) (*pb.IncCustomerUsageResponse, error) {
 	if cus.ParentKey != "" {
-		if _, err := s.handleCustomerUsage(ctx, cus.ParentKey, req); err != nil {
+		pres, err := s.handleCustomerUsage(ctx, cus.ParentKey, req)
+		if err != nil {
 			return nil, err
 		}
+		for k, msg := range pres.FailedUsage {
+			log.Errorf("%s %s: applying usage to parent %s: %s", cus.Key, k, cus.ParentKey, msg)
+		}
 	}
 	if err := common.StatusCheck(cus.SubscriptionStatus); err != nil {
 		return nil, err
 	}
 
 	res := &pb.IncCustomerUsageResponse{
-		DailyUsage: make(map[string]*pb.Usage),
+		DailyUsage:  make(map[string]*pb.Usage),
+		FailedUsage: make(map[string]string),
 	}
+	var applied int
+	var firstErr error
 	for k, inc := range req.ProductUsage {
 		if product, ok := s.products[k]; ok && inc != 0 {
-			usage, err := s.handleUsage(ctx, cus, product, inc)
+			usage, err := s.handleUsage(ctx, cus, product, inc, req.Labels, req.BucketKey)
 			if err != nil {
-				return nil, err
+				if firstErr == nil {
+					firstErr = err
+				}
+				res.FailedUsage[k] = err.Error()
+				continue
 			}
+			applied++
 			if usage != nil {
 				log.Debugf("%s %s: total=%d free=%d", cus.Key, k, usage.Total, usage.Free)
 				res.DailyUsage[k] = usage
 			}
 		}
 	}
+	if firstErr != nil && applied == 0 {
+		return nil, firstErr
+	}
 	return res, nil
 }
 
-func (s *Service) handleUsage(ctx context.Context, cus *Customer, product Product, incSize int64) (*pb.Usage, error) {
+func (s *Service) handleUsage(
+	ctx context.Context,
+	cus *Customer,
+	product Product,
+	incSize int64,
+	labels map[string]string,
+	bucketKey string,
+) (*pb.Usage, error) {
 	usage, ok := cus.DailyUsage[product.Key]
 	if !ok {
+		// The subscription couldn't be backfilled with the product, see backfillSubscription.
+		log.Warnf("%s has no %s subscription item, dropping inc=%d", cus.Key, product.Key, incSize)
 		return nil, nil
 	}
 	total := usage.Total + incSize
@@ -1004,13 +1270,80 @@ func (s *Service) handleUsage(ctx context.Context, cus *Customer, product Produc
 			return nil, common.ErrExceedsFreeQuota
 		}
 	}
-	if _, err := s.cdb.UpdateOne(ctx, bson.M{"_id": cus.Key}, bson.M{"$set": update}); err != nil {
+	ops := bson.M{"$set": update}
+	if bucketKey != "" {
+		ops["$inc"] = bson.M{"bucket_usage." + bucketKey + "." + product.Key: incSize}
+	}
+	if _, err := s.cdb.UpdateOne(ctx, bson.M{"_id": cus.Key}, ops); err != nil {
 		return nil, err
 	}
+	if _, err := s.udb.InsertOne(ctx, &UsageEvent{
+		CustomerKey: cus.Key,
+		ProductKey:  product.Key,
+		Inc:         incSize,
+		Total:       total,
+		Labels:      labels,
+		BucketKey:   bucketKey,
+		CreatedAt:   time.Now().Unix(),
+	}); err != nil {
+		log.Errorf("recording usage event for %s: %v", cus.Key, err)
+	}
 	start, end := getCurrentDayBounds()
 	return getUsage(product, total, Period{UnixStart: start, UnixEnd: end}), nil
 }
 
+// GetCustomerUsageHistory returns a time-ordered series of recorded usage increments for each product.
+// The current invoice period is used if a period is not provided.
+func (s *Service) GetCustomerUsageHistory(
+	ctx context.Context,
+	req *pb.GetCustomerUsageHistoryRequest,
+) (*pb.GetCustomerUsageHistoryResponse, error) {
+	doc, err := s.getCustomer(ctx, "_id", req.Key)
+	if err != nil {
+		return nil, err
+	}
+	period := doc.InvoicePeriod
+	if req.Period != nil && (req.Period.UnixStart != 0 || req.Period.UnixEnd != 0) {
+		period = Period{UnixStart: req.Period.UnixStart, UnixEnd: req.Period.UnixEnd}
+	}
+	window := bson.M{"$gte": period.UnixStart}
+	if period.UnixEnd > 0 {
+		window["$lt"] = period.UnixEnd
+	}
+	opts := options.Find().SetSort(bson.D{{"created_at", 1}, {"_id", 1}})
+	cursor, err := s.udb.Find(ctx, bson.M{"customer_key": doc.Key, "created_at": window}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	usage := make(map[string]*pb.UsageSeries)
+	for cursor.Next(ctx) {
+		var e UsageEvent
+		if err := cursor.Decode(&e); err != nil {
+			return nil, err
+		}
+		series, ok := usage[e.ProductKey]
+		if !ok {
+			series = &pb.UsageSeries{}
+			usage[e.ProductKey] = series
+		}
+		series.Records = append(series.Records, &pb.UsageRecord{
+			UnixTime:  e.CreatedAt,
+			Inc:       e.Inc,
+			Total:     e.Total,
+			Labels:    e.Labels,
+			BucketKey: e.BucketKey,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	log.Debugf("got usage history for %s", doc.Key)
+	return &pb.GetCustomerUsageHistoryResponse{
+		Usage: usage,
+	}, nil
+}
+
 func (s *Service) ReportCustomerUsage(
 	ctx context.Context,
 	req *pb.ReportCustomerUsageRequest,
@@ -639,6 +639,16 @@ func (c *Client) Remove(ctx context.Context, key string) error {
 	return err
 }
 
+// ReserveStorage reserves size bytes of storage ahead of a large upload.
+// Add the returned token to the upload context with common.NewStorageReservationContext
+// so the upload isn't rejected partway through.
+// Reservations that are not used before they expire are released.
+func (c *Client) ReserveStorage(ctx context.Context, size int64) (*pb.ReserveStorageResponse, error) {
+	return c.c.ReserveStorage(ctx, &pb.ReserveStorageRequest{
+		Size: size,
+	})
+}
+
 // RemovePath removes the file or directory at path.
 // Files and directories will be unpinned.
 func (c *Client) RemovePath(ctx context.Context, key, pth string, opts ...Option) (path.Resolved, error) {
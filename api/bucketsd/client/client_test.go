@@ -712,6 +712,48 @@ func setPath(t *testing.T, private bool) {
 	}
 }
 
+func TestClient_SetPathOverwrite(t *testing.T) {
+	t.Run("public", func(t *testing.T) {
+		setPathOverwrite(t, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		setPathOverwrite(t, true)
+	})
+}
+
+func setPathOverwrite(t *testing.T, private bool) {
+	ctx, client := setup(t)
+
+	ipfs, err := httpapi.NewApi(apitest.GetIPFSApiAddr())
+	require.NoError(t, err)
+	addFile := func(size int) path.Resolved {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+			"file": ipfsfiles.NewBytesFile(data),
+		}))
+		require.NoError(t, err)
+		return p
+	}
+
+	buck, err := client.Create(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	large := addFile(1024 * 1024)
+	rep, err := client.SetPath(ctx, buck.Root.Key, "dir", large.Cid())
+	require.NoError(t, err)
+	assert.Greater(t, rep.Pinned, int64(1024*1024))
+
+	// Overwriting the path with smaller data decreases storage by the net difference.
+	small := addFile(1024)
+	rep, err = client.SetPath(ctx, buck.Root.Key, "dir", small.Cid())
+	require.NoError(t, err)
+	assert.Less(t, rep.Pinned, int64(-1024*1024+1024))
+	assert.Greater(t, rep.Pinned, int64(-1024*1024-64*1024))
+}
+
 func TestClient_Remove(t *testing.T) {
 	ctx, client := setup(t)
 
@@ -1903,6 +1903,108 @@ func (x *RemovePathResponse) GetPinned() int64 {
 	return 0
 }
 
+type ReserveStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ReserveStorageRequest) Reset() {
+	*x = ReserveStorageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStorageRequest) ProtoMessage() {}
+
+func (x *ReserveStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStorageRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStorageRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReserveStorageRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReserveStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ReserveStorageResponse) Reset() {
+	*x = ReserveStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStorageResponse) ProtoMessage() {}
+
+func (x *ReserveStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStorageResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStorageResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReserveStorageResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReserveStorageResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type PushPathAccessRolesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1916,7 +2018,7 @@ type PushPathAccessRolesRequest struct {
 func (x *PushPathAccessRolesRequest) Reset() {
 	*x = PushPathAccessRolesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2031,7 @@ func (x *PushPathAccessRolesRequest) String() string {
 func (*PushPathAccessRolesRequest) ProtoMessage() {}
 
 func (x *PushPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2044,7 @@ func (x *PushPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathAccessRolesRequest.ProtoReflect.Descriptor instead.
 func (*PushPathAccessRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{29}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PushPathAccessRolesRequest) GetKey() string {
@@ -1977,7 +2079,7 @@ type PushPathAccessRolesResponse struct {
 func (x *PushPathAccessRolesResponse) Reset() {
 	*x = PushPathAccessRolesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1990,7 +2092,7 @@ func (x *PushPathAccessRolesResponse) String() string {
 func (*PushPathAccessRolesResponse) ProtoMessage() {}
 
 func (x *PushPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2105,7 @@ func (x *PushPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathAccessRolesResponse.ProtoReflect.Descriptor instead.
 func (*PushPathAccessRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{30}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PushPathAccessRolesResponse) GetPinned() int64 {
@@ -2025,7 +2127,7 @@ type PullPathAccessRolesRequest struct {
 func (x *PullPathAccessRolesRequest) Reset() {
 	*x = PullPathAccessRolesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2038,7 +2140,7 @@ func (x *PullPathAccessRolesRequest) String() string {
 func (*PullPathAccessRolesRequest) ProtoMessage() {}
 
 func (x *PullPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +2153,7 @@ func (x *PullPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullPathAccessRolesRequest.ProtoReflect.Descriptor instead.
 func (*PullPathAccessRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{31}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PullPathAccessRolesRequest) GetKey() string {
@@ -2079,7 +2181,7 @@ type PullPathAccessRolesResponse struct {
 func (x *PullPathAccessRolesResponse) Reset() {
 	*x = PullPathAccessRolesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2092,7 +2194,7 @@ func (x *PullPathAccessRolesResponse) String() string {
 func (*PullPathAccessRolesResponse) ProtoMessage() {}
 
 func (x *PullPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,7 +2207,7 @@ func (x *PullPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullPathAccessRolesResponse.ProtoReflect.Descriptor instead.
 func (*PullPathAccessRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{32}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PullPathAccessRolesResponse) GetRoles() map[string]PathAccessRole {
@@ -2134,7 +2236,7 @@ type ArchiveConfig struct {
 func (x *ArchiveConfig) Reset() {
 	*x = ArchiveConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2147,7 +2249,7 @@ func (x *ArchiveConfig) String() string {
 func (*ArchiveConfig) ProtoMessage() {}
 
 func (x *ArchiveConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2160,7 +2262,7 @@ func (x *ArchiveConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveConfig.ProtoReflect.Descriptor instead.
 func (*ArchiveConfig) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{33}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ArchiveConfig) GetRepFactor() int32 {
@@ -2238,7 +2340,7 @@ type Archives struct {
 func (x *Archives) Reset() {
 	*x = Archives{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2251,7 +2353,7 @@ func (x *Archives) String() string {
 func (*Archives) ProtoMessage() {}
 
 func (x *Archives) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2264,7 +2366,7 @@ func (x *Archives) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Archives.ProtoReflect.Descriptor instead.
 func (*Archives) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{34}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Archives) GetCurrent() *Archive {
@@ -2299,7 +2401,7 @@ type Archive struct {
 func (x *Archive) Reset() {
 	*x = Archive{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2312,7 +2414,7 @@ func (x *Archive) String() string {
 func (*Archive) ProtoMessage() {}
 
 func (x *Archive) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,7 +2427,7 @@ func (x *Archive) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Archive.ProtoReflect.Descriptor instead.
 func (*Archive) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{35}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Archive) GetCid() string {
@@ -2406,7 +2508,7 @@ type DealInfo struct {
 func (x *DealInfo) Reset() {
 	*x = DealInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2419,7 +2521,7 @@ func (x *DealInfo) String() string {
 func (*DealInfo) ProtoMessage() {}
 
 func (x *DealInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,7 +2534,7 @@ func (x *DealInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealInfo.ProtoReflect.Descriptor instead.
 func (*DealInfo) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{36}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DealInfo) GetProposalCid() string {
@@ -2531,7 +2633,7 @@ type ArchiveRenew struct {
 func (x *ArchiveRenew) Reset() {
 	*x = ArchiveRenew{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2544,7 +2646,7 @@ func (x *ArchiveRenew) String() string {
 func (*ArchiveRenew) ProtoMessage() {}
 
 func (x *ArchiveRenew) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,7 +2659,7 @@ func (x *ArchiveRenew) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRenew.ProtoReflect.Descriptor instead.
 func (*ArchiveRenew) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{37}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ArchiveRenew) GetEnabled() bool {
@@ -2585,7 +2687,7 @@ type DefaultArchiveConfigRequest struct {
 func (x *DefaultArchiveConfigRequest) Reset() {
 	*x = DefaultArchiveConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2598,7 +2700,7 @@ func (x *DefaultArchiveConfigRequest) String() string {
 func (*DefaultArchiveConfigRequest) ProtoMessage() {}
 
 func (x *DefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2611,7 +2713,7 @@ func (x *DefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultArchiveConfigRequest.ProtoReflect.Descriptor instead.
 func (*DefaultArchiveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{38}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DefaultArchiveConfigRequest) GetKey() string {
@@ -2632,7 +2734,7 @@ type DefaultArchiveConfigResponse struct {
 func (x *DefaultArchiveConfigResponse) Reset() {
 	*x = DefaultArchiveConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2645,7 +2747,7 @@ func (x *DefaultArchiveConfigResponse) String() string {
 func (*DefaultArchiveConfigResponse) ProtoMessage() {}
 
 func (x *DefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2658,7 +2760,7 @@ func (x *DefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultArchiveConfigResponse.ProtoReflect.Descriptor instead.
 func (*DefaultArchiveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{39}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DefaultArchiveConfigResponse) GetArchiveConfig() *ArchiveConfig {
@@ -2680,7 +2782,7 @@ type SetDefaultArchiveConfigRequest struct {
 func (x *SetDefaultArchiveConfigRequest) Reset() {
 	*x = SetDefaultArchiveConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2693,7 +2795,7 @@ func (x *SetDefaultArchiveConfigRequest) String() string {
 func (*SetDefaultArchiveConfigRequest) ProtoMessage() {}
 
 func (x *SetDefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +2808,7 @@ func (x *SetDefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultArchiveConfigRequest.ProtoReflect.Descriptor instead.
 func (*SetDefaultArchiveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{40}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SetDefaultArchiveConfigRequest) GetKey() string {
@@ -2732,7 +2834,7 @@ type SetDefaultArchiveConfigResponse struct {
 func (x *SetDefaultArchiveConfigResponse) Reset() {
 	*x = SetDefaultArchiveConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2745,7 +2847,7 @@ func (x *SetDefaultArchiveConfigResponse) String() string {
 func (*SetDefaultArchiveConfigResponse) ProtoMessage() {}
 
 func (x *SetDefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2758,7 +2860,7 @@ func (x *SetDefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultArchiveConfigResponse.ProtoReflect.Descriptor instead.
 func (*SetDefaultArchiveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{41}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{43}
 }
 
 type ArchiveRequest struct {
@@ -2773,7 +2875,7 @@ type ArchiveRequest struct {
 func (x *ArchiveRequest) Reset() {
 	*x = ArchiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2786,7 +2888,7 @@ func (x *ArchiveRequest) String() string {
 func (*ArchiveRequest) ProtoMessage() {}
 
 func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,7 +2901,7 @@ func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{42}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ArchiveRequest) GetKey() string {
@@ -2825,7 +2927,7 @@ type ArchiveResponse struct {
 func (x *ArchiveResponse) Reset() {
 	*x = ArchiveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2838,7 +2940,7 @@ func (x *ArchiveResponse) String() string {
 func (*ArchiveResponse) ProtoMessage() {}
 
 func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,7 +2953,7 @@ func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{43}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{45}
 }
 
 type ArchivesRequest struct {
@@ -2865,7 +2967,7 @@ type ArchivesRequest struct {
 func (x *ArchivesRequest) Reset() {
 	*x = ArchivesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2878,7 +2980,7 @@ func (x *ArchivesRequest) String() string {
 func (*ArchivesRequest) ProtoMessage() {}
 
 func (x *ArchivesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,7 +2993,7 @@ func (x *ArchivesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesRequest.ProtoReflect.Descriptor instead.
 func (*ArchivesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{44}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ArchivesRequest) GetKey() string {
@@ -2914,7 +3016,7 @@ type ArchivesResponse struct {
 func (x *ArchivesResponse) Reset() {
 	*x = ArchivesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2927,7 +3029,7 @@ func (x *ArchivesResponse) String() string {
 func (*ArchivesResponse) ProtoMessage() {}
 
 func (x *ArchivesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2940,7 +3042,7 @@ func (x *ArchivesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesResponse.ProtoReflect.Descriptor instead.
 func (*ArchivesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{45}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ArchivesResponse) GetCurrent() *Archive {
@@ -2968,7 +3070,7 @@ type ArchiveWatchRequest struct {
 func (x *ArchiveWatchRequest) Reset() {
 	*x = ArchiveWatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2981,7 +3083,7 @@ func (x *ArchiveWatchRequest) String() string {
 func (*ArchiveWatchRequest) ProtoMessage() {}
 
 func (x *ArchiveWatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,7 +3096,7 @@ func (x *ArchiveWatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveWatchRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveWatchRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{46}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ArchiveWatchRequest) GetKey() string {
@@ -3015,7 +3117,7 @@ type ArchiveWatchResponse struct {
 func (x *ArchiveWatchResponse) Reset() {
 	*x = ArchiveWatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3028,7 +3130,7 @@ func (x *ArchiveWatchResponse) String() string {
 func (*ArchiveWatchResponse) ProtoMessage() {}
 
 func (x *ArchiveWatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3041,7 +3143,7 @@ func (x *ArchiveWatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveWatchResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveWatchResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{47}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ArchiveWatchResponse) GetMsg() string {
@@ -3064,7 +3166,7 @@ type PushPathRequest_Header struct {
 func (x *PushPathRequest_Header) Reset() {
 	*x = PushPathRequest_Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[50]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3077,7 +3179,7 @@ func (x *PushPathRequest_Header) String() string {
 func (*PushPathRequest_Header) ProtoMessage() {}
 
 func (x *PushPathRequest_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[50]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3130,7 +3232,7 @@ type PushPathResponse_Event struct {
 func (x *PushPathResponse_Event) Reset() {
 	*x = PushPathResponse_Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[51]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3143,7 +3245,7 @@ func (x *PushPathResponse_Event) String() string {
 func (*PushPathResponse_Event) ProtoMessage() {}
 
 func (x *PushPathResponse_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[51]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3213,7 +3315,7 @@ type PushPathsRequest_Header struct {
 func (x *PushPathsRequest_Header) Reset() {
 	*x = PushPathsRequest_Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3226,7 +3328,7 @@ func (x *PushPathsRequest_Header) String() string {
 func (*PushPathsRequest_Header) ProtoMessage() {}
 
 func (x *PushPathsRequest_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3268,7 +3370,7 @@ type PushPathsRequest_Chunk struct {
 func (x *PushPathsRequest_Chunk) Reset() {
 	*x = PushPathsRequest_Chunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3281,7 +3383,7 @@ func (x *PushPathsRequest_Chunk) String() string {
 func (*PushPathsRequest_Chunk) ProtoMessage() {}
 
 func (x *PushPathsRequest_Chunk) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,301 +3624,315 @@ var file_api_bucketsd_pb_bucketsd_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
 	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72,
 	0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x1a,
-	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x15, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x4d, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x1a, 0x50, 0x75, 0x73, 0x68,
+	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x0a, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74,
+	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x1a,
+	0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f,
 	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
 	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x4c, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x36, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x59,
-	0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x75, 0x73,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x22, 0x42, 0x0a, 0x1a, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf4,
-	0x02, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x70, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c,
-	0x4d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
-	0x75, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x33, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x05,
-	0x72, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74,
-	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61,
-	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x72, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a,
+	0x59, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf4, 0x02, 0x0a, 0x0d, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x70, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x72, 0x65, 0x70, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x64,
+	0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x05, 0x72, 0x65, 0x6e, 0x65,
+	0x77, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x72, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x32, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x07, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x45,
-	0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x67,
-	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x73,
-	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
-	0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x61,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63,
-	0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x65,
-	0x63, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
-	0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x0c,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x79, 0x0a, 0x1e,
-	0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45,
-	0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x7a, 0x0a,
-	0x10, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x09,
+	0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x65, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x22, 0x2f, 0x0a, 0x1b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x65, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x79, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x0e,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x7a, 0x0a, 0x10, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x27, 0x0a, 0x13, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0x28, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x88, 0x01, 0x0a,
-	0x0e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x1c, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52,
-	0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b,
-	0x0a, 0x17, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f,
-	0x4c, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x27, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x28,
+	0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x74,
+	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x50,
 	0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0xbc, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47,
-	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a,
-	0x17, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x52,
-	0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x32, 0xa3, 0x0e, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x48, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
-	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x2e,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41,
+	0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x54, 0x48, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49,
+	0x4e, 0x10, 0x03, 0x2a, 0xbc, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x19,
+	0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x52, 0x43,
+	0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x05, 0x32, 0x88, 0x0f, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x08,
-	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
-	0x01, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a,
-	0x08, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
-	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75,
-	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x5f, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49,
-	0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f,
+	0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x05,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x58, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x08, 0x50, 0x75, 0x6c,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5f,
+	0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x57, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22,
+	0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4e, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x50, 0x75, 0x73,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a,
-	0x13, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x75, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72,
+	0x0a, 0x13, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
 	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
 	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x07, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
 	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x31, 0x5a, 0x2f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69,
-	0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x31, 0x5a,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74,
+	0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3832,7 +3948,7 @@ func file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP() []byte {
 }
 
 var file_api_bucketsd_pb_bucketsd_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_bucketsd_pb_bucketsd_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_api_bucketsd_pb_bucketsd_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_api_bucketsd_pb_bucketsd_proto_goTypes = []interface{}{
 	(PathAccessRole)(0),                     // 0: api.bucketsd.pb.PathAccessRole
 	(ArchiveStatus)(0),                      // 1: api.bucketsd.pb.ArchiveStatus
@@ -3865,39 +3981,41 @@ var file_api_bucketsd_pb_bucketsd_proto_goTypes = []interface{}{
 	(*RemoveResponse)(nil),                  // 28: api.bucketsd.pb.RemoveResponse
 	(*RemovePathRequest)(nil),               // 29: api.bucketsd.pb.RemovePathRequest
 	(*RemovePathResponse)(nil),              // 30: api.bucketsd.pb.RemovePathResponse
-	(*PushPathAccessRolesRequest)(nil),      // 31: api.bucketsd.pb.PushPathAccessRolesRequest
-	(*PushPathAccessRolesResponse)(nil),     // 32: api.bucketsd.pb.PushPathAccessRolesResponse
-	(*PullPathAccessRolesRequest)(nil),      // 33: api.bucketsd.pb.PullPathAccessRolesRequest
-	(*PullPathAccessRolesResponse)(nil),     // 34: api.bucketsd.pb.PullPathAccessRolesResponse
-	(*ArchiveConfig)(nil),                   // 35: api.bucketsd.pb.ArchiveConfig
-	(*Archives)(nil),                        // 36: api.bucketsd.pb.Archives
-	(*Archive)(nil),                         // 37: api.bucketsd.pb.Archive
-	(*DealInfo)(nil),                        // 38: api.bucketsd.pb.DealInfo
-	(*ArchiveRenew)(nil),                    // 39: api.bucketsd.pb.ArchiveRenew
-	(*DefaultArchiveConfigRequest)(nil),     // 40: api.bucketsd.pb.DefaultArchiveConfigRequest
-	(*DefaultArchiveConfigResponse)(nil),    // 41: api.bucketsd.pb.DefaultArchiveConfigResponse
-	(*SetDefaultArchiveConfigRequest)(nil),  // 42: api.bucketsd.pb.SetDefaultArchiveConfigRequest
-	(*SetDefaultArchiveConfigResponse)(nil), // 43: api.bucketsd.pb.SetDefaultArchiveConfigResponse
-	(*ArchiveRequest)(nil),                  // 44: api.bucketsd.pb.ArchiveRequest
-	(*ArchiveResponse)(nil),                 // 45: api.bucketsd.pb.ArchiveResponse
-	(*ArchivesRequest)(nil),                 // 46: api.bucketsd.pb.ArchivesRequest
-	(*ArchivesResponse)(nil),                // 47: api.bucketsd.pb.ArchivesResponse
-	(*ArchiveWatchRequest)(nil),             // 48: api.bucketsd.pb.ArchiveWatchRequest
-	(*ArchiveWatchResponse)(nil),            // 49: api.bucketsd.pb.ArchiveWatchResponse
-	nil,                                     // 50: api.bucketsd.pb.Metadata.RolesEntry
-	nil,                                     // 51: api.bucketsd.pb.Root.PathMetadataEntry
-	(*PushPathRequest_Header)(nil),          // 52: api.bucketsd.pb.PushPathRequest.Header
-	(*PushPathResponse_Event)(nil),          // 53: api.bucketsd.pb.PushPathResponse.Event
-	(*PushPathsRequest_Header)(nil),         // 54: api.bucketsd.pb.PushPathsRequest.Header
-	(*PushPathsRequest_Chunk)(nil),          // 55: api.bucketsd.pb.PushPathsRequest.Chunk
-	nil,                                     // 56: api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
-	nil,                                     // 57: api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
+	(*ReserveStorageRequest)(nil),           // 31: api.bucketsd.pb.ReserveStorageRequest
+	(*ReserveStorageResponse)(nil),          // 32: api.bucketsd.pb.ReserveStorageResponse
+	(*PushPathAccessRolesRequest)(nil),      // 33: api.bucketsd.pb.PushPathAccessRolesRequest
+	(*PushPathAccessRolesResponse)(nil),     // 34: api.bucketsd.pb.PushPathAccessRolesResponse
+	(*PullPathAccessRolesRequest)(nil),      // 35: api.bucketsd.pb.PullPathAccessRolesRequest
+	(*PullPathAccessRolesResponse)(nil),     // 36: api.bucketsd.pb.PullPathAccessRolesResponse
+	(*ArchiveConfig)(nil),                   // 37: api.bucketsd.pb.ArchiveConfig
+	(*Archives)(nil),                        // 38: api.bucketsd.pb.Archives
+	(*Archive)(nil),                         // 39: api.bucketsd.pb.Archive
+	(*DealInfo)(nil),                        // 40: api.bucketsd.pb.DealInfo
+	(*ArchiveRenew)(nil),                    // 41: api.bucketsd.pb.ArchiveRenew
+	(*DefaultArchiveConfigRequest)(nil),     // 42: api.bucketsd.pb.DefaultArchiveConfigRequest
+	(*DefaultArchiveConfigResponse)(nil),    // 43: api.bucketsd.pb.DefaultArchiveConfigResponse
+	(*SetDefaultArchiveConfigRequest)(nil),  // 44: api.bucketsd.pb.SetDefaultArchiveConfigRequest
+	(*SetDefaultArchiveConfigResponse)(nil), // 45: api.bucketsd.pb.SetDefaultArchiveConfigResponse
+	(*ArchiveRequest)(nil),                  // 46: api.bucketsd.pb.ArchiveRequest
+	(*ArchiveResponse)(nil),                 // 47: api.bucketsd.pb.ArchiveResponse
+	(*ArchivesRequest)(nil),                 // 48: api.bucketsd.pb.ArchivesRequest
+	(*ArchivesResponse)(nil),                // 49: api.bucketsd.pb.ArchivesResponse
+	(*ArchiveWatchRequest)(nil),             // 50: api.bucketsd.pb.ArchiveWatchRequest
+	(*ArchiveWatchResponse)(nil),            // 51: api.bucketsd.pb.ArchiveWatchResponse
+	nil,                                     // 52: api.bucketsd.pb.Metadata.RolesEntry
+	nil,                                     // 53: api.bucketsd.pb.Root.PathMetadataEntry
+	(*PushPathRequest_Header)(nil),          // 54: api.bucketsd.pb.PushPathRequest.Header
+	(*PushPathResponse_Event)(nil),          // 55: api.bucketsd.pb.PushPathResponse.Event
+	(*PushPathsRequest_Header)(nil),         // 56: api.bucketsd.pb.PushPathsRequest.Header
+	(*PushPathsRequest_Chunk)(nil),          // 57: api.bucketsd.pb.PushPathsRequest.Chunk
+	nil,                                     // 58: api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
+	nil,                                     // 59: api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
 }
 var file_api_bucketsd_pb_bucketsd_proto_depIdxs = []int32{
-	50, // 0: api.bucketsd.pb.Metadata.roles:type_name -> api.bucketsd.pb.Metadata.RolesEntry
+	52, // 0: api.bucketsd.pb.Metadata.roles:type_name -> api.bucketsd.pb.Metadata.RolesEntry
 	2,  // 1: api.bucketsd.pb.Root.metadata:type_name -> api.bucketsd.pb.Metadata
-	51, // 2: api.bucketsd.pb.Root.path_metadata:type_name -> api.bucketsd.pb.Root.PathMetadataEntry
-	36, // 3: api.bucketsd.pb.Root.archives:type_name -> api.bucketsd.pb.Archives
+	53, // 2: api.bucketsd.pb.Root.path_metadata:type_name -> api.bucketsd.pb.Root.PathMetadataEntry
+	38, // 3: api.bucketsd.pb.Root.archives:type_name -> api.bucketsd.pb.Archives
 	3,  // 4: api.bucketsd.pb.ListResponse.roots:type_name -> api.bucketsd.pb.Root
 	3,  // 5: api.bucketsd.pb.CreateResponse.root:type_name -> api.bucketsd.pb.Root
 	11, // 6: api.bucketsd.pb.CreateResponse.links:type_name -> api.bucketsd.pb.LinksResponse
@@ -3907,24 +4025,24 @@ var file_api_bucketsd_pb_bucketsd_proto_depIdxs = []int32{
 	14, // 10: api.bucketsd.pb.PathItem.items:type_name -> api.bucketsd.pb.PathItem
 	2,  // 11: api.bucketsd.pb.PathItem.metadata:type_name -> api.bucketsd.pb.Metadata
 	14, // 12: api.bucketsd.pb.ListIpfsPathResponse.item:type_name -> api.bucketsd.pb.PathItem
-	52, // 13: api.bucketsd.pb.PushPathRequest.header:type_name -> api.bucketsd.pb.PushPathRequest.Header
-	53, // 14: api.bucketsd.pb.PushPathResponse.event:type_name -> api.bucketsd.pb.PushPathResponse.Event
-	54, // 15: api.bucketsd.pb.PushPathsRequest.header:type_name -> api.bucketsd.pb.PushPathsRequest.Header
-	55, // 16: api.bucketsd.pb.PushPathsRequest.chunk:type_name -> api.bucketsd.pb.PushPathsRequest.Chunk
+	54, // 13: api.bucketsd.pb.PushPathRequest.header:type_name -> api.bucketsd.pb.PushPathRequest.Header
+	55, // 14: api.bucketsd.pb.PushPathResponse.event:type_name -> api.bucketsd.pb.PushPathResponse.Event
+	56, // 15: api.bucketsd.pb.PushPathsRequest.header:type_name -> api.bucketsd.pb.PushPathsRequest.Header
+	57, // 16: api.bucketsd.pb.PushPathsRequest.chunk:type_name -> api.bucketsd.pb.PushPathsRequest.Chunk
 	3,  // 17: api.bucketsd.pb.PushPathsResponse.root:type_name -> api.bucketsd.pb.Root
 	3,  // 18: api.bucketsd.pb.RemovePathResponse.root:type_name -> api.bucketsd.pb.Root
-	56, // 19: api.bucketsd.pb.PushPathAccessRolesRequest.roles:type_name -> api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
-	57, // 20: api.bucketsd.pb.PullPathAccessRolesResponse.roles:type_name -> api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
-	39, // 21: api.bucketsd.pb.ArchiveConfig.renew:type_name -> api.bucketsd.pb.ArchiveRenew
-	37, // 22: api.bucketsd.pb.Archives.current:type_name -> api.bucketsd.pb.Archive
-	37, // 23: api.bucketsd.pb.Archives.history:type_name -> api.bucketsd.pb.Archive
+	58, // 19: api.bucketsd.pb.PushPathAccessRolesRequest.roles:type_name -> api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
+	59, // 20: api.bucketsd.pb.PullPathAccessRolesResponse.roles:type_name -> api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
+	41, // 21: api.bucketsd.pb.ArchiveConfig.renew:type_name -> api.bucketsd.pb.ArchiveRenew
+	39, // 22: api.bucketsd.pb.Archives.current:type_name -> api.bucketsd.pb.Archive
+	39, // 23: api.bucketsd.pb.Archives.history:type_name -> api.bucketsd.pb.Archive
 	1,  // 24: api.bucketsd.pb.Archive.archive_status:type_name -> api.bucketsd.pb.ArchiveStatus
-	38, // 25: api.bucketsd.pb.Archive.deal_info:type_name -> api.bucketsd.pb.DealInfo
-	35, // 26: api.bucketsd.pb.DefaultArchiveConfigResponse.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
-	35, // 27: api.bucketsd.pb.SetDefaultArchiveConfigRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
-	35, // 28: api.bucketsd.pb.ArchiveRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
-	37, // 29: api.bucketsd.pb.ArchivesResponse.current:type_name -> api.bucketsd.pb.Archive
-	37, // 30: api.bucketsd.pb.ArchivesResponse.history:type_name -> api.bucketsd.pb.Archive
+	40, // 25: api.bucketsd.pb.Archive.deal_info:type_name -> api.bucketsd.pb.DealInfo
+	37, // 26: api.bucketsd.pb.DefaultArchiveConfigResponse.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
+	37, // 27: api.bucketsd.pb.SetDefaultArchiveConfigRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
+	37, // 28: api.bucketsd.pb.ArchiveRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
+	39, // 29: api.bucketsd.pb.ArchivesResponse.current:type_name -> api.bucketsd.pb.Archive
+	39, // 30: api.bucketsd.pb.ArchivesResponse.history:type_name -> api.bucketsd.pb.Archive
 	0,  // 31: api.bucketsd.pb.Metadata.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
 	2,  // 32: api.bucketsd.pb.Root.PathMetadataEntry.value:type_name -> api.bucketsd.pb.Metadata
 	3,  // 33: api.bucketsd.pb.PushPathResponse.Event.root:type_name -> api.bucketsd.pb.Root
@@ -3943,35 +4061,37 @@ var file_api_bucketsd_pb_bucketsd_proto_depIdxs = []int32{
 	25, // 46: api.bucketsd.pb.APIService.SetPath:input_type -> api.bucketsd.pb.SetPathRequest
 	27, // 47: api.bucketsd.pb.APIService.Remove:input_type -> api.bucketsd.pb.RemoveRequest
 	29, // 48: api.bucketsd.pb.APIService.RemovePath:input_type -> api.bucketsd.pb.RemovePathRequest
-	31, // 49: api.bucketsd.pb.APIService.PushPathAccessRoles:input_type -> api.bucketsd.pb.PushPathAccessRolesRequest
-	33, // 50: api.bucketsd.pb.APIService.PullPathAccessRoles:input_type -> api.bucketsd.pb.PullPathAccessRolesRequest
-	40, // 51: api.bucketsd.pb.APIService.DefaultArchiveConfig:input_type -> api.bucketsd.pb.DefaultArchiveConfigRequest
-	42, // 52: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:input_type -> api.bucketsd.pb.SetDefaultArchiveConfigRequest
-	44, // 53: api.bucketsd.pb.APIService.Archive:input_type -> api.bucketsd.pb.ArchiveRequest
-	46, // 54: api.bucketsd.pb.APIService.Archives:input_type -> api.bucketsd.pb.ArchivesRequest
-	48, // 55: api.bucketsd.pb.APIService.ArchiveWatch:input_type -> api.bucketsd.pb.ArchiveWatchRequest
-	5,  // 56: api.bucketsd.pb.APIService.List:output_type -> api.bucketsd.pb.ListResponse
-	7,  // 57: api.bucketsd.pb.APIService.Create:output_type -> api.bucketsd.pb.CreateResponse
-	9,  // 58: api.bucketsd.pb.APIService.Root:output_type -> api.bucketsd.pb.RootResponse
-	11, // 59: api.bucketsd.pb.APIService.Links:output_type -> api.bucketsd.pb.LinksResponse
-	13, // 60: api.bucketsd.pb.APIService.ListPath:output_type -> api.bucketsd.pb.ListPathResponse
-	16, // 61: api.bucketsd.pb.APIService.ListIpfsPath:output_type -> api.bucketsd.pb.ListIpfsPathResponse
-	18, // 62: api.bucketsd.pb.APIService.PushPath:output_type -> api.bucketsd.pb.PushPathResponse
-	20, // 63: api.bucketsd.pb.APIService.PushPaths:output_type -> api.bucketsd.pb.PushPathsResponse
-	22, // 64: api.bucketsd.pb.APIService.PullPath:output_type -> api.bucketsd.pb.PullPathResponse
-	24, // 65: api.bucketsd.pb.APIService.PullIpfsPath:output_type -> api.bucketsd.pb.PullIpfsPathResponse
-	26, // 66: api.bucketsd.pb.APIService.SetPath:output_type -> api.bucketsd.pb.SetPathResponse
-	28, // 67: api.bucketsd.pb.APIService.Remove:output_type -> api.bucketsd.pb.RemoveResponse
-	30, // 68: api.bucketsd.pb.APIService.RemovePath:output_type -> api.bucketsd.pb.RemovePathResponse
-	32, // 69: api.bucketsd.pb.APIService.PushPathAccessRoles:output_type -> api.bucketsd.pb.PushPathAccessRolesResponse
-	34, // 70: api.bucketsd.pb.APIService.PullPathAccessRoles:output_type -> api.bucketsd.pb.PullPathAccessRolesResponse
-	41, // 71: api.bucketsd.pb.APIService.DefaultArchiveConfig:output_type -> api.bucketsd.pb.DefaultArchiveConfigResponse
-	43, // 72: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:output_type -> api.bucketsd.pb.SetDefaultArchiveConfigResponse
-	45, // 73: api.bucketsd.pb.APIService.Archive:output_type -> api.bucketsd.pb.ArchiveResponse
-	47, // 74: api.bucketsd.pb.APIService.Archives:output_type -> api.bucketsd.pb.ArchivesResponse
-	49, // 75: api.bucketsd.pb.APIService.ArchiveWatch:output_type -> api.bucketsd.pb.ArchiveWatchResponse
-	56, // [56:76] is the sub-list for method output_type
-	36, // [36:56] is the sub-list for method input_type
+	31, // 49: api.bucketsd.pb.APIService.ReserveStorage:input_type -> api.bucketsd.pb.ReserveStorageRequest
+	33, // 50: api.bucketsd.pb.APIService.PushPathAccessRoles:input_type -> api.bucketsd.pb.PushPathAccessRolesRequest
+	35, // 51: api.bucketsd.pb.APIService.PullPathAccessRoles:input_type -> api.bucketsd.pb.PullPathAccessRolesRequest
+	42, // 52: api.bucketsd.pb.APIService.DefaultArchiveConfig:input_type -> api.bucketsd.pb.DefaultArchiveConfigRequest
+	44, // 53: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:input_type -> api.bucketsd.pb.SetDefaultArchiveConfigRequest
+	46, // 54: api.bucketsd.pb.APIService.Archive:input_type -> api.bucketsd.pb.ArchiveRequest
+	48, // 55: api.bucketsd.pb.APIService.Archives:input_type -> api.bucketsd.pb.ArchivesRequest
+	50, // 56: api.bucketsd.pb.APIService.ArchiveWatch:input_type -> api.bucketsd.pb.ArchiveWatchRequest
+	5,  // 57: api.bucketsd.pb.APIService.List:output_type -> api.bucketsd.pb.ListResponse
+	7,  // 58: api.bucketsd.pb.APIService.Create:output_type -> api.bucketsd.pb.CreateResponse
+	9,  // 59: api.bucketsd.pb.APIService.Root:output_type -> api.bucketsd.pb.RootResponse
+	11, // 60: api.bucketsd.pb.APIService.Links:output_type -> api.bucketsd.pb.LinksResponse
+	13, // 61: api.bucketsd.pb.APIService.ListPath:output_type -> api.bucketsd.pb.ListPathResponse
+	16, // 62: api.bucketsd.pb.APIService.ListIpfsPath:output_type -> api.bucketsd.pb.ListIpfsPathResponse
+	18, // 63: api.bucketsd.pb.APIService.PushPath:output_type -> api.bucketsd.pb.PushPathResponse
+	20, // 64: api.bucketsd.pb.APIService.PushPaths:output_type -> api.bucketsd.pb.PushPathsResponse
+	22, // 65: api.bucketsd.pb.APIService.PullPath:output_type -> api.bucketsd.pb.PullPathResponse
+	24, // 66: api.bucketsd.pb.APIService.PullIpfsPath:output_type -> api.bucketsd.pb.PullIpfsPathResponse
+	26, // 67: api.bucketsd.pb.APIService.SetPath:output_type -> api.bucketsd.pb.SetPathResponse
+	28, // 68: api.bucketsd.pb.APIService.Remove:output_type -> api.bucketsd.pb.RemoveResponse
+	30, // 69: api.bucketsd.pb.APIService.RemovePath:output_type -> api.bucketsd.pb.RemovePathResponse
+	32, // 70: api.bucketsd.pb.APIService.ReserveStorage:output_type -> api.bucketsd.pb.ReserveStorageResponse
+	34, // 71: api.bucketsd.pb.APIService.PushPathAccessRoles:output_type -> api.bucketsd.pb.PushPathAccessRolesResponse
+	36, // 72: api.bucketsd.pb.APIService.PullPathAccessRoles:output_type -> api.bucketsd.pb.PullPathAccessRolesResponse
+	43, // 73: api.bucketsd.pb.APIService.DefaultArchiveConfig:output_type -> api.bucketsd.pb.DefaultArchiveConfigResponse
+	45, // 74: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:output_type -> api.bucketsd.pb.SetDefaultArchiveConfigResponse
+	47, // 75: api.bucketsd.pb.APIService.Archive:output_type -> api.bucketsd.pb.ArchiveResponse
+	49, // 76: api.bucketsd.pb.APIService.Archives:output_type -> api.bucketsd.pb.ArchivesResponse
+	51, // 77: api.bucketsd.pb.APIService.ArchiveWatch:output_type -> api.bucketsd.pb.ArchiveWatchResponse
+	57, // [57:78] is the sub-list for method output_type
+	36, // [36:57] is the sub-list for method input_type
 	36, // [36:36] is the sub-list for extension type_name
 	36, // [36:36] is the sub-list for extension extendee
 	0,  // [0:36] is the sub-list for field type_name
@@ -4332,7 +4452,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathAccessRolesRequest); i {
+			switch v := v.(*ReserveStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4344,7 +4464,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathAccessRolesResponse); i {
+			switch v := v.(*ReserveStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4356,7 +4476,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullPathAccessRolesRequest); i {
+			switch v := v.(*PushPathAccessRolesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4368,7 +4488,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullPathAccessRolesResponse); i {
+			switch v := v.(*PushPathAccessRolesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4380,7 +4500,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveConfig); i {
+			switch v := v.(*PullPathAccessRolesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4392,7 +4512,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Archives); i {
+			switch v := v.(*PullPathAccessRolesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4404,7 +4524,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Archive); i {
+			switch v := v.(*ArchiveConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4416,7 +4536,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DealInfo); i {
+			switch v := v.(*Archives); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4428,7 +4548,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRenew); i {
+			switch v := v.(*Archive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4440,7 +4560,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultArchiveConfigRequest); i {
+			switch v := v.(*DealInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4452,7 +4572,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultArchiveConfigResponse); i {
+			switch v := v.(*ArchiveRenew); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4464,7 +4584,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDefaultArchiveConfigRequest); i {
+			switch v := v.(*DefaultArchiveConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4476,7 +4596,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDefaultArchiveConfigResponse); i {
+			switch v := v.(*DefaultArchiveConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4488,7 +4608,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRequest); i {
+			switch v := v.(*SetDefaultArchiveConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4500,7 +4620,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveResponse); i {
+			switch v := v.(*SetDefaultArchiveConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4512,7 +4632,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesRequest); i {
+			switch v := v.(*ArchiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4524,7 +4644,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesResponse); i {
+			switch v := v.(*ArchiveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4536,7 +4656,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveWatchRequest); i {
+			switch v := v.(*ArchivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4548,6 +4668,30 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ArchiveWatchResponse); i {
 			case 0:
 				return &v.state
@@ -4559,7 +4703,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathRequest_Header); i {
 			case 0:
 				return &v.state
@@ -4571,7 +4715,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathResponse_Event); i {
 			case 0:
 				return &v.state
@@ -4583,7 +4727,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathsRequest_Header); i {
 			case 0:
 				return &v.state
@@ -4595,7 +4739,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathsRequest_Chunk); i {
 			case 0:
 				return &v.state
@@ -4622,7 +4766,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_bucketsd_pb_bucketsd_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   56,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4662,6 +4806,7 @@ type APIServiceClient interface {
 	SetPath(ctx context.Context, in *SetPathRequest, opts ...grpc.CallOption) (*SetPathResponse, error)
 	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
 	RemovePath(ctx context.Context, in *RemovePathRequest, opts ...grpc.CallOption) (*RemovePathResponse, error)
+	ReserveStorage(ctx context.Context, in *ReserveStorageRequest, opts ...grpc.CallOption) (*ReserveStorageResponse, error)
 	PushPathAccessRoles(ctx context.Context, in *PushPathAccessRolesRequest, opts ...grpc.CallOption) (*PushPathAccessRolesResponse, error)
 	PullPathAccessRoles(ctx context.Context, in *PullPathAccessRolesRequest, opts ...grpc.CallOption) (*PullPathAccessRolesResponse, error)
 	// Archive
@@ -4887,6 +5032,15 @@ func (c *aPIServiceClient) RemovePath(ctx context.Context, in *RemovePathRequest
 	return out, nil
 }
 
+func (c *aPIServiceClient) ReserveStorage(ctx context.Context, in *ReserveStorageRequest, opts ...grpc.CallOption) (*ReserveStorageResponse, error) {
+	out := new(ReserveStorageResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/ReserveStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) PushPathAccessRoles(ctx context.Context, in *PushPathAccessRolesRequest, opts ...grpc.CallOption) (*PushPathAccessRolesResponse, error) {
 	out := new(PushPathAccessRolesResponse)
 	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/PushPathAccessRoles", in, out, opts...)
@@ -4988,6 +5142,7 @@ type APIServiceServer interface {
 	SetPath(context.Context, *SetPathRequest) (*SetPathResponse, error)
 	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
 	RemovePath(context.Context, *RemovePathRequest) (*RemovePathResponse, error)
+	ReserveStorage(context.Context, *ReserveStorageRequest) (*ReserveStorageResponse, error)
 	PushPathAccessRoles(context.Context, *PushPathAccessRolesRequest) (*PushPathAccessRolesResponse, error)
 	PullPathAccessRoles(context.Context, *PullPathAccessRolesRequest) (*PullPathAccessRolesResponse, error)
 	// Archive
@@ -5041,6 +5196,9 @@ func (*UnimplementedAPIServiceServer) Remove(context.Context, *RemoveRequest) (*
 func (*UnimplementedAPIServiceServer) RemovePath(context.Context, *RemovePathRequest) (*RemovePathResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePath not implemented")
 }
+func (*UnimplementedAPIServiceServer) ReserveStorage(context.Context, *ReserveStorageRequest) (*ReserveStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStorage not implemented")
+}
 func (*UnimplementedAPIServiceServer) PushPathAccessRoles(context.Context, *PushPathAccessRolesRequest) (*PushPathAccessRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushPathAccessRoles not implemented")
 }
@@ -5323,6 +5481,24 @@ func _APIService_RemovePath_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ReserveStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ReserveStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/ReserveStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ReserveStorage(ctx, req.(*ReserveStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_PushPathAccessRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PushPathAccessRolesRequest)
 	if err := dec(in); err != nil {
@@ -5492,6 +5668,10 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemovePath",
 			Handler:    _APIService_RemovePath_Handler,
 		},
+		{
+			MethodName: "ReserveStorage",
+			Handler:    _APIService_ReserveStorage_Handler,
+		},
 		{
 			MethodName: "PushPathAccessRoles",
 			Handler:    _APIService_PushPathAccessRoles_Handler,
@@ -110,6 +110,8 @@ type Service struct {
 	Semaphores                *nutil.SemaphorePool
 	MaxBucketArchiveSize      int64
 	MaxBucketArchiveRepFactor int
+	StorageReservations       *buckets.StorageReservations
+	StorageExcludedBuckets    map[string]struct{}
 }
 
 var (
@@ -362,6 +364,11 @@ func (s *Service) createBucket(
 		if err != nil {
 			return ctx, nil, nil, fmt.Errorf("creating prepared bucket: %v", err)
 		}
+		added, err := s.countPathObjects(ctx, path.IpfsPath(bootCid), "", nil)
+		if err != nil {
+			return ctx, nil, nil, fmt.Errorf("counting added objects: %v", err)
+		}
+		s.addObjects(ctx, added)
 	} else {
 		ctx, buckPath, err = s.createPristinePath(ctx, seed, linkKey)
 		if err != nil {
@@ -478,7 +485,7 @@ func (s *Service) pinBlocks(ctx context.Context, nodes []ipld.Node) (context.Con
 
 	// Check context owner's storage allowance
 	owner, ok := buckets.BucketOwnerFromContext(ctx)
-	if ok && totalAddedSize > owner.StorageAvailable {
+	if ok && !owner.StorageExcluded && totalAddedSize > owner.StorageAvailable {
 		return ctx, ErrStorageQuotaExhausted
 	}
 
@@ -493,15 +500,119 @@ func (s *Service) addPinnedBytes(ctx context.Context, delta int64) context.Conte
 	total, _ := ctx.Value(ctxKey("pinnedBytes")).(int64)
 	ctx = context.WithValue(ctx, ctxKey("pinnedBytes"), total+delta)
 	owner, ok := buckets.BucketOwnerFromContext(ctx)
-	if ok {
+	if ok && !owner.StorageExcluded {
 		owner.StorageUsed += delta
-		owner.StorageAvailable -= delta
+		if !owner.IsUnlimited() {
+			owner.StorageAvailable -= delta
+		}
 		owner.StorageDelta += delta
 		ctx = buckets.NewBucketOwnerContext(ctx, owner)
 	}
 	return ctx
 }
 
+// scopeStorage attributes the context owner's storage changes to a bucket path,
+// and marks them as excluded from accounting if key is an excluded bucket.
+func (s *Service) scopeStorage(ctx context.Context, key, pth string) {
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	if !ok || owner == nil {
+		return
+	}
+	owner.StorageScope = gopath.Join(key, pth)
+	owner.BucketKey = key
+	if _, ok := s.StorageExcludedBuckets[key]; ok {
+		owner.StorageExcluded = true
+	}
+}
+
+// markUnchanged marks the context owner's request as having no effective change, so that it isn't billed.
+func (s *Service) markUnchanged(ctx context.Context) {
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	if !ok || owner == nil {
+		return
+	}
+	owner.Unchanged = true
+}
+
+// addObjects adds delta to the number of objects the context owner's request stores.
+func (s *Service) addObjects(ctx context.Context, delta int64) {
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	if !ok || owner == nil {
+		return
+	}
+	owner.ObjectDelta += delta
+}
+
+// countPathObjects returns the number of files at pth in the bucket at root, which is zero if pth
+// doesn't exist. Files are only counted for a context owner, who's billed for them.
+// Key will be required if the path is encrypted.
+func (s *Service) countPathObjects(ctx context.Context, root path.Path, pth string, key []byte) (int64, error) {
+	if owner, ok := buckets.BucketOwnerFromContext(ctx); !ok || owner == nil {
+		return 0, nil
+	}
+	if pth == "" {
+		n, err := s.IPFSClient.ResolveNode(ctx, root)
+		if err != nil {
+			return 0, err
+		}
+		return s.countObjects(ctx, n, key)
+	}
+	dir, name := gopath.Split(pth)
+	dirPath := root
+	if dir = strings.TrimSuffix(dir, "/"); dir != "" {
+		dirPath = path.Join(root, dir)
+	}
+	parent, err := s.getNodeAtPath(ctx, dirPath, key)
+	if err != nil {
+		// Parent directories that can't be resolved don't exist yet.
+		return 0, nil
+	}
+	l := getLink(parent.Links(), name)
+	if l == nil {
+		return 0, nil
+	}
+	n, err := l.GetNode(ctx, s.IPFSClient.Dag())
+	if err != nil {
+		return 0, err
+	}
+	return s.countObjects(ctx, n, key)
+}
+
+// countObjects returns the number of files in the dag at n, which is one if n is a file.
+// Seed files aren't counted. Key will be required if the dag is encrypted.
+func (s *Service) countObjects(ctx context.Context, n ipld.Node, key []byte) (int64, error) {
+	var isDir bool
+	if key != nil {
+		var err error
+		n, isDir, err = decryptNode(n, key)
+		if err != nil {
+			return 0, err
+		}
+	} else if pn, ok := n.(*dag.ProtoNode); ok {
+		fn, _ := unixfs.FSNodeFromBytes(pn.Data())
+		isDir = fn != nil && fn.IsDir()
+	}
+	if !isDir {
+		return 1, nil
+	}
+	var count int64
+	for _, l := range n.Links() {
+		if l.Name == buckets.SeedName {
+			continue
+		}
+		ln, err := l.GetNode(ctx, s.IPFSClient.Dag())
+		if err != nil {
+			return 0, err
+		}
+		c, err := s.countObjects(ctx, ln, key)
+		if err != nil {
+			return 0, err
+		}
+		count += c
+	}
+	return count, nil
+}
+
 // getPinnedBytes returns the total pinned bytes for context.
 func (s *Service) getPinnedBytes(ctx context.Context) int64 {
 	pinned, _ := ctx.Value(ctxKey("pinnedBytes")).(int64)
@@ -979,6 +1090,7 @@ func (s *Service) SetPath(ctx context.Context, req *pb.SetPathRequest) (res *pb.
 	lck := s.Semaphores.Get(buckLock(req.Key))
 	lck.Acquire()
 	defer lck.Release()
+	s.scopeStorage(ctx, req.Key, destPath)
 
 	buck := &tdb.Bucket{}
 	if err = s.Buckets.GetSafe(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
@@ -1004,12 +1116,35 @@ func (s *Service) SetPath(ctx context.Context, req *pb.SetPathRequest) (res *pb.
 		}
 	}
 
+	replaced, err := s.countPathObjects(ctx, path.New(buck.Path), destPath, linkKey)
+	if err != nil {
+		return nil, fmt.Errorf("counting replaced objects: %v", err)
+	}
+	sizeBefore, err := s.getBucketSize(ctx, buck)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket size: %v", err)
+	}
+	pinnedBefore := s.getPinnedBytes(ctx)
 	buckPath := path.New(buck.Path)
 	ctx, dirPath, err := s.setPathFromExistingCid(ctx, buck, buckPath, destPath, bootCid, linkKey, fileKey)
 	if err != nil {
 		return nil, err
 	}
+	added, err := s.countPathObjects(ctx, path.IpfsPath(bootCid), "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("counting added objects: %v", err)
+	}
+	s.addObjects(ctx, added-replaced)
 	buck.Path = dirPath.String()
+
+	// Pinning only accounts for the nodes that change, which doesn't net out replaced data
+	// in private buckets. Reconcile pinned bytes with the net change in bucket size so that
+	// overwriting a path with smaller data decreases storage.
+	sizeAfter, err := s.getBucketSize(ctx, buck)
+	if err != nil {
+		return nil, fmt.Errorf("getting bucket size: %v", err)
+	}
+	ctx = s.addPinnedBytes(ctx, sizeAfter-sizeBefore-(s.getPinnedBytes(ctx)-pinnedBefore))
 	if err = s.Buckets.Save(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
@@ -1394,6 +1529,7 @@ func (s *Service) PushPath(server pb.APIService_PushPathServer) (err error) {
 	lck := s.Semaphores.Get(buckLock(buckKey))
 	lck.Acquire()
 	defer lck.Release()
+	s.scopeStorage(server.Context(), buckKey, filePath)
 
 	buck := &tdb.Bucket{}
 	err = s.Buckets.GetSafe(server.Context(), dbID, buckKey, buck, tdb.WithToken(dbToken))
@@ -1502,6 +1638,11 @@ func (s *Service) PushPath(server pb.APIService_PushPathServer) (err error) {
 	}
 
 	ctx := server.Context()
+	replaced, err := s.countPathObjects(ctx, path.New(buck.Path), filePath, buck.GetLinkEncryptionKey())
+	if err != nil {
+		return fmt.Errorf("counting replaced objects: %v", err)
+	}
+	s.addObjects(ctx, 1-replaced)
 	buckPath := path.New(buck.Path)
 	var dirPath path.Resolved
 	if buck.IsPrivate() {
@@ -1680,6 +1821,7 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 	lck := s.Semaphores.Get(buckLock(buckKey))
 	lck.Acquire()
 	defer lck.Release()
+	s.scopeStorage(ctx, buckKey, "")
 
 	buck := &tdb.Bucket{}
 	err = s.Buckets.GetSafe(ctx, dbID, buckKey, buck, tdb.WithToken(dbToken))
@@ -1776,6 +1918,11 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 			if err != nil {
 				return saveWithErr(fmt.Errorf("resolving added node: %v", err))
 			}
+			replaced, err := s.countPathObjects(ctx, path.New(buck.Path), res.path, buck.GetLinkEncryptionKey())
+			if err != nil {
+				return saveWithErr(fmt.Errorf("counting replaced objects: %v", err))
+			}
+			s.addObjects(ctx, 1-replaced)
 
 			var dir path.Resolved
 			if buck.IsPrivate() {
@@ -2112,7 +2259,7 @@ func (s *Service) updateOrAddPin(ctx context.Context, from, to path.Path) (conte
 
 	// Check context owner's storage allowance
 	owner, ok := buckets.BucketOwnerFromContext(ctx)
-	if ok && deltaSize > owner.StorageAvailable {
+	if ok && !owner.StorageExcluded && deltaSize > owner.StorageAvailable {
 		return ctx, ErrStorageQuotaExhausted
 	}
 
@@ -2196,6 +2343,12 @@ func (s *Service) PullPath(req *pb.PullPathRequest, server pb.APIService_PullPat
 	if file == nil {
 		return fmt.Errorf("node is a directory")
 	}
+	if size, err := file.Size(); err == nil {
+		if err := buckets.AdmitEgress(server.Context(), size); err != nil {
+			return err
+		}
+		buckets.PrecountEgress(server.Context(), size)
+	}
 	var reader io.Reader
 	if fileKey != nil {
 		r, err := dcrypto.NewDecrypter(file, fileKey)
@@ -2272,16 +2425,22 @@ func (s *Service) Remove(ctx context.Context, req *pb.RemoveRequest) (*pb.Remove
 	lck := s.Semaphores.Get(buckLock(req.Key))
 	lck.Acquire()
 	defer lck.Release()
+	s.scopeStorage(ctx, req.Key, "")
 
 	buck := &tdb.Bucket{}
 	err := s.Buckets.GetSafe(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
 	if err != nil {
 		return nil, err
 	}
+	removed, err := s.countPathObjects(ctx, path.New(buck.Path), "", buck.GetLinkEncryptionKey())
+	if err != nil {
+		return nil, fmt.Errorf("counting removed objects: %v", err)
+	}
 
 	if err = s.Buckets.Delete(ctx, dbID, buck.Key, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
+	s.addObjects(ctx, -removed)
 
 	buckPath, err := util.NewResolvedPath(buck.Path)
 	if err != nil {
@@ -2318,6 +2477,32 @@ func (s *Service) unpinNodeAndBranch(ctx context.Context, pth path.Resolved, key
 	return s.unpinPath(ctx, pth)
 }
 
+func (s *Service) ReserveStorage(ctx context.Context, req *pb.ReserveStorageRequest) (*pb.ReserveStorageResponse, error) {
+	log.Debugf("received reserve storage request")
+
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	if !ok || s.StorageReservations == nil {
+		return nil, status.Error(codes.Unimplemented, "storage reservations are not enabled")
+	}
+	account, ok := mdb.AccountFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "account required")
+	}
+	if req.Size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size must be greater than zero")
+	}
+	res, ok := s.StorageReservations.Reserve(account.Owner().Key.String(), req.Size, owner.StorageAvailable)
+	if !ok {
+		return nil, ErrStorageQuotaExhausted
+	}
+
+	log.Debugf("reserved %d bytes for %s", res.Size, account.Owner().Key)
+	return &pb.ReserveStorageResponse{
+		Token:     res.Token,
+		ExpiresAt: res.ExpiresAt.Unix(),
+	}, nil
+}
+
 func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (res *pb.RemovePathResponse, err error) {
 	log.Debugf("received remove path request")
 
@@ -2335,6 +2520,7 @@ func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (re
 	lck := s.Semaphores.Get(buckLock(req.Key))
 	lck.Acquire()
 	defer lck.Release()
+	s.scopeStorage(ctx, req.Key, filePath)
 
 	buck := &tdb.Bucket{}
 	err = s.Buckets.GetSafe(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
@@ -2352,6 +2538,10 @@ func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (re
 		return nil, err
 	}
 
+	removed, err := s.countPathObjects(ctx, path.New(buck.Path), filePath, buck.GetLinkEncryptionKey())
+	if err != nil {
+		return nil, fmt.Errorf("counting removed objects: %v", err)
+	}
 	buckPath := path.New(buck.Path)
 	var dirPath path.Resolved
 	if buck.IsPrivate() {
@@ -2373,6 +2563,12 @@ func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (re
 			return nil, err
 		}
 	}
+	// Removing a path that doesn't exist leaves the bucket root as is.
+	if dirPath.String() == buck.Path {
+		s.markUnchanged(ctx)
+	} else {
+		s.addObjects(ctx, -removed)
+	}
 
 	buck.Path = dirPath.String()
 	if err = s.Buckets.Save(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
@@ -2483,6 +2679,7 @@ func (s *Service) PushPathAccessRoles(
 	lck := s.Semaphores.Get(buckLock(req.Key))
 	lck.Acquire()
 	defer lck.Release()
+	s.scopeStorage(ctx, req.Key, reqPath)
 
 	buck, pth, err := s.getBucketPath(ctx, dbID, req.Key, reqPath, dbToken)
 	if err != nil {
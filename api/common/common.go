@@ -4,13 +4,20 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	mbase "github.com/multiformats/go-multibase"
 	"github.com/textileio/go-threads/core/thread"
+	"google.golang.org/grpc/metadata"
 )
 
+// EstimatedCostKey is the trailer key that carries the estimated cost in USD of a metered request.
+const EstimatedCostKey = "x-textile-estimated-cost"
+
 type ctxKey string
 
 // NewSessionContext adds a session to a context.
@@ -223,6 +230,219 @@ func ThreadNameFromMD(ctx context.Context) (name string, ok bool) {
 	return
 }
 
+// NewStorageReservationContext adds a storage reservation token to a context.
+func NewStorageReservationContext(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("storageReservation"), token)
+}
+
+// StorageReservationFromContext returns a storage reservation token from a context.
+func StorageReservationFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(ctxKey("storageReservation")).(string)
+	return token, ok
+}
+
+// StorageReservationFromMD returns a storage reservation token from context metadata.
+func StorageReservationFromMD(ctx context.Context) (token string, ok bool) {
+	token = metautils.ExtractIncoming(ctx).Get("x-textile-storage-reservation")
+	if token != "" {
+		ok = true
+	}
+	return
+}
+
+// NewAdminTokenContext adds an admin token to a context.
+func NewAdminTokenContext(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("adminToken"), token)
+}
+
+// AdminTokenFromContext returns an admin token from a context.
+func AdminTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(ctxKey("adminToken")).(string)
+	return token, ok
+}
+
+// AdminTokenFromMD returns an admin token from context metadata.
+func AdminTokenFromMD(ctx context.Context) (token string, ok bool) {
+	token = metautils.ExtractIncoming(ctx).Get("x-textile-admin-token")
+	if token != "" {
+		ok = true
+	}
+	return
+}
+
+// NewRequestIDContext adds a request ID to a context.
+// Requests that are retried with the same ID are billed once.
+func NewRequestIDContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("requestID"), id)
+}
+
+// RequestIDFromContext returns a request ID from a context.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxKey("requestID")).(string)
+	return id, ok
+}
+
+// RequestIDFromMD returns a request ID from context metadata.
+func RequestIDFromMD(ctx context.Context) (id string, ok bool) {
+	id = metautils.ExtractIncoming(ctx).Get("x-textile-request-id")
+	if id != "" {
+		ok = true
+	}
+	return
+}
+
+// NewIdempotencyKeyContext adds an idempotency key to a context.
+// Mutations that are retried with the same key may return the result of the first attempt
+// instead of being executed again.
+func NewIdempotencyKeyContext(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("idempotencyKey"), key)
+}
+
+// IdempotencyKeyFromContext returns an idempotency key from a context.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(ctxKey("idempotencyKey")).(string)
+	return key, ok
+}
+
+// IdempotencyKeyFromMD returns an idempotency key from context metadata.
+func IdempotencyKeyFromMD(ctx context.Context) (key string, ok bool) {
+	key = metautils.ExtractIncoming(ctx).Get("x-textile-idempotency-key")
+	if key != "" {
+		ok = true
+	}
+	return
+}
+
+// CreateSupportSession creates a support-session token for actor to act on behalf of owner
+// that expires at date, signed with an HMAC of key and SHA256 as the hash algorithm.
+// Requests for owner with the token are not metered and are audit-logged with actor.
+func CreateSupportSession(actor, owner string, date time.Time, key string) (string, error) {
+	if owner == "" || strings.Contains(owner, ".") {
+		return "", fmt.Errorf("invalid support session owner: %s", owner)
+	}
+	_, sec, err := mbase.Decode(key)
+	if err != nil {
+		return "", err
+	}
+	msg := date.UTC().Format(time.RFC3339) + "." + owner + "." + actor
+	hash := hmac.New(sha256.New, sec)
+	if _, err = hash.Write([]byte(msg)); err != nil {
+		return "", err
+	}
+	sig, err := mbase.Encode(mbase.Base32, hash.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+	return sig + "." + msg, nil
+}
+
+// ValidateSupportSession re-computes the signature of a support-session token using key
+// and returns the support actor and the owner they act on behalf of. This method returns false
+// if the signature doesn't match, the token expired before now, or the token expires more than
+// maxLifetime after now.
+func ValidateSupportSession(token, key string, now time.Time, maxLifetime time.Duration) (actor, owner string, ok bool) {
+	parts := strings.SplitN(token, ".", 4)
+	if len(parts) != 4 || parts[2] == "" || parts[3] == "" {
+		return
+	}
+	_, sig, err := mbase.Decode(parts[0])
+	if err != nil {
+		return
+	}
+	_, sec, err := mbase.Decode(key)
+	if err != nil {
+		return
+	}
+	date, err := time.Parse(time.RFC3339, parts[1])
+	if err != nil || !date.After(now) || date.After(now.Add(maxLifetime)) {
+		return
+	}
+	hash := hmac.New(sha256.New, sec)
+	if _, err = hash.Write([]byte(parts[1] + "." + parts[2] + "." + parts[3])); err != nil {
+		return
+	}
+	if !hmac.Equal(sig, hash.Sum(nil)) {
+		return
+	}
+	return parts[3], parts[2], true
+}
+
+// NewSupportSessionContext adds a support-session token to a context.
+func NewSupportSessionContext(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("supportSession"), token)
+}
+
+// SupportSessionFromContext returns a support-session token from a context.
+func SupportSessionFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(ctxKey("supportSession")).(string)
+	return token, ok
+}
+
+// SupportSessionFromMD returns a support-session token from context metadata.
+func SupportSessionFromMD(ctx context.Context) (token string, ok bool) {
+	token = metautils.ExtractIncoming(ctx).Get("x-textile-support-session")
+	if token != "" {
+		ok = true
+	}
+	return
+}
+
+// NewUploadContext adds an upload ID to a context, which correlates the PushPath requests of a
+// chunked upload. Set finalize on the request that completes the upload.
+func NewUploadContext(ctx context.Context, id string, finalize bool) context.Context {
+	if id == "" {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, ctxKey("uploadID"), id)
+	return context.WithValue(ctx, ctxKey("uploadFinalize"), finalize)
+}
+
+// UploadFromContext returns an upload ID and whether or not it's finalized from a context.
+func UploadFromContext(ctx context.Context) (id string, finalize bool, ok bool) {
+	id, ok = ctx.Value(ctxKey("uploadID")).(string)
+	finalize, _ = ctx.Value(ctxKey("uploadFinalize")).(bool)
+	return id, finalize, ok
+}
+
+// UploadFromMD returns an upload ID and whether or not it's finalized from context metadata.
+func UploadFromMD(ctx context.Context) (id string, finalize bool, ok bool) {
+	md := metautils.ExtractIncoming(ctx)
+	id = md.Get("x-textile-upload-id")
+	if id != "" {
+		ok = true
+	}
+	finalize = md.Get("x-textile-upload-finalize") == "true"
+	return
+}
+
+// EstimatedCostFromTrailer returns the estimated cost in USD of a request from its trailer.
+func EstimatedCostFromTrailer(trailer metadata.MD) (cost float64, ok bool) {
+	vals := trailer.Get(EstimatedCostKey)
+	if len(vals) == 0 {
+		return
+	}
+	cost, err := strconv.ParseFloat(vals[0], 64)
+	if err != nil {
+		return 0, false
+	}
+	return cost, true
+}
+
 // Credentials implements grpc.PerRPCCredentials.
 type Credentials struct {
 	Secure bool
@@ -259,6 +479,31 @@ func (c Credentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[s
 	if ok {
 		md["x-textile-thread-name"] = threadName
 	}
+	reservation, ok := StorageReservationFromContext(ctx)
+	if ok {
+		md["x-textile-storage-reservation"] = reservation
+	}
+	adminToken, ok := AdminTokenFromContext(ctx)
+	if ok {
+		md["x-textile-admin-token"] = adminToken
+	}
+	requestID, ok := RequestIDFromContext(ctx)
+	if ok {
+		md["x-textile-request-id"] = requestID
+	}
+	idempotencyKey, ok := IdempotencyKeyFromContext(ctx)
+	if ok {
+		md["x-textile-idempotency-key"] = idempotencyKey
+	}
+	supportSession, ok := SupportSessionFromContext(ctx)
+	if ok {
+		md["x-textile-support-session"] = supportSession
+	}
+	uploadID, finalize, ok := UploadFromContext(ctx)
+	if ok {
+		md["x-textile-upload-id"] = uploadID
+		md["x-textile-upload-finalize"] = strconv.FormatBool(finalize)
+	}
 	threadToken, ok := thread.TokenFromContext(ctx)
 	if ok {
 		md["authorization"] = "bearer " + string(threadToken)
@@ -2,7 +2,9 @@ package client
 
 import (
 	"context"
+	"time"
 
+	"github.com/textileio/go-threads/core/thread"
 	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"google.golang.org/grpc"
 )
@@ -147,6 +149,51 @@ func (c *Client) ListBillingUsers(ctx context.Context, opts ...ListOption) (
 	})
 }
 
+// RecalculateStorage recomputes an owner's stored data from their buckets and overwrites the billed total.
+// The context must carry the admin token, see common.NewAdminTokenContext.
+func (c *Client) RecalculateStorage(ctx context.Context, key thread.PubKey) (*pb.RecalculateStorageResponse, error) {
+	return c.c.RecalculateStorage(ctx, &pb.RecalculateStorageRequest{
+		Key: key.String(),
+	})
+}
+
+// BatchGetUsage returns the daily usage of many owners. Owners without a billing customer are listed as missing.
+// The context must carry the admin token, see common.NewAdminTokenContext.
+func (c *Client) BatchGetUsage(ctx context.Context, keys []thread.PubKey) (*pb.BatchGetUsageResponse, error) {
+	req := &pb.BatchGetUsageRequest{Keys: make([]string, len(keys))}
+	for i, k := range keys {
+		req.Keys[i] = k.String()
+	}
+	return c.c.BatchGetUsage(ctx, req)
+}
+
+// BillingSelfTest runs a synthetic request through the billing path and returns the result of each step.
+// The context must carry the admin token, see common.NewAdminTokenContext.
+func (c *Client) BillingSelfTest(ctx context.Context) (*pb.BillingSelfTestResponse, error) {
+	return c.c.BillingSelfTest(ctx, &pb.BillingSelfTestRequest{})
+}
+
+// SetBillingChaos injects latency and a fraction of errors into the hub's billing calls, which requires
+// billing chaos to be enabled in the hub's config. Zero values stop injecting chaos.
+// The context must carry the admin token, see common.NewAdminTokenContext.
+func (c *Client) SetBillingChaos(ctx context.Context, latency time.Duration, errorRate float64) error {
+	_, err := c.c.SetBillingChaos(ctx, &pb.SetBillingChaosRequest{
+		LatencyNs: int64(latency),
+		ErrorRate: errorRate,
+	})
+	return err
+}
+
+// InvalidateCustomerCache drops an owner's cached usage decisions so that changes to their quotas,
+// e.g., an upgrade, apply to their next request.
+// The context must carry the admin token, see common.NewAdminTokenContext.
+func (c *Client) InvalidateCustomerCache(ctx context.Context, key thread.PubKey) error {
+	_, err := c.c.InvalidateCustomerCache(ctx, &pb.InvalidateCustomerCacheRequest{
+		Key: key.String(),
+	})
+	return err
+}
+
 // IsUsernameAvailable returns a nil error if the username is valid and available.
 func (c *Client) IsUsernameAvailable(ctx context.Context, username string) error {
 	_, err := c.c.IsUsernameAvailable(ctx, &pb.IsUsernameAvailableRequest{
@@ -2,13 +2,17 @@ package client_test
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tc "github.com/textileio/go-threads/api/client"
@@ -20,6 +24,9 @@ import (
 	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"github.com/textileio/textile/v2/core"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestMain(m *testing.M) {
@@ -405,6 +412,258 @@ func TestClient_GetBillingSession(t *testing.T) {
 	})
 }
 
+func TestClient_RecalculateStorage(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = "admin"
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	key := &thread.Libp2pPubKey{}
+	err = key.UnmarshalBinary(user.Key)
+	require.NoError(t, err)
+
+	t.Run("without admin token", func(t *testing.T) {
+		_, err := client.RecalculateStorage(common.NewSessionContext(ctx, user.Session), key)
+		require.Error(t, err)
+		_, err = client.RecalculateStorage(common.NewAdminTokenContext(ctx, "wrong"), key)
+		require.Error(t, err)
+	})
+
+	t.Run("with admin token", func(t *testing.T) {
+		res, err := client.RecalculateStorage(common.NewAdminTokenContext(ctx, conf.AdminToken), key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), res.Total)
+	})
+}
+
+func TestClient_BatchGetUsage(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = "admin"
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	key := &thread.Libp2pPubKey{}
+	err = key.UnmarshalBinary(user.Key)
+	require.NoError(t, err)
+	_, missing, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	missingKey := thread.NewLibp2pPubKey(missing)
+
+	t.Run("without admin token", func(t *testing.T) {
+		_, err := client.BatchGetUsage(common.NewSessionContext(ctx, user.Session), []thread.PubKey{key})
+		require.Error(t, err)
+	})
+
+	t.Run("with admin token", func(t *testing.T) {
+		res, err := client.BatchGetUsage(common.NewAdminTokenContext(ctx, conf.AdminToken),
+			[]thread.PubKey{key, missingKey})
+		require.NoError(t, err)
+		require.Contains(t, res.Usage, key.String())
+		assert.NotEmpty(t, res.Usage[key.String()].DailyUsage)
+		assert.Equal(t, []string{missingKey.String()}, res.Missing)
+	})
+}
+
+func TestClient_BillingSelfTest(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = "admin"
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	t.Run("without admin token", func(t *testing.T) {
+		_, err := client.BillingSelfTest(ctx)
+		require.Error(t, err)
+	})
+
+	t.Run("with admin token", func(t *testing.T) {
+		res, err := client.BillingSelfTest(common.NewAdminTokenContext(ctx, conf.AdminToken))
+		require.NoError(t, err)
+		assert.True(t, res.Ok)
+		require.Len(t, res.Steps, 5)
+		for _, step := range res.Steps {
+			assert.True(t, step.Ok, step.Error)
+		}
+	})
+}
+
+func TestClient_SetBillingChaos(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = "admin"
+	conf.BillingChaos = true
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	t.Run("without admin token", func(t *testing.T) {
+		err := client.SetBillingChaos(ctx, time.Millisecond, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("with admin token", func(t *testing.T) {
+		actx := common.NewAdminTokenContext(ctx, conf.AdminToken)
+		err := client.SetBillingChaos(actx, time.Millisecond, 0.5)
+		require.NoError(t, err)
+		err = client.SetBillingChaos(actx, 0, 2)
+		require.Error(t, err)
+		err = client.SetBillingChaos(actx, 0, 0)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_InvalidateCustomerCache(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = "admin"
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	key := &thread.Libp2pPubKey{}
+	err = key.UnmarshalBinary(user.Key)
+	require.NoError(t, err)
+
+	t.Run("without admin token", func(t *testing.T) {
+		err := client.InvalidateCustomerCache(common.NewSessionContext(ctx, user.Session), key)
+		require.Error(t, err)
+	})
+
+	t.Run("with admin token", func(t *testing.T) {
+		err := client.InvalidateCustomerCache(common.NewAdminTokenContext(ctx, conf.AdminToken), key)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_AdminAuthorizer(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = "admin"
+	conf.AdminAuthorizer = func(ctx context.Context, method string) error {
+		assert.Equal(t, "/api.hubd.pb.APIService/InvalidateCustomerCache", method)
+		md, _ := metadata.FromIncomingContext(ctx)
+		if roles := md.Get("x-role"); len(roles) == 0 || roles[0] != "admin" {
+			return errors.New("admin role required")
+		}
+		return nil
+	}
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	key := &thread.Libp2pPubKey{}
+	err = key.UnmarshalBinary(user.Key)
+	require.NoError(t, err)
+
+	t.Run("denied", func(t *testing.T) {
+		// The admin token is ignored when there's an authorizer.
+		err := client.InvalidateCustomerCache(common.NewAdminTokenContext(ctx, conf.AdminToken), key)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		err = client.InvalidateCustomerCache(metadata.AppendToOutgoingContext(ctx, "x-role", "member"), key)
+		require.Error(t, err)
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		err := client.InvalidateCustomerCache(metadata.AppendToOutgoingContext(ctx, "x-role", "admin"), key)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_AdminTokenPowergateFallback(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.PowergateAdminToken = "pow-admin"
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	key := &thread.Libp2pPubKey{}
+	err = key.UnmarshalBinary(user.Key)
+	require.NoError(t, err)
+
+	t.Run("without admin token", func(t *testing.T) {
+		err := client.InvalidateCustomerCache(ctx, key)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		err = client.InvalidateCustomerCache(common.NewAdminTokenContext(ctx, "wrong"), key)
+		require.Error(t, err)
+	})
+
+	t.Run("with powergate admin token", func(t *testing.T) {
+		err := client.InvalidateCustomerCache(common.NewAdminTokenContext(ctx, conf.PowergateAdminToken), key)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_AdminTokenUnset(t *testing.T) {
+	t.Parallel()
+	bconf := apitest.DefaultBillingConfig(t)
+	apitest.MakeBillingWithConfig(t, bconf)
+	conf := apitest.DefaultTextileConfig(t)
+	billingApi, err := tutil.TCPAddrFromMultiAddr(bconf.ListenAddr)
+	require.NoError(t, err)
+	conf.AddrBillingAPI = billingApi
+	conf.AdminToken = ""
+	conf.PowergateAdminToken = ""
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	key := &thread.Libp2pPubKey{}
+	err = key.UnmarshalBinary(user.Key)
+	require.NoError(t, err)
+
+	// All admin calls are rejected.
+	err = client.InvalidateCustomerCache(ctx, key)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = client.InvalidateCustomerCache(common.NewAdminTokenContext(ctx, "admin"), key)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.BillingSelfTest(common.NewAdminTokenContext(ctx, "admin"))
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestClient_IsUsernameAvailable(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t, nil)
@@ -1905,6 +1905,481 @@ func (x *ListBillingUsersResponse) GetNextOffset() int64 {
 	return 0
 }
 
+type RecalculateStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *RecalculateStorageRequest) Reset() {
+	*x = RecalculateStorageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecalculateStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateStorageRequest) ProtoMessage() {}
+
+func (x *RecalculateStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateStorageRequest.ProtoReflect.Descriptor instead.
+func (*RecalculateStorageRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RecalculateStorageRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RecalculateStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Previous int64 `protobuf:"varint,1,opt,name=previous,proto3" json:"previous,omitempty"`
+	Total    int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *RecalculateStorageResponse) Reset() {
+	*x = RecalculateStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecalculateStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateStorageResponse) ProtoMessage() {}
+
+func (x *RecalculateStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateStorageResponse.ProtoReflect.Descriptor instead.
+func (*RecalculateStorageResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RecalculateStorageResponse) GetPrevious() int64 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *RecalculateStorageResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type BatchGetUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *BatchGetUsageRequest) Reset() {
+	*x = BatchGetUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsageRequest) ProtoMessage() {}
+
+func (x *BatchGetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsageRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchGetUsageRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type BatchGetUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage   map[string]*BatchGetUsageResponse_OwnerUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Missing []string                                     `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+}
+
+func (x *BatchGetUsageResponse) Reset() {
+	*x = BatchGetUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsageResponse) ProtoMessage() {}
+
+func (x *BatchGetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsageResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchGetUsageResponse) GetUsage() map[string]*BatchGetUsageResponse_OwnerUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *BatchGetUsageResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type BillingSelfTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BillingSelfTestRequest) Reset() {
+	*x = BillingSelfTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillingSelfTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingSelfTestRequest) ProtoMessage() {}
+
+func (x *BillingSelfTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingSelfTestRequest.ProtoReflect.Descriptor instead.
+func (*BillingSelfTestRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{42}
+}
+
+type BillingSelfTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*BillingSelfTestResponse_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	Ok    bool                            `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *BillingSelfTestResponse) Reset() {
+	*x = BillingSelfTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillingSelfTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingSelfTestResponse) ProtoMessage() {}
+
+func (x *BillingSelfTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingSelfTestResponse.ProtoReflect.Descriptor instead.
+func (*BillingSelfTestResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BillingSelfTestResponse) GetSteps() []*BillingSelfTestResponse_Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *BillingSelfTestResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type SetBillingChaosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LatencyNs int64   `protobuf:"varint,1,opt,name=latency_ns,json=latencyNs,proto3" json:"latency_ns,omitempty"`
+	ErrorRate float64 `protobuf:"fixed64,2,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+}
+
+func (x *SetBillingChaosRequest) Reset() {
+	*x = SetBillingChaosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBillingChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBillingChaosRequest) ProtoMessage() {}
+
+func (x *SetBillingChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBillingChaosRequest.ProtoReflect.Descriptor instead.
+func (*SetBillingChaosRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetBillingChaosRequest) GetLatencyNs() int64 {
+	if x != nil {
+		return x.LatencyNs
+	}
+	return 0
+}
+
+func (x *SetBillingChaosRequest) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+type SetBillingChaosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetBillingChaosResponse) Reset() {
+	*x = SetBillingChaosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBillingChaosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBillingChaosResponse) ProtoMessage() {}
+
+func (x *SetBillingChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBillingChaosResponse.ProtoReflect.Descriptor instead.
+func (*SetBillingChaosResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{45}
+}
+
+type InvalidateCustomerCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *InvalidateCustomerCacheRequest) Reset() {
+	*x = InvalidateCustomerCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateCustomerCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCustomerCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateCustomerCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCustomerCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateCustomerCacheRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InvalidateCustomerCacheRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type InvalidateCustomerCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InvalidateCustomerCacheResponse) Reset() {
+	*x = InvalidateCustomerCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateCustomerCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCustomerCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateCustomerCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCustomerCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCustomerCacheResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{47}
+}
+
 type IsUsernameAvailableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1916,7 +2391,7 @@ type IsUsernameAvailableRequest struct {
 func (x *IsUsernameAvailableRequest) Reset() {
 	*x = IsUsernameAvailableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2404,7 @@ func (x *IsUsernameAvailableRequest) String() string {
 func (*IsUsernameAvailableRequest) ProtoMessage() {}
 
 func (x *IsUsernameAvailableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2417,7 @@ func (x *IsUsernameAvailableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsUsernameAvailableRequest.ProtoReflect.Descriptor instead.
 func (*IsUsernameAvailableRequest) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{38}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *IsUsernameAvailableRequest) GetUsername() string {
@@ -1961,7 +2436,7 @@ type IsUsernameAvailableResponse struct {
 func (x *IsUsernameAvailableResponse) Reset() {
 	*x = IsUsernameAvailableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[39]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1974,7 +2449,7 @@ func (x *IsUsernameAvailableResponse) String() string {
 func (*IsUsernameAvailableResponse) ProtoMessage() {}
 
 func (x *IsUsernameAvailableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[39]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,7 +2462,7 @@ func (x *IsUsernameAvailableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsUsernameAvailableResponse.ProtoReflect.Descriptor instead.
 func (*IsUsernameAvailableResponse) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{39}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{49}
 }
 
 type IsOrgNameAvailableRequest struct {
@@ -2001,7 +2476,7 @@ type IsOrgNameAvailableRequest struct {
 func (x *IsOrgNameAvailableRequest) Reset() {
 	*x = IsOrgNameAvailableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[40]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2014,7 +2489,7 @@ func (x *IsOrgNameAvailableRequest) String() string {
 func (*IsOrgNameAvailableRequest) ProtoMessage() {}
 
 func (x *IsOrgNameAvailableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[40]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,7 +2502,7 @@ func (x *IsOrgNameAvailableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsOrgNameAvailableRequest.ProtoReflect.Descriptor instead.
 func (*IsOrgNameAvailableRequest) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{40}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *IsOrgNameAvailableRequest) GetName() string {
@@ -2049,7 +2524,7 @@ type IsOrgNameAvailableResponse struct {
 func (x *IsOrgNameAvailableResponse) Reset() {
 	*x = IsOrgNameAvailableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[41]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2062,7 +2537,7 @@ func (x *IsOrgNameAvailableResponse) String() string {
 func (*IsOrgNameAvailableResponse) ProtoMessage() {}
 
 func (x *IsOrgNameAvailableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[41]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,7 +2550,7 @@ func (x *IsOrgNameAvailableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsOrgNameAvailableResponse.ProtoReflect.Descriptor instead.
 func (*IsOrgNameAvailableResponse) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{41}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *IsOrgNameAvailableResponse) GetSlug() string {
@@ -2101,7 +2576,7 @@ type DestroyAccountRequest struct {
 func (x *DestroyAccountRequest) Reset() {
 	*x = DestroyAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[42]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2114,7 +2589,7 @@ func (x *DestroyAccountRequest) String() string {
 func (*DestroyAccountRequest) ProtoMessage() {}
 
 func (x *DestroyAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[42]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,7 +2602,7 @@ func (x *DestroyAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyAccountRequest.ProtoReflect.Descriptor instead.
 func (*DestroyAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{42}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{52}
 }
 
 type DestroyAccountResponse struct {
@@ -2139,7 +2614,7 @@ type DestroyAccountResponse struct {
 func (x *DestroyAccountResponse) Reset() {
 	*x = DestroyAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[43]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2152,7 +2627,7 @@ func (x *DestroyAccountResponse) String() string {
 func (*DestroyAccountResponse) ProtoMessage() {}
 
 func (x *DestroyAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[43]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,7 +2640,7 @@ func (x *DestroyAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyAccountResponse.ProtoReflect.Descriptor instead.
 func (*DestroyAccountResponse) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{43}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{53}
 }
 
 type OrgInfo_Member struct {
@@ -2181,7 +2656,7 @@ type OrgInfo_Member struct {
 func (x *OrgInfo_Member) Reset() {
 	*x = OrgInfo_Member{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[44]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2194,7 +2669,7 @@ func (x *OrgInfo_Member) String() string {
 func (*OrgInfo_Member) ProtoMessage() {}
 
 func (x *OrgInfo_Member) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[44]
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,25 +2685,143 @@ func (*OrgInfo_Member) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *OrgInfo_Member) GetKey() []byte {
+func (x *OrgInfo_Member) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *OrgInfo_Member) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OrgInfo_Member) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type BatchGetUsageResponse_OwnerUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DailyUsage map[string]*pb.Usage `protobuf:"bytes,1,rep,name=daily_usage,json=dailyUsage,proto3" json:"daily_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BatchGetUsageResponse_OwnerUsage) Reset() {
+	*x = BatchGetUsageResponse_OwnerUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsageResponse_OwnerUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsageResponse_OwnerUsage) ProtoMessage() {}
+
+func (x *BatchGetUsageResponse_OwnerUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsageResponse_OwnerUsage.ProtoReflect.Descriptor instead.
+func (*BatchGetUsageResponse_OwnerUsage) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{41, 1}
+}
+
+func (x *BatchGetUsageResponse_OwnerUsage) GetDailyUsage() map[string]*pb.Usage {
+	if x != nil {
+		return x.DailyUsage
+	}
+	return nil
+}
+
+type BillingSelfTestResponse_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ok        bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error     string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyNs int64  `protobuf:"varint,4,opt,name=latency_ns,json=latencyNs,proto3" json:"latency_ns,omitempty"`
+}
+
+func (x *BillingSelfTestResponse_Step) Reset() {
+	*x = BillingSelfTestResponse_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillingSelfTestResponse_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingSelfTestResponse_Step) ProtoMessage() {}
+
+func (x *BillingSelfTestResponse_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingSelfTestResponse_Step.ProtoReflect.Descriptor instead.
+func (*BillingSelfTestResponse_Step) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *BillingSelfTestResponse_Step) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BillingSelfTestResponse_Step) GetOk() bool {
 	if x != nil {
-		return x.Key
+		return x.Ok
 	}
-	return nil
+	return false
 }
 
-func (x *OrgInfo_Member) GetUsername() string {
+func (x *BillingSelfTestResponse_Step) GetError() string {
 	if x != nil {
-		return x.Username
+		return x.Error
 	}
 	return ""
 }
 
-func (x *OrgInfo_Member) GetRole() string {
+func (x *BillingSelfTestResponse_Step) GetLatencyNs() int64 {
 	if x != nil {
-		return x.Role
+		return x.LatencyNs
 	}
-	return ""
+	return 0
 }
 
 var File_api_hubd_pb_hubd_proto protoreflect.FileDescriptor
@@ -2375,119 +2968,215 @@ var file_api_hubd_pb_hubd_proto_rawDesc = []byte{
 	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
 	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x22, 0x38, 0x0a, 0x1a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x49,
-	0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x49, 0x73,
-	0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x1a, 0x49,
-	0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65,
-	0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12,
-	0x11, 0x0a, 0x0d, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
-	0x10, 0x02, 0x32, 0xed, 0x0d, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4c, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x12, 0x1a,
+	0x74, 0x22, 0x2d, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x4e, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x2a, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xa5, 0x03, 0x0a,
+	0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x1a, 0x67, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc3,
+	0x01, 0x0a, 0x0a, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x5e, 0x0a,
+	0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x55, 0x0a,
+	0x0f, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcb,
+	0x01, 0x0a, 0x17, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x1a, 0x5f, 0x0a, 0x04, 0x53,
+	0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x73, 0x22, 0x56, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x61, 0x74, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x32, 0x0a, 0x1e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x21, 0x0a, 0x1f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x1a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x1d, 0x0a, 0x1b, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2f, 0x0a, 0x19, 0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x44, 0x0a, 0x1a, 0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x18, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c, 0x0a, 0x07, 0x4b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x32, 0xe8, 0x11, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1a,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x53, 0x69, 0x67,
-	0x6e, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x67, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x49,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x12, 0x1a, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67,
-	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x52, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67,
-	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72,
-	0x67, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x55, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x08, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4f, 0x72, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x4f, 0x72, 0x67, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x42,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
 	0x12, 0x6a, 0x0a, 0x13, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76,
 	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
 	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
@@ -2526,113 +3215,143 @@ func file_api_hubd_pb_hubd_proto_rawDescGZIP() []byte {
 }
 
 var file_api_hubd_pb_hubd_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_hubd_pb_hubd_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_api_hubd_pb_hubd_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_api_hubd_pb_hubd_proto_goTypes = []interface{}{
-	(KeyType)(0),                        // 0: api.hubd.pb.KeyType
-	(*BuildInfoRequest)(nil),            // 1: api.hubd.pb.BuildInfoRequest
-	(*BuildInfoResponse)(nil),           // 2: api.hubd.pb.BuildInfoResponse
-	(*SignupRequest)(nil),               // 3: api.hubd.pb.SignupRequest
-	(*SignupResponse)(nil),              // 4: api.hubd.pb.SignupResponse
-	(*SigninRequest)(nil),               // 5: api.hubd.pb.SigninRequest
-	(*SigninResponse)(nil),              // 6: api.hubd.pb.SigninResponse
-	(*SignoutRequest)(nil),              // 7: api.hubd.pb.SignoutRequest
-	(*SignoutResponse)(nil),             // 8: api.hubd.pb.SignoutResponse
-	(*GetSessionInfoRequest)(nil),       // 9: api.hubd.pb.GetSessionInfoRequest
-	(*GetSessionInfoResponse)(nil),      // 10: api.hubd.pb.GetSessionInfoResponse
-	(*GetIdentityRequest)(nil),          // 11: api.hubd.pb.GetIdentityRequest
-	(*GetIdentityResponse)(nil),         // 12: api.hubd.pb.GetIdentityResponse
-	(*KeyInfo)(nil),                     // 13: api.hubd.pb.KeyInfo
-	(*CreateKeyRequest)(nil),            // 14: api.hubd.pb.CreateKeyRequest
-	(*CreateKeyResponse)(nil),           // 15: api.hubd.pb.CreateKeyResponse
-	(*InvalidateKeyRequest)(nil),        // 16: api.hubd.pb.InvalidateKeyRequest
-	(*InvalidateKeyResponse)(nil),       // 17: api.hubd.pb.InvalidateKeyResponse
-	(*ListKeysRequest)(nil),             // 18: api.hubd.pb.ListKeysRequest
-	(*ListKeysResponse)(nil),            // 19: api.hubd.pb.ListKeysResponse
-	(*OrgInfo)(nil),                     // 20: api.hubd.pb.OrgInfo
-	(*CreateOrgRequest)(nil),            // 21: api.hubd.pb.CreateOrgRequest
-	(*CreateOrgResponse)(nil),           // 22: api.hubd.pb.CreateOrgResponse
-	(*GetOrgRequest)(nil),               // 23: api.hubd.pb.GetOrgRequest
-	(*GetOrgResponse)(nil),              // 24: api.hubd.pb.GetOrgResponse
-	(*ListOrgsRequest)(nil),             // 25: api.hubd.pb.ListOrgsRequest
-	(*ListOrgsResponse)(nil),            // 26: api.hubd.pb.ListOrgsResponse
-	(*RemoveOrgRequest)(nil),            // 27: api.hubd.pb.RemoveOrgRequest
-	(*RemoveOrgResponse)(nil),           // 28: api.hubd.pb.RemoveOrgResponse
-	(*InviteToOrgRequest)(nil),          // 29: api.hubd.pb.InviteToOrgRequest
-	(*InviteToOrgResponse)(nil),         // 30: api.hubd.pb.InviteToOrgResponse
-	(*LeaveOrgRequest)(nil),             // 31: api.hubd.pb.LeaveOrgRequest
-	(*LeaveOrgResponse)(nil),            // 32: api.hubd.pb.LeaveOrgResponse
-	(*SetupBillingRequest)(nil),         // 33: api.hubd.pb.SetupBillingRequest
-	(*SetupBillingResponse)(nil),        // 34: api.hubd.pb.SetupBillingResponse
-	(*GetBillingSessionRequest)(nil),    // 35: api.hubd.pb.GetBillingSessionRequest
-	(*GetBillingSessionResponse)(nil),   // 36: api.hubd.pb.GetBillingSessionResponse
-	(*ListBillingUsersRequest)(nil),     // 37: api.hubd.pb.ListBillingUsersRequest
-	(*ListBillingUsersResponse)(nil),    // 38: api.hubd.pb.ListBillingUsersResponse
-	(*IsUsernameAvailableRequest)(nil),  // 39: api.hubd.pb.IsUsernameAvailableRequest
-	(*IsUsernameAvailableResponse)(nil), // 40: api.hubd.pb.IsUsernameAvailableResponse
-	(*IsOrgNameAvailableRequest)(nil),   // 41: api.hubd.pb.IsOrgNameAvailableRequest
-	(*IsOrgNameAvailableResponse)(nil),  // 42: api.hubd.pb.IsOrgNameAvailableResponse
-	(*DestroyAccountRequest)(nil),       // 43: api.hubd.pb.DestroyAccountRequest
-	(*DestroyAccountResponse)(nil),      // 44: api.hubd.pb.DestroyAccountResponse
-	(*OrgInfo_Member)(nil),              // 45: api.hubd.pb.OrgInfo.Member
-	(*pb.GetCustomerResponse)(nil),      // 46: api.billingd.pb.GetCustomerResponse
+	(KeyType)(0),                             // 0: api.hubd.pb.KeyType
+	(*BuildInfoRequest)(nil),                 // 1: api.hubd.pb.BuildInfoRequest
+	(*BuildInfoResponse)(nil),                // 2: api.hubd.pb.BuildInfoResponse
+	(*SignupRequest)(nil),                    // 3: api.hubd.pb.SignupRequest
+	(*SignupResponse)(nil),                   // 4: api.hubd.pb.SignupResponse
+	(*SigninRequest)(nil),                    // 5: api.hubd.pb.SigninRequest
+	(*SigninResponse)(nil),                   // 6: api.hubd.pb.SigninResponse
+	(*SignoutRequest)(nil),                   // 7: api.hubd.pb.SignoutRequest
+	(*SignoutResponse)(nil),                  // 8: api.hubd.pb.SignoutResponse
+	(*GetSessionInfoRequest)(nil),            // 9: api.hubd.pb.GetSessionInfoRequest
+	(*GetSessionInfoResponse)(nil),           // 10: api.hubd.pb.GetSessionInfoResponse
+	(*GetIdentityRequest)(nil),               // 11: api.hubd.pb.GetIdentityRequest
+	(*GetIdentityResponse)(nil),              // 12: api.hubd.pb.GetIdentityResponse
+	(*KeyInfo)(nil),                          // 13: api.hubd.pb.KeyInfo
+	(*CreateKeyRequest)(nil),                 // 14: api.hubd.pb.CreateKeyRequest
+	(*CreateKeyResponse)(nil),                // 15: api.hubd.pb.CreateKeyResponse
+	(*InvalidateKeyRequest)(nil),             // 16: api.hubd.pb.InvalidateKeyRequest
+	(*InvalidateKeyResponse)(nil),            // 17: api.hubd.pb.InvalidateKeyResponse
+	(*ListKeysRequest)(nil),                  // 18: api.hubd.pb.ListKeysRequest
+	(*ListKeysResponse)(nil),                 // 19: api.hubd.pb.ListKeysResponse
+	(*OrgInfo)(nil),                          // 20: api.hubd.pb.OrgInfo
+	(*CreateOrgRequest)(nil),                 // 21: api.hubd.pb.CreateOrgRequest
+	(*CreateOrgResponse)(nil),                // 22: api.hubd.pb.CreateOrgResponse
+	(*GetOrgRequest)(nil),                    // 23: api.hubd.pb.GetOrgRequest
+	(*GetOrgResponse)(nil),                   // 24: api.hubd.pb.GetOrgResponse
+	(*ListOrgsRequest)(nil),                  // 25: api.hubd.pb.ListOrgsRequest
+	(*ListOrgsResponse)(nil),                 // 26: api.hubd.pb.ListOrgsResponse
+	(*RemoveOrgRequest)(nil),                 // 27: api.hubd.pb.RemoveOrgRequest
+	(*RemoveOrgResponse)(nil),                // 28: api.hubd.pb.RemoveOrgResponse
+	(*InviteToOrgRequest)(nil),               // 29: api.hubd.pb.InviteToOrgRequest
+	(*InviteToOrgResponse)(nil),              // 30: api.hubd.pb.InviteToOrgResponse
+	(*LeaveOrgRequest)(nil),                  // 31: api.hubd.pb.LeaveOrgRequest
+	(*LeaveOrgResponse)(nil),                 // 32: api.hubd.pb.LeaveOrgResponse
+	(*SetupBillingRequest)(nil),              // 33: api.hubd.pb.SetupBillingRequest
+	(*SetupBillingResponse)(nil),             // 34: api.hubd.pb.SetupBillingResponse
+	(*GetBillingSessionRequest)(nil),         // 35: api.hubd.pb.GetBillingSessionRequest
+	(*GetBillingSessionResponse)(nil),        // 36: api.hubd.pb.GetBillingSessionResponse
+	(*ListBillingUsersRequest)(nil),          // 37: api.hubd.pb.ListBillingUsersRequest
+	(*ListBillingUsersResponse)(nil),         // 38: api.hubd.pb.ListBillingUsersResponse
+	(*RecalculateStorageRequest)(nil),        // 39: api.hubd.pb.RecalculateStorageRequest
+	(*RecalculateStorageResponse)(nil),       // 40: api.hubd.pb.RecalculateStorageResponse
+	(*BatchGetUsageRequest)(nil),             // 41: api.hubd.pb.BatchGetUsageRequest
+	(*BatchGetUsageResponse)(nil),            // 42: api.hubd.pb.BatchGetUsageResponse
+	(*BillingSelfTestRequest)(nil),           // 43: api.hubd.pb.BillingSelfTestRequest
+	(*BillingSelfTestResponse)(nil),          // 44: api.hubd.pb.BillingSelfTestResponse
+	(*SetBillingChaosRequest)(nil),           // 45: api.hubd.pb.SetBillingChaosRequest
+	(*SetBillingChaosResponse)(nil),          // 46: api.hubd.pb.SetBillingChaosResponse
+	(*InvalidateCustomerCacheRequest)(nil),   // 47: api.hubd.pb.InvalidateCustomerCacheRequest
+	(*InvalidateCustomerCacheResponse)(nil),  // 48: api.hubd.pb.InvalidateCustomerCacheResponse
+	(*IsUsernameAvailableRequest)(nil),       // 49: api.hubd.pb.IsUsernameAvailableRequest
+	(*IsUsernameAvailableResponse)(nil),      // 50: api.hubd.pb.IsUsernameAvailableResponse
+	(*IsOrgNameAvailableRequest)(nil),        // 51: api.hubd.pb.IsOrgNameAvailableRequest
+	(*IsOrgNameAvailableResponse)(nil),       // 52: api.hubd.pb.IsOrgNameAvailableResponse
+	(*DestroyAccountRequest)(nil),            // 53: api.hubd.pb.DestroyAccountRequest
+	(*DestroyAccountResponse)(nil),           // 54: api.hubd.pb.DestroyAccountResponse
+	(*OrgInfo_Member)(nil),                   // 55: api.hubd.pb.OrgInfo.Member
+	nil,                                      // 56: api.hubd.pb.BatchGetUsageResponse.UsageEntry
+	(*BatchGetUsageResponse_OwnerUsage)(nil), // 57: api.hubd.pb.BatchGetUsageResponse.OwnerUsage
+	nil,                                      // 58: api.hubd.pb.BatchGetUsageResponse.OwnerUsage.DailyUsageEntry
+	(*BillingSelfTestResponse_Step)(nil),     // 59: api.hubd.pb.BillingSelfTestResponse.Step
+	(*pb.GetCustomerResponse)(nil),           // 60: api.billingd.pb.GetCustomerResponse
+	(*pb.Usage)(nil),                         // 61: api.billingd.pb.Usage
 }
 var file_api_hubd_pb_hubd_proto_depIdxs = []int32{
 	0,  // 0: api.hubd.pb.KeyInfo.type:type_name -> api.hubd.pb.KeyType
 	0,  // 1: api.hubd.pb.CreateKeyRequest.type:type_name -> api.hubd.pb.KeyType
 	13, // 2: api.hubd.pb.CreateKeyResponse.key_info:type_name -> api.hubd.pb.KeyInfo
 	13, // 3: api.hubd.pb.ListKeysResponse.list:type_name -> api.hubd.pb.KeyInfo
-	45, // 4: api.hubd.pb.OrgInfo.members:type_name -> api.hubd.pb.OrgInfo.Member
+	55, // 4: api.hubd.pb.OrgInfo.members:type_name -> api.hubd.pb.OrgInfo.Member
 	20, // 5: api.hubd.pb.CreateOrgResponse.org_info:type_name -> api.hubd.pb.OrgInfo
 	20, // 6: api.hubd.pb.GetOrgResponse.org_info:type_name -> api.hubd.pb.OrgInfo
 	20, // 7: api.hubd.pb.ListOrgsResponse.list:type_name -> api.hubd.pb.OrgInfo
-	46, // 8: api.hubd.pb.ListBillingUsersResponse.users:type_name -> api.billingd.pb.GetCustomerResponse
-	1,  // 9: api.hubd.pb.APIService.BuildInfo:input_type -> api.hubd.pb.BuildInfoRequest
-	3,  // 10: api.hubd.pb.APIService.Signup:input_type -> api.hubd.pb.SignupRequest
-	5,  // 11: api.hubd.pb.APIService.Signin:input_type -> api.hubd.pb.SigninRequest
-	7,  // 12: api.hubd.pb.APIService.Signout:input_type -> api.hubd.pb.SignoutRequest
-	9,  // 13: api.hubd.pb.APIService.GetSessionInfo:input_type -> api.hubd.pb.GetSessionInfoRequest
-	11, // 14: api.hubd.pb.APIService.GetIdentity:input_type -> api.hubd.pb.GetIdentityRequest
-	14, // 15: api.hubd.pb.APIService.CreateKey:input_type -> api.hubd.pb.CreateKeyRequest
-	18, // 16: api.hubd.pb.APIService.ListKeys:input_type -> api.hubd.pb.ListKeysRequest
-	16, // 17: api.hubd.pb.APIService.InvalidateKey:input_type -> api.hubd.pb.InvalidateKeyRequest
-	21, // 18: api.hubd.pb.APIService.CreateOrg:input_type -> api.hubd.pb.CreateOrgRequest
-	23, // 19: api.hubd.pb.APIService.GetOrg:input_type -> api.hubd.pb.GetOrgRequest
-	25, // 20: api.hubd.pb.APIService.ListOrgs:input_type -> api.hubd.pb.ListOrgsRequest
-	27, // 21: api.hubd.pb.APIService.RemoveOrg:input_type -> api.hubd.pb.RemoveOrgRequest
-	29, // 22: api.hubd.pb.APIService.InviteToOrg:input_type -> api.hubd.pb.InviteToOrgRequest
-	31, // 23: api.hubd.pb.APIService.LeaveOrg:input_type -> api.hubd.pb.LeaveOrgRequest
-	33, // 24: api.hubd.pb.APIService.SetupBilling:input_type -> api.hubd.pb.SetupBillingRequest
-	35, // 25: api.hubd.pb.APIService.GetBillingSession:input_type -> api.hubd.pb.GetBillingSessionRequest
-	37, // 26: api.hubd.pb.APIService.ListBillingUsers:input_type -> api.hubd.pb.ListBillingUsersRequest
-	39, // 27: api.hubd.pb.APIService.IsUsernameAvailable:input_type -> api.hubd.pb.IsUsernameAvailableRequest
-	41, // 28: api.hubd.pb.APIService.IsOrgNameAvailable:input_type -> api.hubd.pb.IsOrgNameAvailableRequest
-	43, // 29: api.hubd.pb.APIService.DestroyAccount:input_type -> api.hubd.pb.DestroyAccountRequest
-	2,  // 30: api.hubd.pb.APIService.BuildInfo:output_type -> api.hubd.pb.BuildInfoResponse
-	4,  // 31: api.hubd.pb.APIService.Signup:output_type -> api.hubd.pb.SignupResponse
-	6,  // 32: api.hubd.pb.APIService.Signin:output_type -> api.hubd.pb.SigninResponse
-	8,  // 33: api.hubd.pb.APIService.Signout:output_type -> api.hubd.pb.SignoutResponse
-	10, // 34: api.hubd.pb.APIService.GetSessionInfo:output_type -> api.hubd.pb.GetSessionInfoResponse
-	12, // 35: api.hubd.pb.APIService.GetIdentity:output_type -> api.hubd.pb.GetIdentityResponse
-	15, // 36: api.hubd.pb.APIService.CreateKey:output_type -> api.hubd.pb.CreateKeyResponse
-	19, // 37: api.hubd.pb.APIService.ListKeys:output_type -> api.hubd.pb.ListKeysResponse
-	17, // 38: api.hubd.pb.APIService.InvalidateKey:output_type -> api.hubd.pb.InvalidateKeyResponse
-	22, // 39: api.hubd.pb.APIService.CreateOrg:output_type -> api.hubd.pb.CreateOrgResponse
-	24, // 40: api.hubd.pb.APIService.GetOrg:output_type -> api.hubd.pb.GetOrgResponse
-	26, // 41: api.hubd.pb.APIService.ListOrgs:output_type -> api.hubd.pb.ListOrgsResponse
-	28, // 42: api.hubd.pb.APIService.RemoveOrg:output_type -> api.hubd.pb.RemoveOrgResponse
-	30, // 43: api.hubd.pb.APIService.InviteToOrg:output_type -> api.hubd.pb.InviteToOrgResponse
-	32, // 44: api.hubd.pb.APIService.LeaveOrg:output_type -> api.hubd.pb.LeaveOrgResponse
-	34, // 45: api.hubd.pb.APIService.SetupBilling:output_type -> api.hubd.pb.SetupBillingResponse
-	36, // 46: api.hubd.pb.APIService.GetBillingSession:output_type -> api.hubd.pb.GetBillingSessionResponse
-	38, // 47: api.hubd.pb.APIService.ListBillingUsers:output_type -> api.hubd.pb.ListBillingUsersResponse
-	40, // 48: api.hubd.pb.APIService.IsUsernameAvailable:output_type -> api.hubd.pb.IsUsernameAvailableResponse
-	42, // 49: api.hubd.pb.APIService.IsOrgNameAvailable:output_type -> api.hubd.pb.IsOrgNameAvailableResponse
-	44, // 50: api.hubd.pb.APIService.DestroyAccount:output_type -> api.hubd.pb.DestroyAccountResponse
-	30, // [30:51] is the sub-list for method output_type
-	9,  // [9:30] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	60, // 8: api.hubd.pb.ListBillingUsersResponse.users:type_name -> api.billingd.pb.GetCustomerResponse
+	56, // 9: api.hubd.pb.BatchGetUsageResponse.usage:type_name -> api.hubd.pb.BatchGetUsageResponse.UsageEntry
+	59, // 10: api.hubd.pb.BillingSelfTestResponse.steps:type_name -> api.hubd.pb.BillingSelfTestResponse.Step
+	57, // 11: api.hubd.pb.BatchGetUsageResponse.UsageEntry.value:type_name -> api.hubd.pb.BatchGetUsageResponse.OwnerUsage
+	58, // 12: api.hubd.pb.BatchGetUsageResponse.OwnerUsage.daily_usage:type_name -> api.hubd.pb.BatchGetUsageResponse.OwnerUsage.DailyUsageEntry
+	61, // 13: api.hubd.pb.BatchGetUsageResponse.OwnerUsage.DailyUsageEntry.value:type_name -> api.billingd.pb.Usage
+	1,  // 14: api.hubd.pb.APIService.BuildInfo:input_type -> api.hubd.pb.BuildInfoRequest
+	3,  // 15: api.hubd.pb.APIService.Signup:input_type -> api.hubd.pb.SignupRequest
+	5,  // 16: api.hubd.pb.APIService.Signin:input_type -> api.hubd.pb.SigninRequest
+	7,  // 17: api.hubd.pb.APIService.Signout:input_type -> api.hubd.pb.SignoutRequest
+	9,  // 18: api.hubd.pb.APIService.GetSessionInfo:input_type -> api.hubd.pb.GetSessionInfoRequest
+	11, // 19: api.hubd.pb.APIService.GetIdentity:input_type -> api.hubd.pb.GetIdentityRequest
+	14, // 20: api.hubd.pb.APIService.CreateKey:input_type -> api.hubd.pb.CreateKeyRequest
+	18, // 21: api.hubd.pb.APIService.ListKeys:input_type -> api.hubd.pb.ListKeysRequest
+	16, // 22: api.hubd.pb.APIService.InvalidateKey:input_type -> api.hubd.pb.InvalidateKeyRequest
+	21, // 23: api.hubd.pb.APIService.CreateOrg:input_type -> api.hubd.pb.CreateOrgRequest
+	23, // 24: api.hubd.pb.APIService.GetOrg:input_type -> api.hubd.pb.GetOrgRequest
+	25, // 25: api.hubd.pb.APIService.ListOrgs:input_type -> api.hubd.pb.ListOrgsRequest
+	27, // 26: api.hubd.pb.APIService.RemoveOrg:input_type -> api.hubd.pb.RemoveOrgRequest
+	29, // 27: api.hubd.pb.APIService.InviteToOrg:input_type -> api.hubd.pb.InviteToOrgRequest
+	31, // 28: api.hubd.pb.APIService.LeaveOrg:input_type -> api.hubd.pb.LeaveOrgRequest
+	33, // 29: api.hubd.pb.APIService.SetupBilling:input_type -> api.hubd.pb.SetupBillingRequest
+	35, // 30: api.hubd.pb.APIService.GetBillingSession:input_type -> api.hubd.pb.GetBillingSessionRequest
+	37, // 31: api.hubd.pb.APIService.ListBillingUsers:input_type -> api.hubd.pb.ListBillingUsersRequest
+	39, // 32: api.hubd.pb.APIService.RecalculateStorage:input_type -> api.hubd.pb.RecalculateStorageRequest
+	41, // 33: api.hubd.pb.APIService.BatchGetUsage:input_type -> api.hubd.pb.BatchGetUsageRequest
+	43, // 34: api.hubd.pb.APIService.BillingSelfTest:input_type -> api.hubd.pb.BillingSelfTestRequest
+	45, // 35: api.hubd.pb.APIService.SetBillingChaos:input_type -> api.hubd.pb.SetBillingChaosRequest
+	47, // 36: api.hubd.pb.APIService.InvalidateCustomerCache:input_type -> api.hubd.pb.InvalidateCustomerCacheRequest
+	49, // 37: api.hubd.pb.APIService.IsUsernameAvailable:input_type -> api.hubd.pb.IsUsernameAvailableRequest
+	51, // 38: api.hubd.pb.APIService.IsOrgNameAvailable:input_type -> api.hubd.pb.IsOrgNameAvailableRequest
+	53, // 39: api.hubd.pb.APIService.DestroyAccount:input_type -> api.hubd.pb.DestroyAccountRequest
+	2,  // 40: api.hubd.pb.APIService.BuildInfo:output_type -> api.hubd.pb.BuildInfoResponse
+	4,  // 41: api.hubd.pb.APIService.Signup:output_type -> api.hubd.pb.SignupResponse
+	6,  // 42: api.hubd.pb.APIService.Signin:output_type -> api.hubd.pb.SigninResponse
+	8,  // 43: api.hubd.pb.APIService.Signout:output_type -> api.hubd.pb.SignoutResponse
+	10, // 44: api.hubd.pb.APIService.GetSessionInfo:output_type -> api.hubd.pb.GetSessionInfoResponse
+	12, // 45: api.hubd.pb.APIService.GetIdentity:output_type -> api.hubd.pb.GetIdentityResponse
+	15, // 46: api.hubd.pb.APIService.CreateKey:output_type -> api.hubd.pb.CreateKeyResponse
+	19, // 47: api.hubd.pb.APIService.ListKeys:output_type -> api.hubd.pb.ListKeysResponse
+	17, // 48: api.hubd.pb.APIService.InvalidateKey:output_type -> api.hubd.pb.InvalidateKeyResponse
+	22, // 49: api.hubd.pb.APIService.CreateOrg:output_type -> api.hubd.pb.CreateOrgResponse
+	24, // 50: api.hubd.pb.APIService.GetOrg:output_type -> api.hubd.pb.GetOrgResponse
+	26, // 51: api.hubd.pb.APIService.ListOrgs:output_type -> api.hubd.pb.ListOrgsResponse
+	28, // 52: api.hubd.pb.APIService.RemoveOrg:output_type -> api.hubd.pb.RemoveOrgResponse
+	30, // 53: api.hubd.pb.APIService.InviteToOrg:output_type -> api.hubd.pb.InviteToOrgResponse
+	32, // 54: api.hubd.pb.APIService.LeaveOrg:output_type -> api.hubd.pb.LeaveOrgResponse
+	34, // 55: api.hubd.pb.APIService.SetupBilling:output_type -> api.hubd.pb.SetupBillingResponse
+	36, // 56: api.hubd.pb.APIService.GetBillingSession:output_type -> api.hubd.pb.GetBillingSessionResponse
+	38, // 57: api.hubd.pb.APIService.ListBillingUsers:output_type -> api.hubd.pb.ListBillingUsersResponse
+	40, // 58: api.hubd.pb.APIService.RecalculateStorage:output_type -> api.hubd.pb.RecalculateStorageResponse
+	42, // 59: api.hubd.pb.APIService.BatchGetUsage:output_type -> api.hubd.pb.BatchGetUsageResponse
+	44, // 60: api.hubd.pb.APIService.BillingSelfTest:output_type -> api.hubd.pb.BillingSelfTestResponse
+	46, // 61: api.hubd.pb.APIService.SetBillingChaos:output_type -> api.hubd.pb.SetBillingChaosResponse
+	48, // 62: api.hubd.pb.APIService.InvalidateCustomerCache:output_type -> api.hubd.pb.InvalidateCustomerCacheResponse
+	50, // 63: api.hubd.pb.APIService.IsUsernameAvailable:output_type -> api.hubd.pb.IsUsernameAvailableResponse
+	52, // 64: api.hubd.pb.APIService.IsOrgNameAvailable:output_type -> api.hubd.pb.IsOrgNameAvailableResponse
+	54, // 65: api.hubd.pb.APIService.DestroyAccount:output_type -> api.hubd.pb.DestroyAccountResponse
+	40, // [40:66] is the sub-list for method output_type
+	14, // [14:40] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_api_hubd_pb_hubd_proto_init() }
@@ -3098,7 +3817,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsUsernameAvailableRequest); i {
+			switch v := v.(*RecalculateStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3110,7 +3829,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsUsernameAvailableResponse); i {
+			switch v := v.(*RecalculateStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3122,7 +3841,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsOrgNameAvailableRequest); i {
+			switch v := v.(*BatchGetUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3134,7 +3853,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsOrgNameAvailableResponse); i {
+			switch v := v.(*BatchGetUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3146,7 +3865,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyAccountRequest); i {
+			switch v := v.(*BillingSelfTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3158,7 +3877,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyAccountResponse); i {
+			switch v := v.(*BillingSelfTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3170,6 +3889,126 @@ func file_api_hubd_pb_hubd_proto_init() {
 			}
 		}
 		file_api_hubd_pb_hubd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBillingChaosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBillingChaosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCustomerCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCustomerCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsUsernameAvailableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsUsernameAvailableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOrgNameAvailableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOrgNameAvailableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrgInfo_Member); i {
 			case 0:
 				return &v.state
@@ -3181,6 +4020,30 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
+		file_api_hubd_pb_hubd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetUsageResponse_OwnerUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillingSelfTestResponse_Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3188,7 +4051,7 @@ func file_api_hubd_pb_hubd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_hubd_pb_hubd_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   45,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3233,6 +4096,11 @@ type APIServiceClient interface {
 	SetupBilling(ctx context.Context, in *SetupBillingRequest, opts ...grpc.CallOption) (*SetupBillingResponse, error)
 	GetBillingSession(ctx context.Context, in *GetBillingSessionRequest, opts ...grpc.CallOption) (*GetBillingSessionResponse, error)
 	ListBillingUsers(ctx context.Context, in *ListBillingUsersRequest, opts ...grpc.CallOption) (*ListBillingUsersResponse, error)
+	RecalculateStorage(ctx context.Context, in *RecalculateStorageRequest, opts ...grpc.CallOption) (*RecalculateStorageResponse, error)
+	BatchGetUsage(ctx context.Context, in *BatchGetUsageRequest, opts ...grpc.CallOption) (*BatchGetUsageResponse, error)
+	BillingSelfTest(ctx context.Context, in *BillingSelfTestRequest, opts ...grpc.CallOption) (*BillingSelfTestResponse, error)
+	SetBillingChaos(ctx context.Context, in *SetBillingChaosRequest, opts ...grpc.CallOption) (*SetBillingChaosResponse, error)
+	InvalidateCustomerCache(ctx context.Context, in *InvalidateCustomerCacheRequest, opts ...grpc.CallOption) (*InvalidateCustomerCacheResponse, error)
 	IsUsernameAvailable(ctx context.Context, in *IsUsernameAvailableRequest, opts ...grpc.CallOption) (*IsUsernameAvailableResponse, error)
 	IsOrgNameAvailable(ctx context.Context, in *IsOrgNameAvailableRequest, opts ...grpc.CallOption) (*IsOrgNameAvailableResponse, error)
 	DestroyAccount(ctx context.Context, in *DestroyAccountRequest, opts ...grpc.CallOption) (*DestroyAccountResponse, error)
@@ -3408,6 +4276,51 @@ func (c *aPIServiceClient) ListBillingUsers(ctx context.Context, in *ListBilling
 	return out, nil
 }
 
+func (c *aPIServiceClient) RecalculateStorage(ctx context.Context, in *RecalculateStorageRequest, opts ...grpc.CallOption) (*RecalculateStorageResponse, error) {
+	out := new(RecalculateStorageResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RecalculateStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) BatchGetUsage(ctx context.Context, in *BatchGetUsageRequest, opts ...grpc.CallOption) (*BatchGetUsageResponse, error) {
+	out := new(BatchGetUsageResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/BatchGetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) BillingSelfTest(ctx context.Context, in *BillingSelfTestRequest, opts ...grpc.CallOption) (*BillingSelfTestResponse, error) {
+	out := new(BillingSelfTestResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/BillingSelfTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SetBillingChaos(ctx context.Context, in *SetBillingChaosRequest, opts ...grpc.CallOption) (*SetBillingChaosResponse, error) {
+	out := new(SetBillingChaosResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/SetBillingChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) InvalidateCustomerCache(ctx context.Context, in *InvalidateCustomerCacheRequest, opts ...grpc.CallOption) (*InvalidateCustomerCacheResponse, error) {
+	out := new(InvalidateCustomerCacheResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/InvalidateCustomerCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) IsUsernameAvailable(ctx context.Context, in *IsUsernameAvailableRequest, opts ...grpc.CallOption) (*IsUsernameAvailableResponse, error) {
 	out := new(IsUsernameAvailableResponse)
 	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/IsUsernameAvailable", in, out, opts...)
@@ -3455,6 +4368,11 @@ type APIServiceServer interface {
 	SetupBilling(context.Context, *SetupBillingRequest) (*SetupBillingResponse, error)
 	GetBillingSession(context.Context, *GetBillingSessionRequest) (*GetBillingSessionResponse, error)
 	ListBillingUsers(context.Context, *ListBillingUsersRequest) (*ListBillingUsersResponse, error)
+	RecalculateStorage(context.Context, *RecalculateStorageRequest) (*RecalculateStorageResponse, error)
+	BatchGetUsage(context.Context, *BatchGetUsageRequest) (*BatchGetUsageResponse, error)
+	BillingSelfTest(context.Context, *BillingSelfTestRequest) (*BillingSelfTestResponse, error)
+	SetBillingChaos(context.Context, *SetBillingChaosRequest) (*SetBillingChaosResponse, error)
+	InvalidateCustomerCache(context.Context, *InvalidateCustomerCacheRequest) (*InvalidateCustomerCacheResponse, error)
 	IsUsernameAvailable(context.Context, *IsUsernameAvailableRequest) (*IsUsernameAvailableResponse, error)
 	IsOrgNameAvailable(context.Context, *IsOrgNameAvailableRequest) (*IsOrgNameAvailableResponse, error)
 	DestroyAccount(context.Context, *DestroyAccountRequest) (*DestroyAccountResponse, error)
@@ -3518,6 +4436,21 @@ func (*UnimplementedAPIServiceServer) GetBillingSession(context.Context, *GetBil
 func (*UnimplementedAPIServiceServer) ListBillingUsers(context.Context, *ListBillingUsersRequest) (*ListBillingUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBillingUsers not implemented")
 }
+func (*UnimplementedAPIServiceServer) RecalculateStorage(context.Context, *RecalculateStorageRequest) (*RecalculateStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateStorage not implemented")
+}
+func (*UnimplementedAPIServiceServer) BatchGetUsage(context.Context, *BatchGetUsageRequest) (*BatchGetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUsage not implemented")
+}
+func (*UnimplementedAPIServiceServer) BillingSelfTest(context.Context, *BillingSelfTestRequest) (*BillingSelfTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillingSelfTest not implemented")
+}
+func (*UnimplementedAPIServiceServer) SetBillingChaos(context.Context, *SetBillingChaosRequest) (*SetBillingChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBillingChaos not implemented")
+}
+func (*UnimplementedAPIServiceServer) InvalidateCustomerCache(context.Context, *InvalidateCustomerCacheRequest) (*InvalidateCustomerCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCustomerCache not implemented")
+}
 func (*UnimplementedAPIServiceServer) IsUsernameAvailable(context.Context, *IsUsernameAvailableRequest) (*IsUsernameAvailableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsUsernameAvailable not implemented")
 }
@@ -3856,6 +4789,96 @@ func _APIService_ListBillingUsers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_RecalculateStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RecalculateStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/RecalculateStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RecalculateStorage(ctx, req.(*RecalculateStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_BatchGetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).BatchGetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/BatchGetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).BatchGetUsage(ctx, req.(*BatchGetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_BillingSelfTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BillingSelfTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).BillingSelfTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/BillingSelfTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).BillingSelfTest(ctx, req.(*BillingSelfTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SetBillingChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBillingChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SetBillingChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/SetBillingChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SetBillingChaos(ctx, req.(*SetBillingChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_InvalidateCustomerCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCustomerCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).InvalidateCustomerCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/InvalidateCustomerCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).InvalidateCustomerCache(ctx, req.(*InvalidateCustomerCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_IsUsernameAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsUsernameAvailableRequest)
 	if err := dec(in); err != nil {
@@ -3986,6 +5009,26 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListBillingUsers",
 			Handler:    _APIService_ListBillingUsers_Handler,
 		},
+		{
+			MethodName: "RecalculateStorage",
+			Handler:    _APIService_RecalculateStorage_Handler,
+		},
+		{
+			MethodName: "BatchGetUsage",
+			Handler:    _APIService_BatchGetUsage_Handler,
+		},
+		{
+			MethodName: "BillingSelfTest",
+			Handler:    _APIService_BillingSelfTest_Handler,
+		},
+		{
+			MethodName: "SetBillingChaos",
+			Handler:    _APIService_SetBillingChaos_Handler,
+		},
+		{
+			MethodName: "InvalidateCustomerCache",
+			Handler:    _APIService_InvalidateCustomerCache_Handler,
+		},
 		{
 			MethodName: "IsUsernameAvailable",
 			Handler:    _APIService_IsUsernameAvailable_Handler,
@@ -2,6 +2,7 @@ package hubd
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"net/mail"
@@ -13,11 +14,13 @@ import (
 	threads "github.com/textileio/go-threads/api/client"
 	"github.com/textileio/go-threads/broadcast"
 	net "github.com/textileio/go-threads/core/net"
+	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
 	netclient "github.com/textileio/go-threads/net/api/client"
 	pow "github.com/textileio/powergate/v2/api/client"
 	"github.com/textileio/textile/v2/api/billingd/analytics"
 	billing "github.com/textileio/textile/v2/api/billingd/client"
+	billingpb "github.com/textileio/textile/v2/api/billingd/pb"
 	"github.com/textileio/textile/v2/api/common"
 	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"github.com/textileio/textile/v2/buckets"
@@ -28,6 +31,7 @@ import (
 	tdb "github.com/textileio/textile/v2/threaddb"
 	"github.com/textileio/textile/v2/util"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -55,6 +59,51 @@ type Service struct {
 	BillingClient       *billing.Client
 	PowergateClient     *pow.Client
 	PowergateAdminToken string
+	AdminToken          string
+	AdminAuthorizer     AdminAuthorizer
+	StorageRecalculator StorageRecalculator
+	UsageQuerier        UsageQuerier
+	BillingSelfTester   BillingSelfTester
+	ChaosController     ChaosController
+	CacheInvalidator    CacheInvalidator
+}
+
+// AdminAuthorizer authorizes a request to an admin method, e.g., by checking an mTLS client
+// certificate or JWT scopes. The request is denied if an error is returned.
+type AdminAuthorizer func(ctx context.Context, method string) error
+
+// StorageRecalculator recomputes an owner's billed storage from their buckets.
+type StorageRecalculator interface {
+	// RecalculateStorage overwrites the owner's billed stored data with the size of their buckets.
+	// The previous and recalculated totals are returned.
+	RecalculateStorage(ctx context.Context, key thread.PubKey) (previous, total int64, err error)
+}
+
+// UsageQuerier fetches the usage of many owners at once.
+type UsageQuerier interface {
+	// BatchGetUsage returns the daily usage of each owner in keys that has a billing customer,
+	// keyed by owner. Owners without a customer are omitted.
+	BatchGetUsage(ctx context.Context, keys []thread.PubKey) (map[string]map[string]*billingpb.Usage, error)
+}
+
+// BillingSelfTester runs a synthetic request through the billing path.
+type BillingSelfTester interface {
+	// BillingSelfTest returns the result of each step of the self-test, which fails at the first
+	// step that fails.
+	BillingSelfTest(ctx context.Context) ([]*pb.BillingSelfTestResponse_Step, error)
+}
+
+// ChaosController injects faults into billing calls for resilience testing.
+type ChaosController interface {
+	// SetBillingChaos changes the latency and error rate injected into billing calls.
+	SetBillingChaos(latency time.Duration, errorRate float64) error
+}
+
+// CacheInvalidator drops billing state that's cached for an owner.
+type CacheInvalidator interface {
+	// InvalidateCustomerCache drops the owner's cached usage decisions so that their next request
+	// is checked against their current customer, e.g., after a quota increase.
+	InvalidateCustomerCache(key thread.PubKey) error
 }
 
 // Info provides the currently running API's build information
@@ -757,6 +806,175 @@ func (s *Service) ListBillingUsers(
 	}, nil
 }
 
+// RecalculateStorage recomputes an owner's stored data from their buckets and overwrites
+// the billed total. It requires the admin token.
+func (s *Service) RecalculateStorage(
+	ctx context.Context,
+	req *pb.RecalculateStorageRequest,
+) (*pb.RecalculateStorageResponse, error) {
+	log.Debugf("received recalculate storage request")
+
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.StorageRecalculator == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	key := &thread.Libp2pPubKey{}
+	if err := key.UnmarshalString(req.Key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid public key")
+	}
+	previous, total, err := s.StorageRecalculator.RecalculateStorage(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecalculateStorageResponse{
+		Previous: previous,
+		Total:    total,
+	}, nil
+}
+
+// BatchGetUsage returns the daily usage of many owners, e.g., for internal dashboards.
+// Owners without a billing customer are listed as missing. It requires the admin token.
+func (s *Service) BatchGetUsage(ctx context.Context, req *pb.BatchGetUsageRequest) (*pb.BatchGetUsageResponse, error) {
+	log.Debugf("received batch get usage request")
+
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.UsageQuerier == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	keys := make([]thread.PubKey, len(req.Keys))
+	for i, k := range req.Keys {
+		key := &thread.Libp2pPubKey{}
+		if err := key.UnmarshalString(k); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key: %s", k)
+		}
+		keys[i] = key
+	}
+	usage, err := s.UsageQuerier.BatchGetUsage(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BatchGetUsageResponse{
+		Usage: make(map[string]*pb.BatchGetUsageResponse_OwnerUsage),
+	}
+	seen := make(map[string]struct{})
+	for _, key := range keys {
+		k := key.String()
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		if u, ok := usage[k]; ok {
+			res.Usage[k] = &pb.BatchGetUsageResponse_OwnerUsage{DailyUsage: u}
+		} else {
+			res.Missing = append(res.Missing, k)
+		}
+	}
+	return res, nil
+}
+
+// BillingSelfTest runs a synthetic request against a disposable owner through customer creation,
+// a usage increment, and a read-back of the usage, e.g., to smoke test a deployment's billing
+// integration. It requires the admin token.
+func (s *Service) BillingSelfTest(
+	ctx context.Context,
+	_ *pb.BillingSelfTestRequest,
+) (*pb.BillingSelfTestResponse, error) {
+	log.Debugf("received billing self-test request")
+
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.BillingSelfTester == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	steps, err := s.BillingSelfTester.BillingSelfTest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.BillingSelfTestResponse{Steps: steps, Ok: true}
+	for _, step := range steps {
+		if !step.Ok {
+			res.Ok = false
+		}
+	}
+	return res, nil
+}
+
+// SetBillingChaos changes the latency and error rate injected into the billing calls made by the usage
+// interceptor, e.g., to test how requests behave when billingd is slow or failing. Chaos must be enabled
+// in the hub's config. It requires the admin token.
+func (s *Service) SetBillingChaos(
+	ctx context.Context,
+	req *pb.SetBillingChaosRequest,
+) (*pb.SetBillingChaosResponse, error) {
+	log.Debugf("received set billing chaos request")
+
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.ChaosController == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	if err := s.ChaosController.SetBillingChaos(time.Duration(req.LatencyNs), req.ErrorRate); err != nil {
+		return nil, err
+	}
+	return &pb.SetBillingChaosResponse{}, nil
+}
+
+// InvalidateCustomerCache drops an owner's cached usage decisions, e.g., so that a quota increase
+// applies to their next request instead of once the cache expires. It requires the admin token.
+func (s *Service) InvalidateCustomerCache(
+	ctx context.Context,
+	req *pb.InvalidateCustomerCacheRequest,
+) (*pb.InvalidateCustomerCacheResponse, error) {
+	log.Debugf("received invalidate customer cache request")
+
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.CacheInvalidator == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	key := &thread.Libp2pPubKey{}
+	if err := key.UnmarshalString(req.Key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid public key")
+	}
+	if err := s.CacheInvalidator.InvalidateCustomerCache(key); err != nil {
+		return nil, err
+	}
+	return &pb.InvalidateCustomerCacheResponse{}, nil
+}
+
+// checkAdmin returns an error if the request isn't authorized by the admin authorizer, or,
+// if there isn't one, doesn't carry the admin token. The Powergate admin token is used if there
+// isn't an admin token, and all requests are denied if neither is set.
+func (s *Service) checkAdmin(ctx context.Context) error {
+	if s.AdminAuthorizer != nil {
+		method, _ := grpc.Method(ctx)
+		err := s.AdminAuthorizer(ctx, method)
+		if err == nil {
+			return nil
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	adminToken := s.AdminToken
+	if adminToken == "" {
+		adminToken = s.PowergateAdminToken
+	}
+	token, ok := common.AdminTokenFromMD(ctx)
+	if adminToken == "" || !ok || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "Admin token required")
+	}
+	return nil
+}
+
 func (s *Service) IsUsernameAvailable(
 	ctx context.Context,
 	req *pb.IsUsernameAvailableRequest,
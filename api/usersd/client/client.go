@@ -11,6 +11,7 @@ import (
 	"github.com/gogo/status"
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/go-threads/core/thread"
+	billingpb "github.com/textileio/textile/v2/api/billingd/pb"
 	pb "github.com/textileio/textile/v2/api/usersd/pb"
 	"github.com/textileio/textile/v2/threaddb"
 	"google.golang.org/grpc"
@@ -291,6 +292,43 @@ func (c *Client) GetUsage(ctx context.Context, opts ...UsageOption) (*pb.GetUsag
 	})
 }
 
+// CheckAccess returns whether or not a request to method would currently be allowed.
+// The request is evaluated against the caller's billing status and usage quotas, but it's not handled.
+// Denied requests include the reason and gRPC status code the request would fail with.
+func (c *Client) CheckAccess(ctx context.Context, method string) (*pb.CheckAccessResponse, error) {
+	return c.c.CheckAccess(ctx, &pb.CheckAccessRequest{
+		Method: method,
+	})
+}
+
+// GetLimits returns every limit that applies to the caller, e.g., daily quotas, burst limits,
+// and the max number of buckets, along with the caller's current usage of each.
+func (c *Client) GetLimits(ctx context.Context) (*pb.GetLimitsResponse, error) {
+	return c.c.GetLimits(ctx, &pb.GetLimitsRequest{})
+}
+
+// WatchUsage sends the caller's daily usage to ch, and again each time it changes,
+// until ctx is canceled.
+func (c *Client) WatchUsage(ctx context.Context, ch chan<- map[string]*billingpb.Usage) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.c.WatchUsage(ctx, &pb.WatchUsageRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF || status.Code(err) == codes.Canceled {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		ch <- reply.DailyUsage
+	}
+	return nil
+}
+
 // ArchivesLs list all imported archives.
 func (c *Client) ArchivesLs(ctx context.Context) (*pb.ArchivesLsResponse, error) {
 	req := &pb.ArchivesLsRequest{}
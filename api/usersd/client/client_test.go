@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	ipfsfiles "github.com/ipfs/go-ipfs-files"
+	httpapi "github.com/ipfs/go-ipfs-http-client"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -487,6 +489,56 @@ func TestClient_GetUsage(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClient_GetUsageBootstrappedBucket(t *testing.T) {
+	t.Parallel()
+	conf, client, hub, threads, _, buckets := setupWithBilling(t)
+
+	dev := apitest.Signup(t, hub, conf, apitest.NewUsername(), apitest.NewEmail())
+	res, err := hub.CreateKey(common.NewSessionContext(context.Background(), dev.Session), hubpb.KeyType_KEY_TYPE_USER, false)
+	require.NoError(t, err)
+
+	ctx := common.NewAPIKeyContext(context.Background(), res.KeyInfo.Key)
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	tok, err := threads.GetToken(ctx, thread.NewLibp2pIdentity(sk))
+	require.NoError(t, err)
+	ctx = thread.NewTokenContext(ctx, tok)
+	id := thread.NewIDV1(thread.Raw, 32)
+	err = threads.NewDB(common.NewThreadNameContext(ctx, "buckets"), id)
+	require.NoError(t, err)
+	ctx = common.NewThreadIDContext(ctx, id)
+
+	ipfs, err := httpapi.NewApi(apitest.GetIPFSApiAddr())
+	require.NoError(t, err)
+	p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+		"file1": ipfsfiles.NewBytesFile([]byte("one")),
+		"folder1": ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+			"file2": ipfsfiles.NewBytesFile([]byte("two")),
+		}),
+	}))
+	require.NoError(t, err)
+
+	objectCount := func() int64 {
+		usage, err := client.GetUsage(ctx)
+		require.NoError(t, err)
+		return usage.Customer.DailyUsage["object_count"].GetTotal()
+	}
+
+	// Bootstrapped files are counted when the bucket is created.
+	buck, err := buckets.Create(ctx, bc.WithCid(p.Cid()))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return objectCount() == 2
+	}, time.Second*10, time.Millisecond*100)
+
+	// Removing the bucket doesn't leave the count negative.
+	err = buckets.Remove(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return objectCount() == 0
+	}, time.Second*10, time.Millisecond*100)
+}
+
 func TestAccountBuckets(t *testing.T) {
 	conf, users, hub, threads, _, buckets := setup(t, nil)
 	ctx := context.Background()
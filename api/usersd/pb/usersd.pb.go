@@ -1246,6 +1246,492 @@ func (x *GetUsageResponse) GetUsage() *pb.GetCustomerUsageResponse {
 	return nil
 }
 
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckAccessRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type CheckAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Code    int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessResponse) ProtoMessage() {}
+
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckAccessResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckAccessResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CheckAccessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WatchUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchUsageRequest) Reset() {
+	*x = WatchUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUsageRequest) ProtoMessage() {}
+
+func (x *WatchUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUsageRequest.ProtoReflect.Descriptor instead.
+func (*WatchUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{23}
+}
+
+type WatchUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DailyUsage map[string]*pb.Usage `protobuf:"bytes,1,rep,name=daily_usage,json=dailyUsage,proto3" json:"daily_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WatchUsageResponse) Reset() {
+	*x = WatchUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUsageResponse) ProtoMessage() {}
+
+func (x *WatchUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUsageResponse.ProtoReflect.Descriptor instead.
+func (*WatchUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WatchUsageResponse) GetDailyUsage() map[string]*pb.Usage {
+	if x != nil {
+		return x.DailyUsage
+	}
+	return nil
+}
+
+type GetLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLimitsRequest) Reset() {
+	*x = GetLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitsRequest) ProtoMessage() {}
+
+func (x *GetLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{25}
+}
+
+type GetLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Billable                  bool                   `protobuf:"varint,1,opt,name=billable,proto3" json:"billable,omitempty"`
+	GracePeriodEnd            int64                  `protobuf:"varint,2,opt,name=grace_period_end,json=gracePeriodEnd,proto3" json:"grace_period_end,omitempty"`
+	DailyQuotas               map[string]*QuotaLimit `protobuf:"bytes,3,rep,name=daily_quotas,json=dailyQuotas,proto3" json:"daily_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BurstWindow               int64                  `protobuf:"varint,4,opt,name=burst_window,json=burstWindow,proto3" json:"burst_window,omitempty"`
+	BurstLimits               map[string]*Limit      `protobuf:"bytes,5,rep,name=burst_limits,json=burstLimits,proto3" json:"burst_limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	InflightEgress            *Limit                 `protobuf:"bytes,6,opt,name=inflight_egress,json=inflightEgress,proto3" json:"inflight_egress,omitempty"`
+	Buckets                   *Limit                 `protobuf:"bytes,7,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	Threads                   *Limit                 `protobuf:"bytes,8,opt,name=threads,proto3" json:"threads,omitempty"`
+	StoredDataCap             *Limit                 `protobuf:"bytes,9,opt,name=stored_data_cap,json=storedDataCap,proto3" json:"stored_data_cap,omitempty"`
+	MaxBucketArchiveRepFactor int32                  `protobuf:"varint,10,opt,name=max_bucket_archive_rep_factor,json=maxBucketArchiveRepFactor,proto3" json:"max_bucket_archive_rep_factor,omitempty"`
+}
+
+func (x *GetLimitsResponse) Reset() {
+	*x = GetLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitsResponse) ProtoMessage() {}
+
+func (x *GetLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetLimitsResponse) GetBillable() bool {
+	if x != nil {
+		return x.Billable
+	}
+	return false
+}
+
+func (x *GetLimitsResponse) GetGracePeriodEnd() int64 {
+	if x != nil {
+		return x.GracePeriodEnd
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetDailyQuotas() map[string]*QuotaLimit {
+	if x != nil {
+		return x.DailyQuotas
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetBurstWindow() int64 {
+	if x != nil {
+		return x.BurstWindow
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetBurstLimits() map[string]*Limit {
+	if x != nil {
+		return x.BurstLimits
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetInflightEgress() *Limit {
+	if x != nil {
+		return x.InflightEgress
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetBuckets() *Limit {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetThreads() *Limit {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetStoredDataCap() *Limit {
+	if x != nil {
+		return x.StoredDataCap
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetMaxBucketArchiveRepFactor() int32 {
+	if x != nil {
+		return x.MaxBucketArchiveRepFactor
+	}
+	return 0
+}
+
+type QuotaLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Used      int64 `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	Free      int64 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Grace     int64 `protobuf:"varint,3,opt,name=grace,proto3" json:"grace,omitempty"`
+	Exhausted bool  `protobuf:"varint,4,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+}
+
+func (x *QuotaLimit) Reset() {
+	*x = QuotaLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLimit) ProtoMessage() {}
+
+func (x *QuotaLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLimit.ProtoReflect.Descriptor instead.
+func (*QuotaLimit) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QuotaLimit) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaLimit) GetFree() int64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *QuotaLimit) GetGrace() int64 {
+	if x != nil {
+		return x.Grace
+	}
+	return 0
+}
+
+func (x *QuotaLimit) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+type Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used  int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *Limit) Reset() {
+	*x = Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limit) ProtoMessage() {}
+
+func (x *Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
+func (*Limit) Descriptor() ([]byte, []int) {
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Limit) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Limit) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
 type ArchivesLsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1255,7 +1741,7 @@ type ArchivesLsRequest struct {
 func (x *ArchivesLsRequest) Reset() {
 	*x = ArchivesLsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[21]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1268,7 +1754,7 @@ func (x *ArchivesLsRequest) String() string {
 func (*ArchivesLsRequest) ProtoMessage() {}
 
 func (x *ArchivesLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[21]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,7 +1767,7 @@ func (x *ArchivesLsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesLsRequest.ProtoReflect.Descriptor instead.
 func (*ArchivesLsRequest) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{21}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{29}
 }
 
 type ArchivesLsResponse struct {
@@ -1295,7 +1781,7 @@ type ArchivesLsResponse struct {
 func (x *ArchivesLsResponse) Reset() {
 	*x = ArchivesLsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[22]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1308,7 +1794,7 @@ func (x *ArchivesLsResponse) String() string {
 func (*ArchivesLsResponse) ProtoMessage() {}
 
 func (x *ArchivesLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[22]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1807,7 @@ func (x *ArchivesLsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesLsResponse.ProtoReflect.Descriptor instead.
 func (*ArchivesLsResponse) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{22}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ArchivesLsResponse) GetArchives() []*ArchiveLsItem {
@@ -1343,7 +1829,7 @@ type ArchiveLsItem struct {
 func (x *ArchiveLsItem) Reset() {
 	*x = ArchiveLsItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[23]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1356,7 +1842,7 @@ func (x *ArchiveLsItem) String() string {
 func (*ArchiveLsItem) ProtoMessage() {}
 
 func (x *ArchiveLsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[23]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,7 +1855,7 @@ func (x *ArchiveLsItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveLsItem.ProtoReflect.Descriptor instead.
 func (*ArchiveLsItem) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{23}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ArchiveLsItem) GetCid() string {
@@ -1397,7 +1883,7 @@ type ArchiveLsItemMetadata struct {
 func (x *ArchiveLsItemMetadata) Reset() {
 	*x = ArchiveLsItemMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[24]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1410,7 +1896,7 @@ func (x *ArchiveLsItemMetadata) String() string {
 func (*ArchiveLsItemMetadata) ProtoMessage() {}
 
 func (x *ArchiveLsItemMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[24]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,7 +1909,7 @@ func (x *ArchiveLsItemMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveLsItemMetadata.ProtoReflect.Descriptor instead.
 func (*ArchiveLsItemMetadata) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{24}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ArchiveLsItemMetadata) GetDealId() uint64 {
@@ -1445,7 +1931,7 @@ type ArchivesImportRequest struct {
 func (x *ArchivesImportRequest) Reset() {
 	*x = ArchivesImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[25]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1458,7 +1944,7 @@ func (x *ArchivesImportRequest) String() string {
 func (*ArchivesImportRequest) ProtoMessage() {}
 
 func (x *ArchivesImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[25]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +1957,7 @@ func (x *ArchivesImportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesImportRequest.ProtoReflect.Descriptor instead.
 func (*ArchivesImportRequest) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{25}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ArchivesImportRequest) GetCid() string {
@@ -1497,7 +1983,7 @@ type ArchivesImportResponse struct {
 func (x *ArchivesImportResponse) Reset() {
 	*x = ArchivesImportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[26]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1510,7 +1996,7 @@ func (x *ArchivesImportResponse) String() string {
 func (*ArchivesImportResponse) ProtoMessage() {}
 
 func (x *ArchivesImportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[26]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +2009,7 @@ func (x *ArchivesImportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesImportResponse.ProtoReflect.Descriptor instead.
 func (*ArchivesImportResponse) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{26}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{34}
 }
 
 type ArchiveRetrievalLsRequest struct {
@@ -1535,7 +2021,7 @@ type ArchiveRetrievalLsRequest struct {
 func (x *ArchiveRetrievalLsRequest) Reset() {
 	*x = ArchiveRetrievalLsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[27]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1548,7 +2034,7 @@ func (x *ArchiveRetrievalLsRequest) String() string {
 func (*ArchiveRetrievalLsRequest) ProtoMessage() {}
 
 func (x *ArchiveRetrievalLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[27]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,7 +2047,7 @@ func (x *ArchiveRetrievalLsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRetrievalLsRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveRetrievalLsRequest) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{27}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{35}
 }
 
 type ArchiveRetrievalLsResponse struct {
@@ -1575,7 +2061,7 @@ type ArchiveRetrievalLsResponse struct {
 func (x *ArchiveRetrievalLsResponse) Reset() {
 	*x = ArchiveRetrievalLsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[28]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1588,7 +2074,7 @@ func (x *ArchiveRetrievalLsResponse) String() string {
 func (*ArchiveRetrievalLsResponse) ProtoMessage() {}
 
 func (x *ArchiveRetrievalLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[28]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1601,7 +2087,7 @@ func (x *ArchiveRetrievalLsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRetrievalLsResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveRetrievalLsResponse) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{28}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ArchiveRetrievalLsResponse) GetRetrievals() []*ArchiveRetrievalLsItem {
@@ -1629,7 +2115,7 @@ type ArchiveRetrievalLsItem struct {
 func (x *ArchiveRetrievalLsItem) Reset() {
 	*x = ArchiveRetrievalLsItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[29]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1642,7 +2128,7 @@ func (x *ArchiveRetrievalLsItem) String() string {
 func (*ArchiveRetrievalLsItem) ProtoMessage() {}
 
 func (x *ArchiveRetrievalLsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[29]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,7 +2141,7 @@ func (x *ArchiveRetrievalLsItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRetrievalLsItem.ProtoReflect.Descriptor instead.
 func (*ArchiveRetrievalLsItem) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{29}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ArchiveRetrievalLsItem) GetId() string {
@@ -1729,7 +2215,7 @@ type ArchiveRetrievalLsItemNewBucket struct {
 func (x *ArchiveRetrievalLsItemNewBucket) Reset() {
 	*x = ArchiveRetrievalLsItemNewBucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[30]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1742,7 +2228,7 @@ func (x *ArchiveRetrievalLsItemNewBucket) String() string {
 func (*ArchiveRetrievalLsItemNewBucket) ProtoMessage() {}
 
 func (x *ArchiveRetrievalLsItemNewBucket) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[30]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +2241,7 @@ func (x *ArchiveRetrievalLsItemNewBucket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRetrievalLsItemNewBucket.ProtoReflect.Descriptor instead.
 func (*ArchiveRetrievalLsItemNewBucket) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{30}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ArchiveRetrievalLsItemNewBucket) GetName() string {
@@ -1783,7 +2269,7 @@ type ArchiveRetrievalLogsRequest struct {
 func (x *ArchiveRetrievalLogsRequest) Reset() {
 	*x = ArchiveRetrievalLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[31]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1796,7 +2282,7 @@ func (x *ArchiveRetrievalLogsRequest) String() string {
 func (*ArchiveRetrievalLogsRequest) ProtoMessage() {}
 
 func (x *ArchiveRetrievalLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[31]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1809,7 +2295,7 @@ func (x *ArchiveRetrievalLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRetrievalLogsRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveRetrievalLogsRequest) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{31}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ArchiveRetrievalLogsRequest) GetId() string {
@@ -1830,7 +2316,7 @@ type ArchiveRetrievalLogsResponse struct {
 func (x *ArchiveRetrievalLogsResponse) Reset() {
 	*x = ArchiveRetrievalLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_usersd_pb_usersd_proto_msgTypes[32]
+		mi := &file_api_usersd_pb_usersd_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1843,7 +2329,7 @@ func (x *ArchiveRetrievalLogsResponse) String() string {
 func (*ArchiveRetrievalLogsResponse) ProtoMessage() {}
 
 func (x *ArchiveRetrievalLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_usersd_pb_usersd_proto_msgTypes[32]
+	mi := &file_api_usersd_pb_usersd_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,7 +2342,7 @@ func (x *ArchiveRetrievalLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRetrievalLogsResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveRetrievalLogsResponse) Descriptor() ([]byte, []int) {
-	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{32}
+	return file_api_usersd_pb_usersd_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ArchiveRetrievalLogsResponse) GetMsg() string {
@@ -1977,176 +2463,274 @@ var file_api_usersd_pb_usersd_proto_rawDesc = []byte{
 	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
 	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x13, 0x0a,
-	0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x73, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65,
-	0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0x30, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49,
-	0x64, 0x22, 0x44, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07,
-	0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63,
-	0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x61, 0x6c, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a,
-	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
-	0x6c, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x61, 0x6c, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
-	0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43,
-	0x61, 0x75, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x4f, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x4e, 0x65,
-	0x77, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
-	0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4f, 0x0a, 0x1f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d,
-	0x4e, 0x65, 0x77, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x1c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0xac, 0x02, 0x0a, 0x16, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52,
-	0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a,
-	0x1f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56,
-	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45,
-	0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45,
-	0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x52,
-	0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x54, 0x4f, 0x42, 0x55, 0x43,
-	0x4b, 0x45, 0x54, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a,
+	0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x75, 0x0a, 0x13, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x55, 0x0a, 0x0f, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf8, 0x05,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x69, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x54, 0x0a, 0x0c, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x72, 0x73, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x54, 0x0a, 0x0c, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x75, 0x72, 0x73, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x62, 0x75, 0x72,
+	0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x43, 0x61, 0x70, 0x12, 0x40, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x6d, 0x61,
+	0x78, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x70, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x59, 0x0a, 0x10, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x54, 0x0a, 0x10, 0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x68, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x22, 0x31, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x73, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x12, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0d, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x30, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x15, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x22,
+	0x18, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0a, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4f, 0x0a, 0x0a, 0x6e,
+	0x65, 0x77, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x4e, 0x65, 0x77, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x00, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x10, 0x0a, 0x0e,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4f,
+	0x0a, 0x1f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x61, 0x6c, 0x4c, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x4e, 0x65, 0x77, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22,
+	0x2d, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30,
+	0x0a, 0x1c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x2a, 0xac, 0x02, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x24, 0x41,
+	0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45,
 	0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x52,
+	0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x52,
 	0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
-	0x05, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54,
-	0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x06, 0x32, 0xf1, 0x0a, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x59, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78,
-	0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
-	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x53,
-	0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x78,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x62,
-	0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a,
-	0x10, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45,
+	0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d,
+	0x4f, 0x56, 0x45, 0x54, 0x4f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x03, 0x12, 0x23, 0x0a,
+	0x1f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56,
+	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45,
+	0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x52, 0x43,
+	0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x06, 0x32,
+	0xf2, 0x0c, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4d, 0x61,
+	0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e,
+	0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74,
+	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x62,
+	0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e,
-	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
-	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x78,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x71, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x62,
-	0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74,
-	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c,
-	0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0a,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x12,
-	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
-	0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x65, 0x73, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x73, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c,
-	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c,
-	0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x73, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x78,
+	0x74, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x64, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2162,7 +2746,7 @@ func file_api_usersd_pb_usersd_proto_rawDescGZIP() []byte {
 }
 
 var file_api_usersd_pb_usersd_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_usersd_pb_usersd_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_api_usersd_pb_usersd_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_api_usersd_pb_usersd_proto_goTypes = []interface{}{
 	(ArchiveRetrievalStatus)(0),             // 0: api.usersd.pb.ArchiveRetrievalStatus
 	(ListInboxMessagesRequest_Status)(0),    // 1: api.usersd.pb.ListInboxMessagesRequest.Status
@@ -2187,66 +2771,94 @@ var file_api_usersd_pb_usersd_proto_goTypes = []interface{}{
 	(*DeleteSentboxMessageResponse)(nil),    // 20: api.usersd.pb.DeleteSentboxMessageResponse
 	(*GetUsageRequest)(nil),                 // 21: api.usersd.pb.GetUsageRequest
 	(*GetUsageResponse)(nil),                // 22: api.usersd.pb.GetUsageResponse
-	(*ArchivesLsRequest)(nil),               // 23: api.usersd.pb.ArchivesLsRequest
-	(*ArchivesLsResponse)(nil),              // 24: api.usersd.pb.ArchivesLsResponse
-	(*ArchiveLsItem)(nil),                   // 25: api.usersd.pb.ArchiveLsItem
-	(*ArchiveLsItemMetadata)(nil),           // 26: api.usersd.pb.ArchiveLsItemMetadata
-	(*ArchivesImportRequest)(nil),           // 27: api.usersd.pb.ArchivesImportRequest
-	(*ArchivesImportResponse)(nil),          // 28: api.usersd.pb.ArchivesImportResponse
-	(*ArchiveRetrievalLsRequest)(nil),       // 29: api.usersd.pb.ArchiveRetrievalLsRequest
-	(*ArchiveRetrievalLsResponse)(nil),      // 30: api.usersd.pb.ArchiveRetrievalLsResponse
-	(*ArchiveRetrievalLsItem)(nil),          // 31: api.usersd.pb.ArchiveRetrievalLsItem
-	(*ArchiveRetrievalLsItemNewBucket)(nil), // 32: api.usersd.pb.ArchiveRetrievalLsItemNewBucket
-	(*ArchiveRetrievalLogsRequest)(nil),     // 33: api.usersd.pb.ArchiveRetrievalLogsRequest
-	(*ArchiveRetrievalLogsResponse)(nil),    // 34: api.usersd.pb.ArchiveRetrievalLogsResponse
-	(*pb.GetCustomerResponse)(nil),          // 35: api.billingd.pb.GetCustomerResponse
-	(*pb.GetCustomerUsageResponse)(nil),     // 36: api.billingd.pb.GetCustomerUsageResponse
+	(*CheckAccessRequest)(nil),              // 23: api.usersd.pb.CheckAccessRequest
+	(*CheckAccessResponse)(nil),             // 24: api.usersd.pb.CheckAccessResponse
+	(*WatchUsageRequest)(nil),               // 25: api.usersd.pb.WatchUsageRequest
+	(*WatchUsageResponse)(nil),              // 26: api.usersd.pb.WatchUsageResponse
+	(*GetLimitsRequest)(nil),                // 27: api.usersd.pb.GetLimitsRequest
+	(*GetLimitsResponse)(nil),               // 28: api.usersd.pb.GetLimitsResponse
+	(*QuotaLimit)(nil),                      // 29: api.usersd.pb.QuotaLimit
+	(*Limit)(nil),                           // 30: api.usersd.pb.Limit
+	(*ArchivesLsRequest)(nil),               // 31: api.usersd.pb.ArchivesLsRequest
+	(*ArchivesLsResponse)(nil),              // 32: api.usersd.pb.ArchivesLsResponse
+	(*ArchiveLsItem)(nil),                   // 33: api.usersd.pb.ArchiveLsItem
+	(*ArchiveLsItemMetadata)(nil),           // 34: api.usersd.pb.ArchiveLsItemMetadata
+	(*ArchivesImportRequest)(nil),           // 35: api.usersd.pb.ArchivesImportRequest
+	(*ArchivesImportResponse)(nil),          // 36: api.usersd.pb.ArchivesImportResponse
+	(*ArchiveRetrievalLsRequest)(nil),       // 37: api.usersd.pb.ArchiveRetrievalLsRequest
+	(*ArchiveRetrievalLsResponse)(nil),      // 38: api.usersd.pb.ArchiveRetrievalLsResponse
+	(*ArchiveRetrievalLsItem)(nil),          // 39: api.usersd.pb.ArchiveRetrievalLsItem
+	(*ArchiveRetrievalLsItemNewBucket)(nil), // 40: api.usersd.pb.ArchiveRetrievalLsItemNewBucket
+	(*ArchiveRetrievalLogsRequest)(nil),     // 41: api.usersd.pb.ArchiveRetrievalLogsRequest
+	(*ArchiveRetrievalLogsResponse)(nil),    // 42: api.usersd.pb.ArchiveRetrievalLogsResponse
+	nil,                                     // 43: api.usersd.pb.WatchUsageResponse.DailyUsageEntry
+	nil,                                     // 44: api.usersd.pb.GetLimitsResponse.DailyQuotasEntry
+	nil,                                     // 45: api.usersd.pb.GetLimitsResponse.BurstLimitsEntry
+	(*pb.GetCustomerResponse)(nil),          // 46: api.billingd.pb.GetCustomerResponse
+	(*pb.GetCustomerUsageResponse)(nil),     // 47: api.billingd.pb.GetCustomerUsageResponse
+	(*pb.Usage)(nil),                        // 48: api.billingd.pb.Usage
 }
 var file_api_usersd_pb_usersd_proto_depIdxs = []int32{
 	5,  // 0: api.usersd.pb.ListThreadsResponse.list:type_name -> api.usersd.pb.GetThreadResponse
 	1,  // 1: api.usersd.pb.ListInboxMessagesRequest.status:type_name -> api.usersd.pb.ListInboxMessagesRequest.Status
 	8,  // 2: api.usersd.pb.ListInboxMessagesResponse.messages:type_name -> api.usersd.pb.Message
 	8,  // 3: api.usersd.pb.ListSentboxMessagesResponse.messages:type_name -> api.usersd.pb.Message
-	35, // 4: api.usersd.pb.GetUsageResponse.customer:type_name -> api.billingd.pb.GetCustomerResponse
-	36, // 5: api.usersd.pb.GetUsageResponse.usage:type_name -> api.billingd.pb.GetCustomerUsageResponse
-	25, // 6: api.usersd.pb.ArchivesLsResponse.archives:type_name -> api.usersd.pb.ArchiveLsItem
-	26, // 7: api.usersd.pb.ArchiveLsItem.info:type_name -> api.usersd.pb.ArchiveLsItemMetadata
-	31, // 8: api.usersd.pb.ArchiveRetrievalLsResponse.retrievals:type_name -> api.usersd.pb.ArchiveRetrievalLsItem
-	0,  // 9: api.usersd.pb.ArchiveRetrievalLsItem.status:type_name -> api.usersd.pb.ArchiveRetrievalStatus
-	32, // 10: api.usersd.pb.ArchiveRetrievalLsItem.new_bucket:type_name -> api.usersd.pb.ArchiveRetrievalLsItemNewBucket
-	4,  // 11: api.usersd.pb.APIService.GetThread:input_type -> api.usersd.pb.GetThreadRequest
-	2,  // 12: api.usersd.pb.APIService.ListThreads:input_type -> api.usersd.pb.ListThreadsRequest
-	6,  // 13: api.usersd.pb.APIService.SetupMailbox:input_type -> api.usersd.pb.SetupMailboxRequest
-	9,  // 14: api.usersd.pb.APIService.SendMessage:input_type -> api.usersd.pb.SendMessageRequest
-	11, // 15: api.usersd.pb.APIService.ListInboxMessages:input_type -> api.usersd.pb.ListInboxMessagesRequest
-	13, // 16: api.usersd.pb.APIService.ListSentboxMessages:input_type -> api.usersd.pb.ListSentboxMessagesRequest
-	15, // 17: api.usersd.pb.APIService.ReadInboxMessage:input_type -> api.usersd.pb.ReadInboxMessageRequest
-	17, // 18: api.usersd.pb.APIService.DeleteInboxMessage:input_type -> api.usersd.pb.DeleteInboxMessageRequest
-	19, // 19: api.usersd.pb.APIService.DeleteSentboxMessage:input_type -> api.usersd.pb.DeleteSentboxMessageRequest
-	21, // 20: api.usersd.pb.APIService.GetUsage:input_type -> api.usersd.pb.GetUsageRequest
-	23, // 21: api.usersd.pb.APIService.ArchivesLs:input_type -> api.usersd.pb.ArchivesLsRequest
-	27, // 22: api.usersd.pb.APIService.ArchivesImport:input_type -> api.usersd.pb.ArchivesImportRequest
-	29, // 23: api.usersd.pb.APIService.ArchiveRetrievalLs:input_type -> api.usersd.pb.ArchiveRetrievalLsRequest
-	33, // 24: api.usersd.pb.APIService.ArchiveRetrievalLogs:input_type -> api.usersd.pb.ArchiveRetrievalLogsRequest
-	5,  // 25: api.usersd.pb.APIService.GetThread:output_type -> api.usersd.pb.GetThreadResponse
-	3,  // 26: api.usersd.pb.APIService.ListThreads:output_type -> api.usersd.pb.ListThreadsResponse
-	7,  // 27: api.usersd.pb.APIService.SetupMailbox:output_type -> api.usersd.pb.SetupMailboxResponse
-	10, // 28: api.usersd.pb.APIService.SendMessage:output_type -> api.usersd.pb.SendMessageResponse
-	12, // 29: api.usersd.pb.APIService.ListInboxMessages:output_type -> api.usersd.pb.ListInboxMessagesResponse
-	14, // 30: api.usersd.pb.APIService.ListSentboxMessages:output_type -> api.usersd.pb.ListSentboxMessagesResponse
-	16, // 31: api.usersd.pb.APIService.ReadInboxMessage:output_type -> api.usersd.pb.ReadInboxMessageResponse
-	18, // 32: api.usersd.pb.APIService.DeleteInboxMessage:output_type -> api.usersd.pb.DeleteInboxMessageResponse
-	20, // 33: api.usersd.pb.APIService.DeleteSentboxMessage:output_type -> api.usersd.pb.DeleteSentboxMessageResponse
-	22, // 34: api.usersd.pb.APIService.GetUsage:output_type -> api.usersd.pb.GetUsageResponse
-	24, // 35: api.usersd.pb.APIService.ArchivesLs:output_type -> api.usersd.pb.ArchivesLsResponse
-	28, // 36: api.usersd.pb.APIService.ArchivesImport:output_type -> api.usersd.pb.ArchivesImportResponse
-	30, // 37: api.usersd.pb.APIService.ArchiveRetrievalLs:output_type -> api.usersd.pb.ArchiveRetrievalLsResponse
-	34, // 38: api.usersd.pb.APIService.ArchiveRetrievalLogs:output_type -> api.usersd.pb.ArchiveRetrievalLogsResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	46, // 4: api.usersd.pb.GetUsageResponse.customer:type_name -> api.billingd.pb.GetCustomerResponse
+	47, // 5: api.usersd.pb.GetUsageResponse.usage:type_name -> api.billingd.pb.GetCustomerUsageResponse
+	43, // 6: api.usersd.pb.WatchUsageResponse.daily_usage:type_name -> api.usersd.pb.WatchUsageResponse.DailyUsageEntry
+	44, // 7: api.usersd.pb.GetLimitsResponse.daily_quotas:type_name -> api.usersd.pb.GetLimitsResponse.DailyQuotasEntry
+	45, // 8: api.usersd.pb.GetLimitsResponse.burst_limits:type_name -> api.usersd.pb.GetLimitsResponse.BurstLimitsEntry
+	30, // 9: api.usersd.pb.GetLimitsResponse.inflight_egress:type_name -> api.usersd.pb.Limit
+	30, // 10: api.usersd.pb.GetLimitsResponse.buckets:type_name -> api.usersd.pb.Limit
+	30, // 11: api.usersd.pb.GetLimitsResponse.threads:type_name -> api.usersd.pb.Limit
+	30, // 12: api.usersd.pb.GetLimitsResponse.stored_data_cap:type_name -> api.usersd.pb.Limit
+	33, // 13: api.usersd.pb.ArchivesLsResponse.archives:type_name -> api.usersd.pb.ArchiveLsItem
+	34, // 14: api.usersd.pb.ArchiveLsItem.info:type_name -> api.usersd.pb.ArchiveLsItemMetadata
+	39, // 15: api.usersd.pb.ArchiveRetrievalLsResponse.retrievals:type_name -> api.usersd.pb.ArchiveRetrievalLsItem
+	0,  // 16: api.usersd.pb.ArchiveRetrievalLsItem.status:type_name -> api.usersd.pb.ArchiveRetrievalStatus
+	40, // 17: api.usersd.pb.ArchiveRetrievalLsItem.new_bucket:type_name -> api.usersd.pb.ArchiveRetrievalLsItemNewBucket
+	48, // 18: api.usersd.pb.WatchUsageResponse.DailyUsageEntry.value:type_name -> api.billingd.pb.Usage
+	29, // 19: api.usersd.pb.GetLimitsResponse.DailyQuotasEntry.value:type_name -> api.usersd.pb.QuotaLimit
+	30, // 20: api.usersd.pb.GetLimitsResponse.BurstLimitsEntry.value:type_name -> api.usersd.pb.Limit
+	4,  // 21: api.usersd.pb.APIService.GetThread:input_type -> api.usersd.pb.GetThreadRequest
+	2,  // 22: api.usersd.pb.APIService.ListThreads:input_type -> api.usersd.pb.ListThreadsRequest
+	6,  // 23: api.usersd.pb.APIService.SetupMailbox:input_type -> api.usersd.pb.SetupMailboxRequest
+	9,  // 24: api.usersd.pb.APIService.SendMessage:input_type -> api.usersd.pb.SendMessageRequest
+	11, // 25: api.usersd.pb.APIService.ListInboxMessages:input_type -> api.usersd.pb.ListInboxMessagesRequest
+	13, // 26: api.usersd.pb.APIService.ListSentboxMessages:input_type -> api.usersd.pb.ListSentboxMessagesRequest
+	15, // 27: api.usersd.pb.APIService.ReadInboxMessage:input_type -> api.usersd.pb.ReadInboxMessageRequest
+	17, // 28: api.usersd.pb.APIService.DeleteInboxMessage:input_type -> api.usersd.pb.DeleteInboxMessageRequest
+	19, // 29: api.usersd.pb.APIService.DeleteSentboxMessage:input_type -> api.usersd.pb.DeleteSentboxMessageRequest
+	21, // 30: api.usersd.pb.APIService.GetUsage:input_type -> api.usersd.pb.GetUsageRequest
+	23, // 31: api.usersd.pb.APIService.CheckAccess:input_type -> api.usersd.pb.CheckAccessRequest
+	27, // 32: api.usersd.pb.APIService.GetLimits:input_type -> api.usersd.pb.GetLimitsRequest
+	25, // 33: api.usersd.pb.APIService.WatchUsage:input_type -> api.usersd.pb.WatchUsageRequest
+	31, // 34: api.usersd.pb.APIService.ArchivesLs:input_type -> api.usersd.pb.ArchivesLsRequest
+	35, // 35: api.usersd.pb.APIService.ArchivesImport:input_type -> api.usersd.pb.ArchivesImportRequest
+	37, // 36: api.usersd.pb.APIService.ArchiveRetrievalLs:input_type -> api.usersd.pb.ArchiveRetrievalLsRequest
+	41, // 37: api.usersd.pb.APIService.ArchiveRetrievalLogs:input_type -> api.usersd.pb.ArchiveRetrievalLogsRequest
+	5,  // 38: api.usersd.pb.APIService.GetThread:output_type -> api.usersd.pb.GetThreadResponse
+	3,  // 39: api.usersd.pb.APIService.ListThreads:output_type -> api.usersd.pb.ListThreadsResponse
+	7,  // 40: api.usersd.pb.APIService.SetupMailbox:output_type -> api.usersd.pb.SetupMailboxResponse
+	10, // 41: api.usersd.pb.APIService.SendMessage:output_type -> api.usersd.pb.SendMessageResponse
+	12, // 42: api.usersd.pb.APIService.ListInboxMessages:output_type -> api.usersd.pb.ListInboxMessagesResponse
+	14, // 43: api.usersd.pb.APIService.ListSentboxMessages:output_type -> api.usersd.pb.ListSentboxMessagesResponse
+	16, // 44: api.usersd.pb.APIService.ReadInboxMessage:output_type -> api.usersd.pb.ReadInboxMessageResponse
+	18, // 45: api.usersd.pb.APIService.DeleteInboxMessage:output_type -> api.usersd.pb.DeleteInboxMessageResponse
+	20, // 46: api.usersd.pb.APIService.DeleteSentboxMessage:output_type -> api.usersd.pb.DeleteSentboxMessageResponse
+	22, // 47: api.usersd.pb.APIService.GetUsage:output_type -> api.usersd.pb.GetUsageResponse
+	24, // 48: api.usersd.pb.APIService.CheckAccess:output_type -> api.usersd.pb.CheckAccessResponse
+	28, // 49: api.usersd.pb.APIService.GetLimits:output_type -> api.usersd.pb.GetLimitsResponse
+	26, // 50: api.usersd.pb.APIService.WatchUsage:output_type -> api.usersd.pb.WatchUsageResponse
+	32, // 51: api.usersd.pb.APIService.ArchivesLs:output_type -> api.usersd.pb.ArchivesLsResponse
+	36, // 52: api.usersd.pb.APIService.ArchivesImport:output_type -> api.usersd.pb.ArchivesImportResponse
+	38, // 53: api.usersd.pb.APIService.ArchiveRetrievalLs:output_type -> api.usersd.pb.ArchiveRetrievalLsResponse
+	42, // 54: api.usersd.pb.APIService.ArchiveRetrievalLogs:output_type -> api.usersd.pb.ArchiveRetrievalLogsResponse
+	38, // [38:55] is the sub-list for method output_type
+	21, // [21:38] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_api_usersd_pb_usersd_proto_init() }
@@ -2508,7 +3120,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesLsRequest); i {
+			switch v := v.(*CheckAccessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2520,7 +3132,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesLsResponse); i {
+			switch v := v.(*CheckAccessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2532,7 +3144,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveLsItem); i {
+			switch v := v.(*WatchUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2544,7 +3156,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveLsItemMetadata); i {
+			switch v := v.(*WatchUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2556,7 +3168,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesImportRequest); i {
+			switch v := v.(*GetLimitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2568,7 +3180,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesImportResponse); i {
+			switch v := v.(*GetLimitsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2580,7 +3192,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRetrievalLsRequest); i {
+			switch v := v.(*QuotaLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2592,7 +3204,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRetrievalLsResponse); i {
+			switch v := v.(*Limit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2604,7 +3216,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRetrievalLsItem); i {
+			switch v := v.(*ArchivesLsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2616,7 +3228,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRetrievalLsItemNewBucket); i {
+			switch v := v.(*ArchivesLsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2628,7 +3240,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRetrievalLogsRequest); i {
+			switch v := v.(*ArchiveLsItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2640,6 +3252,102 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 		file_api_usersd_pb_usersd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveLsItemMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivesImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivesImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetrievalLsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetrievalLsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetrievalLsItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetrievalLsItemNewBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetrievalLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_usersd_pb_usersd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ArchiveRetrievalLogsResponse); i {
 			case 0:
 				return &v.state
@@ -2652,7 +3360,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			}
 		}
 	}
-	file_api_usersd_pb_usersd_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_api_usersd_pb_usersd_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*ArchiveRetrievalLsItem_NewBucket)(nil),
 	}
 	type x struct{}
@@ -2661,7 +3369,7 @@ func file_api_usersd_pb_usersd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_usersd_pb_usersd_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   33,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2698,6 +3406,9 @@ type APIServiceClient interface {
 	DeleteInboxMessage(ctx context.Context, in *DeleteInboxMessageRequest, opts ...grpc.CallOption) (*DeleteInboxMessageResponse, error)
 	DeleteSentboxMessage(ctx context.Context, in *DeleteSentboxMessageRequest, opts ...grpc.CallOption) (*DeleteSentboxMessageResponse, error)
 	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+	GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*GetLimitsResponse, error)
+	WatchUsage(ctx context.Context, in *WatchUsageRequest, opts ...grpc.CallOption) (APIService_WatchUsageClient, error)
 	// Archives Import
 	ArchivesLs(ctx context.Context, in *ArchivesLsRequest, opts ...grpc.CallOption) (*ArchivesLsResponse, error)
 	ArchivesImport(ctx context.Context, in *ArchivesImportRequest, opts ...grpc.CallOption) (*ArchivesImportResponse, error)
@@ -2804,6 +3515,56 @@ func (c *aPIServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, op
 	return out, nil
 }
 
+func (c *aPIServiceClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, "/api.usersd.pb.APIService/CheckAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*GetLimitsResponse, error) {
+	out := new(GetLimitsResponse)
+	err := c.cc.Invoke(ctx, "/api.usersd.pb.APIService/GetLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) WatchUsage(ctx context.Context, in *WatchUsageRequest, opts ...grpc.CallOption) (APIService_WatchUsageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/api.usersd.pb.APIService/WatchUsage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceWatchUsageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_WatchUsageClient interface {
+	Recv() (*WatchUsageResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceWatchUsageClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceWatchUsageClient) Recv() (*WatchUsageResponse, error) {
+	m := new(WatchUsageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIServiceClient) ArchivesLs(ctx context.Context, in *ArchivesLsRequest, opts ...grpc.CallOption) (*ArchivesLsResponse, error) {
 	out := new(ArchivesLsResponse)
 	err := c.cc.Invoke(ctx, "/api.usersd.pb.APIService/ArchivesLs", in, out, opts...)
@@ -2832,7 +3593,7 @@ func (c *aPIServiceClient) ArchiveRetrievalLs(ctx context.Context, in *ArchiveRe
 }
 
 func (c *aPIServiceClient) ArchiveRetrievalLogs(ctx context.Context, in *ArchiveRetrievalLogsRequest, opts ...grpc.CallOption) (APIService_ArchiveRetrievalLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/api.usersd.pb.APIService/ArchiveRetrievalLogs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[1], "/api.usersd.pb.APIService/ArchiveRetrievalLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2875,6 +3636,9 @@ type APIServiceServer interface {
 	DeleteInboxMessage(context.Context, *DeleteInboxMessageRequest) (*DeleteInboxMessageResponse, error)
 	DeleteSentboxMessage(context.Context, *DeleteSentboxMessageRequest) (*DeleteSentboxMessageResponse, error)
 	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+	GetLimits(context.Context, *GetLimitsRequest) (*GetLimitsResponse, error)
+	WatchUsage(*WatchUsageRequest, APIService_WatchUsageServer) error
 	// Archives Import
 	ArchivesLs(context.Context, *ArchivesLsRequest) (*ArchivesLsResponse, error)
 	ArchivesImport(context.Context, *ArchivesImportRequest) (*ArchivesImportResponse, error)
@@ -2917,6 +3681,15 @@ func (*UnimplementedAPIServiceServer) DeleteSentboxMessage(context.Context, *Del
 func (*UnimplementedAPIServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
 }
+func (*UnimplementedAPIServiceServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetLimits(context.Context, *GetLimitsRequest) (*GetLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimits not implemented")
+}
+func (*UnimplementedAPIServiceServer) WatchUsage(*WatchUsageRequest, APIService_WatchUsageServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUsage not implemented")
+}
 func (*UnimplementedAPIServiceServer) ArchivesLs(context.Context, *ArchivesLsRequest) (*ArchivesLsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchivesLs not implemented")
 }
@@ -3114,6 +3887,63 @@ func _APIService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.usersd.pb.APIService/CheckAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.usersd.pb.APIService/GetLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetLimits(ctx, req.(*GetLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_WatchUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUsageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).WatchUsage(m, &aPIServiceWatchUsageServer{stream})
+}
+
+type APIService_WatchUsageServer interface {
+	Send(*WatchUsageResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceWatchUsageServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceWatchUsageServer) Send(m *WatchUsageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _APIService_ArchivesLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchivesLsRequest)
 	if err := dec(in); err != nil {
@@ -3233,6 +4063,14 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsage",
 			Handler:    _APIService_GetUsage_Handler,
 		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _APIService_CheckAccess_Handler,
+		},
+		{
+			MethodName: "GetLimits",
+			Handler:    _APIService_GetLimits_Handler,
+		},
 		{
 			MethodName: "ArchivesLs",
 			Handler:    _APIService_ArchivesLs_Handler,
@@ -3247,6 +4085,11 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUsage",
+			Handler:       _APIService_WatchUsage_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ArchiveRetrievalLogs",
 			Handler:       _APIService_ArchiveRetrievalLogs_Handler,
@@ -34,6 +34,27 @@ type Service struct {
 	BillingClient   *billing.Client
 	FilRetrieval    *retrieval.FilRetrieval
 	PowergateClient *pow.Client
+	AccessChecker   AccessChecker
+	LimitReporter   LimitReporter
+	UsageWatcher    UsageWatcher
+}
+
+// AccessChecker evaluates whether or not requests would be allowed without handling them.
+type AccessChecker interface {
+	// CheckAccess returns whether or not a request to method with the credentials in ctx would be allowed.
+	CheckAccess(ctx context.Context, method string) (*pb.CheckAccessResponse, error)
+}
+
+// LimitReporter reports the limits that apply to owners.
+type LimitReporter interface {
+	// GetLimits returns every limit enforced for the owner in ctx along with their current usage.
+	GetLimits(ctx context.Context) (*pb.GetLimitsResponse, error)
+}
+
+// UsageWatcher streams the usage of owners as it changes.
+type UsageWatcher interface {
+	// WatchUsage sends the daily usage of the owner in ctx with send each time it changes until ctx is done.
+	WatchUsage(ctx context.Context, send func(*pb.WatchUsageResponse) error) error
 }
 
 func (s *Service) GetThread(ctx context.Context, req *pb.GetThreadRequest) (*pb.GetThreadResponse, error) {
@@ -382,6 +403,36 @@ func (s *Service) GetUsage(ctx context.Context, req *pb.GetUsageRequest) (*pb.Ge
 	}, nil
 }
 
+func (s *Service) CheckAccess(ctx context.Context, req *pb.CheckAccessRequest) (*pb.CheckAccessResponse, error) {
+	log.Debugf("received check access request")
+
+	if s.AccessChecker == nil {
+		return nil, status.Error(codes.Unimplemented, "Access checks are not enabled")
+	}
+	if req.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "Method is required")
+	}
+	return s.AccessChecker.CheckAccess(ctx, req.Method)
+}
+
+func (s *Service) GetLimits(ctx context.Context, _ *pb.GetLimitsRequest) (*pb.GetLimitsResponse, error) {
+	log.Debugf("received get limits request")
+
+	if s.LimitReporter == nil {
+		return nil, status.Error(codes.Unimplemented, "Limits are not enabled")
+	}
+	return s.LimitReporter.GetLimits(ctx)
+}
+
+func (s *Service) WatchUsage(_ *pb.WatchUsageRequest, server pb.APIService_WatchUsageServer) error {
+	log.Debugf("received watch usage request")
+
+	if s.UsageWatcher == nil {
+		return status.Error(codes.Unimplemented, "Usage is not enabled")
+	}
+	return s.UsageWatcher.WatchUsage(server.Context(), server.Send)
+}
+
 func (s *Service) getMailbox(ctx context.Context, key thread.PubKey) (thread.ID, error) {
 	thrd, err := s.Collections.Threads.GetByName(ctx, mail.ThreadName, key)
 	if err != nil {
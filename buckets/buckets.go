@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
+	"sync/atomic"
 
 	"github.com/textileio/go-threads/core/thread"
 	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
@@ -37,6 +39,33 @@ type BucketOwner struct {
 	StorageUsed      int64
 	StorageAvailable int64
 	StorageDelta     int64
+	// StorageExcluded is set when the request writes to a bucket that is excluded from
+	// storage accounting. Its storage is neither limited nor counted.
+	StorageExcluded bool
+	// StorageScope identifies the bucket path whose storage is changed by the request.
+	// Successive storage deltas for the same scope may be compacted before they're billed.
+	StorageScope string
+	// BucketKey is the key of the bucket whose storage is changed by the request.
+	BucketKey string
+	// Unchanged is set when the request succeeded without an effective change, e.g., removing
+	// a path that doesn't exist. Its storage changes are not billed.
+	Unchanged bool
+	// ObjectDelta is the change in the number of objects stored by the owner made by the request,
+	// e.g., zero when a path is overwritten, or minus the number of files in a removed bucket.
+	ObjectDelta int64
+}
+
+// IsUnlimited returns whether or not the owner's storage is unlimited, e.g., a billable owner without a cap.
+func (o *BucketOwner) IsUnlimited() bool {
+	return o != nil && o.StorageAvailable == math.MaxInt64
+}
+
+// RemainingStorage returns the number of bytes the owner can still store.
+func (o *BucketOwner) RemainingStorage() int64 {
+	if o == nil || o.StorageAvailable < 0 {
+		return 0
+	}
+	return o.StorageAvailable
 }
 
 func NewBucketOwnerContext(ctx context.Context, owner *BucketOwner) context.Context {
@@ -48,6 +77,81 @@ func BucketOwnerFromContext(ctx context.Context) (*BucketOwner, bool) {
 	return owner, ok
 }
 
+// GetBucketOwner returns the owner from context, or a zero-value owner if context doesn't have one.
+// Storage is not metered for requests without an owner. Use BucketOwnerFromContext
+// to tell a missing owner apart from an owner without storage.
+func GetBucketOwner(ctx context.Context) *BucketOwner {
+	if owner, ok := BucketOwnerFromContext(ctx); ok && owner != nil {
+		return owner
+	}
+	return &BucketOwner{}
+}
+
+// ReadSource records how the response to a read request is served.
+type ReadSource struct {
+	cacheServed int32
+	precounted  int64
+	precount    func(n int64)
+	admit       func(n int64) error
+}
+
+// NewReadSource returns a read source. If precount is not nil, it's called with the bytes
+// reported by PrecountEgress so that they can be metered before they're sent. If admit is not nil,
+// it's called with the bytes reported by AdmitEgress so that the read can be denied before it starts.
+func NewReadSource(precount func(n int64), admit func(n int64) error) *ReadSource {
+	return &ReadSource{precount: precount, admit: admit}
+}
+
+func NewReadSourceContext(ctx context.Context, src *ReadSource) context.Context {
+	return context.WithValue(ctx, ctxKey("readSource"), src)
+}
+
+func ReadSourceFromContext(ctx context.Context) (*ReadSource, bool) {
+	src, ok := ctx.Value(ctxKey("readSource")).(*ReadSource)
+	return src, ok
+}
+
+// SetCacheServed tags the read response for context as served by an upstream cache or CDN,
+// i.e., it doesn't incur origin egress. It's a no-op if the read is not metered.
+func SetCacheServed(ctx context.Context) {
+	if src, ok := ReadSourceFromContext(ctx); ok && src != nil {
+		atomic.StoreInt32(&src.cacheServed, 1)
+	}
+}
+
+// CacheServed returns whether or not the response was tagged as served by a cache.
+func (s *ReadSource) CacheServed() bool {
+	return s != nil && atomic.LoadInt32(&s.cacheServed) == 1
+}
+
+// PrecountEgress reports that n bytes are expected to be sent in response to the read request
+// for context, e.g., the size of a file. It's a no-op if the read is not metered or its egress
+// is not precounted.
+func PrecountEgress(ctx context.Context, n int64) {
+	if src, ok := ReadSourceFromContext(ctx); ok && src != nil && src.precount != nil && n > 0 {
+		atomic.AddInt64(&src.precounted, n)
+		src.precount(n)
+	}
+}
+
+// AdmitEgress checks that an estimated n bytes, e.g., the size of a file, can be sent in response
+// to the read request for context before the read starts. It's a no-op if the read is not metered
+// or its egress is not limited.
+func AdmitEgress(ctx context.Context, n int64) error {
+	if src, ok := ReadSourceFromContext(ctx); ok && src != nil && src.admit != nil {
+		return src.admit(n)
+	}
+	return nil
+}
+
+// Precounted returns the bytes reported by PrecountEgress.
+func (s *ReadSource) Precounted() int64 {
+	if s == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&s.precounted)
+}
+
 // Role describes an access role for a bucket item.
 type Role int
 
@@ -0,0 +1,39 @@
+package buckets
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketOwner_Billable(t *testing.T) {
+	owner := &BucketOwner{StorageUsed: 100, StorageAvailable: math.MaxInt64}
+	assert.True(t, owner.IsUnlimited())
+	assert.Equal(t, int64(math.MaxInt64), owner.RemainingStorage())
+}
+
+func TestBucketOwner_NonBillable(t *testing.T) {
+	owner := &BucketOwner{StorageUsed: 100, StorageAvailable: 400}
+	assert.False(t, owner.IsUnlimited())
+	assert.Equal(t, int64(400), owner.RemainingStorage())
+
+	owner.StorageAvailable = -10
+	assert.Equal(t, int64(0), owner.RemainingStorage())
+}
+
+func TestGetBucketOwner(t *testing.T) {
+	owner := GetBucketOwner(context.Background())
+	assert.NotNil(t, owner)
+	assert.False(t, owner.IsUnlimited())
+	assert.Equal(t, int64(0), owner.RemainingStorage())
+
+	expected := &BucketOwner{StorageAvailable: 5}
+	ctx := NewBucketOwnerContext(context.Background(), expected)
+	assert.Equal(t, expected, GetBucketOwner(ctx))
+
+	var missing *BucketOwner
+	assert.False(t, missing.IsUnlimited())
+	assert.Equal(t, int64(0), missing.RemainingStorage())
+}
@@ -0,0 +1,102 @@
+package buckets
+
+import (
+	"sync"
+	"time"
+
+	"github.com/textileio/textile/v2/util"
+)
+
+// StorageReservation is an amount of storage set aside for an owner's upload.
+type StorageReservation struct {
+	Token     string
+	Size      int64
+	ExpiresAt time.Time
+}
+
+// StorageReservations tracks storage reserved by owners ahead of large uploads.
+// Reserved storage is unavailable to other requests until it's consumed or expires.
+type StorageReservations struct {
+	ttl time.Duration
+	now func() time.Time
+
+	lk     sync.Mutex
+	owners map[string]map[string]*StorageReservation
+}
+
+// NewStorageReservations returns a new reservation tracker.
+// Reservations that are not consumed within ttl expire.
+func NewStorageReservations(ttl time.Duration) *StorageReservations {
+	return &StorageReservations{
+		ttl:    ttl,
+		now:    time.Now,
+		owners: make(map[string]map[string]*StorageReservation),
+	}
+}
+
+// Reserve reserves size bytes for owner if the owner's available storage,
+// less any outstanding reservations, allows it.
+func (r *StorageReservations) Reserve(owner string, size, available int64) (*StorageReservation, bool) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	if size <= 0 || r.reservedLocked(owner)+size > available {
+		return nil, false
+	}
+	res := &StorageReservation{
+		Token:     util.MakeToken(32),
+		Size:      size,
+		ExpiresAt: r.now().Add(r.ttl),
+	}
+	rs, ok := r.owners[owner]
+	if !ok {
+		rs = make(map[string]*StorageReservation)
+		r.owners[owner] = rs
+	}
+	rs[res.Token] = res
+	return res, true
+}
+
+// Consume removes and returns an owner's reservation.
+// False is returned if the reservation does not exist or has expired.
+func (r *StorageReservations) Consume(owner, token string) (*StorageReservation, bool) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	r.pruneLocked(owner)
+	res, ok := r.owners[owner][token]
+	if !ok {
+		return nil, false
+	}
+	delete(r.owners[owner], token)
+	if len(r.owners[owner]) == 0 {
+		delete(r.owners, owner)
+	}
+	return res, true
+}
+
+// Reserved returns the total size of an owner's outstanding reservations.
+func (r *StorageReservations) Reserved(owner string) int64 {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	return r.reservedLocked(owner)
+}
+
+func (r *StorageReservations) reservedLocked(owner string) int64 {
+	r.pruneLocked(owner)
+	var total int64
+	for _, res := range r.owners[owner] {
+		total += res.Size
+	}
+	return total
+}
+
+func (r *StorageReservations) pruneLocked(owner string) {
+	now := r.now()
+	for token, res := range r.owners[owner] {
+		if !now.Before(res.ExpiresAt) {
+			delete(r.owners[owner], token)
+		}
+	}
+	if len(r.owners[owner]) == 0 {
+		delete(r.owners, owner)
+	}
+}
@@ -0,0 +1,67 @@
+package buckets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageReservations_Reserve(t *testing.T) {
+	r := NewStorageReservations(time.Hour)
+
+	res, ok := r.Reserve("owner", 60, 100)
+	require.True(t, ok)
+	assert.NotEmpty(t, res.Token)
+	assert.Equal(t, int64(60), r.Reserved("owner"))
+
+	_, ok = r.Reserve("owner", 50, 100)
+	assert.False(t, ok)
+	res2, ok := r.Reserve("owner", 40, 100)
+	require.True(t, ok)
+	assert.NotEqual(t, res.Token, res2.Token)
+	assert.Equal(t, int64(100), r.Reserved("owner"))
+
+	// Reservations are tracked per owner.
+	_, ok = r.Reserve("other", 100, 100)
+	assert.True(t, ok)
+
+	_, ok = r.Reserve("owner", 0, 100)
+	assert.False(t, ok)
+}
+
+func TestStorageReservations_Consume(t *testing.T) {
+	r := NewStorageReservations(time.Hour)
+	res, ok := r.Reserve("owner", 60, 100)
+	require.True(t, ok)
+
+	_, ok = r.Consume("other", res.Token)
+	assert.False(t, ok)
+
+	consumed, ok := r.Consume("owner", res.Token)
+	require.True(t, ok)
+	assert.Equal(t, int64(60), consumed.Size)
+	assert.Equal(t, int64(0), r.Reserved("owner"))
+
+	_, ok = r.Consume("owner", res.Token)
+	assert.False(t, ok)
+}
+
+func TestStorageReservations_Expiry(t *testing.T) {
+	now := time.Now()
+	r := NewStorageReservations(time.Minute)
+	r.now = func() time.Time { return now }
+
+	res, ok := r.Reserve("owner", 100, 100)
+	require.True(t, ok)
+	_, ok = r.Reserve("owner", 1, 100)
+	assert.False(t, ok)
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, int64(0), r.Reserved("owner"))
+	_, ok = r.Consume("owner", res.Token)
+	assert.False(t, ok)
+	_, ok = r.Reserve("owner", 100, 100)
+	assert.True(t, ok)
+}
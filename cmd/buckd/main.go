@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/spf13/cobra"
@@ -14,7 +15,12 @@ import (
 	"github.com/textileio/textile/v2/core"
 )
 
-const daemonName = "buckd"
+const (
+	daemonName = "buckd"
+
+	// drainTimeout is how long in-flight requests are given to complete on shutdown.
+	drainTimeout = 30 * time.Second
+)
 
 var (
 	log = logging.Logger(daemonName)
@@ -288,6 +294,11 @@ var rootCmd = &cobra.Command{
 		fmt.Println("Your peer ID is " + textile.HostID().String())
 
 		cmd.HandleInterrupt(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+			defer cancel()
+			if err := textile.Drain(ctx); err != nil {
+				fmt.Println(err.Error())
+			}
 			if err := textile.Close(); err != nil {
 				fmt.Println(err.Error())
 			}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	logging "github.com/ipfs/go-log/v2"
@@ -13,11 +15,16 @@ import (
 	"github.com/textileio/go-threads/util"
 	"github.com/textileio/textile/v2/cmd"
 	"github.com/textileio/textile/v2/core"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
 )
 
 const (
 	daemonName = "hubd"
 
+	// drainTimeout is how long in-flight requests are given to complete on shutdown.
+	drainTimeout = 30 * time.Second
+
 	mib = 1024 * 1024
 	gib = 1024 * mib
 )
@@ -42,6 +49,18 @@ var (
 				Key:      "log.file",
 				DefValue: "", // no log file
 			},
+			"methodValidation": {
+				Key:      "method_validation",
+				DefValue: "log",
+			},
+			"maxConcurrentStreams": {
+				Key:      "max_concurrent_streams",
+				DefValue: 0,
+			},
+			"failClosedUnknownMethods": {
+				Key:      "fail_closed_unknown_methods",
+				DefValue: false,
+			},
 
 			// Addresses
 			"addrApi": {
@@ -98,6 +117,34 @@ var (
 				Key:      "buckets.archive_max_rep_factor",
 				DefValue: 4,
 			},
+			"bucketsDefaultName": {
+				Key:      "buckets.default_name",
+				DefValue: "",
+			},
+			"bucketsDefaultStrict": {
+				Key:      "buckets.default_strict",
+				DefValue: false,
+			},
+			"bucketsStorageExcluded": {
+				Key:      "buckets.storage_excluded",
+				DefValue: []string{},
+			},
+			"bucketsMaxNumberPerOwner": {
+				Key:      "buckets.max_number_per_owner",
+				DefValue: 0,
+			},
+			"bucketsMaxNumberPerBillableOwner": {
+				Key:      "buckets.max_number_per_billable_owner",
+				DefValue: 0,
+			},
+			"bucketsLimitEnforcement": {
+				Key:      "buckets.limit_enforcement",
+				DefValue: "reserve",
+			},
+			"bucketsMaxObjectsPerOwner": {
+				Key:      "buckets.max_objects_per_owner",
+				DefValue: int64(0),
+			},
 
 			// Threads
 			"threadsMaxNumberPerOwner": {
@@ -106,11 +153,25 @@ var (
 			},
 
 			// Powergate
+			"powergateDeferUserCreation": {
+				Key:      "powergate.defer_user_creation",
+				DefValue: false,
+			},
+			"powergateUserRetryInterval": {
+				Key:      "powergate.user_retry_interval",
+				DefValue: time.Minute,
+			},
 			"powergateAdminToken": {
 				Key:      "powergate.admin_token",
 				DefValue: "",
 			},
 
+			// Admin
+			"adminToken": {
+				Key:      "admin.token",
+				DefValue: "",
+			},
+
 			// Archives
 			"archivesJobPollIntervalSlow": {
 				Key:      "archives.job_poll_interval_slow",
@@ -127,6 +188,24 @@ var (
 				DefValue: false,
 			},
 
+			// Metrics
+			"metricsBusyOwnerSampleInterval": {
+				Key:      "metrics.busy_owner_sample_interval",
+				DefValue: time.Duration(0),
+			},
+			"metricsBusyOwnerSampleSize": {
+				Key:      "metrics.busy_owner_sample_size",
+				DefValue: 10,
+			},
+			"metricsQuotaHeadroomSampleInterval": {
+				Key:      "metrics.quota_headroom_sample_interval",
+				DefValue: time.Duration(0),
+			},
+			"metricsQuotaHeadroomSampleSize": {
+				Key:      "metrics.quota_headroom_sample_size",
+				DefValue: 100,
+			},
+
 			// Cloudflare
 			"dnsDomain": {
 				Key:      "dns.domain",
@@ -150,6 +229,10 @@ var (
 				Key:      "customerio.invite_template",
 				DefValue: "2",
 			},
+			"customerioSoftCapTmpl": {
+				Key:      "customerio.soft_cap_template",
+				DefValue: "",
+			},
 			"customerioConfirmTmpl": {
 				Key:      "customerio.confirm_template",
 				DefValue: "3",
@@ -158,6 +241,340 @@ var (
 				Key:      "email.session_secret",
 				DefValue: "",
 			},
+
+			// Billing
+			"billingUsageFlushInterval": {
+				Key:      "billing.usage_flush_interval",
+				DefValue: time.Duration(0),
+			},
+			"billingUsageBacklogMax": {
+				Key:      "billing.usage_backlog_max",
+				DefValue: 0,
+			},
+			"billingUsageBacklogOverflow": {
+				Key:      "billing.usage_backlog_overflow",
+				DefValue: "flush",
+			},
+			"billingUsageFlushConcurrency": {
+				Key:      "billing.usage_flush_concurrency",
+				DefValue: 10,
+			},
+			"billingUsageReplayInterval": {
+				Key:      "billing.usage_replay_interval",
+				DefValue: time.Duration(0),
+			},
+			"billingUsageDeadLetterMaxAge": {
+				Key:      "billing.usage_dead_letter_max_age",
+				DefValue: time.Hour * 24 * 7,
+			},
+			"billingChaos": {
+				Key:      "billing.chaos",
+				DefValue: false,
+			},
+			"billingClockSkewInterval": {
+				Key:      "billing.clock_skew_interval",
+				DefValue: time.Duration(0),
+			},
+			"billingClockSkewLogThreshold": {
+				Key:      "billing.clock_skew_log_threshold",
+				DefValue: time.Second * 5,
+			},
+			"billingDecisionCacheTTL": {
+				Key:      "billing.decision_cache_ttl",
+				DefValue: time.Duration(0),
+			},
+			"billingRequestDedupTTL": {
+				Key:      "billing.request_dedup_ttl",
+				DefValue: time.Duration(0),
+			},
+			"billingGlobalRequestLimit": {
+				Key:      "billing.global_request_limit",
+				DefValue: 0,
+			},
+			"billingLowPriorityShare": {
+				Key:      "billing.low_priority_share",
+				DefValue: 0.8,
+			},
+			"billingHighPriorityOwners": {
+				Key:      "billing.high_priority_owners",
+				DefValue: []string{},
+			},
+			"billingStorageSoftCap": {
+				Key:      "billing.storage_soft_cap",
+				DefValue: int64(0),
+			},
+			"billingStorageSoftCapWindow": {
+				Key:      "billing.storage_soft_cap_window",
+				DefValue: time.Hour * 24 * 30,
+			},
+			"billingAnonymousEgress": {
+				Key:      "billing.anonymous_egress",
+				DefValue: false,
+			},
+			"billingCachedEgressMultiplier": {
+				Key:      "billing.cached_egress_multiplier",
+				DefValue: 1.0,
+			},
+			"billingEgressWarnThreshold": {
+				Key:      "billing.egress_warn_threshold",
+				DefValue: int64(0),
+			},
+			"billingEgressPrecount": {
+				Key:      "billing.egress_precount",
+				DefValue: false,
+			},
+			"billingEgressWarnThenBlock": {
+				Key:      "billing.egress_warn_then_block",
+				DefValue: false,
+			},
+			"billingEgressThrottleThreshold": {
+				Key:      "billing.egress_throttle_threshold",
+				DefValue: int64(0),
+			},
+			"billingEgressThrottleRate": {
+				Key:      "billing.egress_throttle_rate",
+				DefValue: int64(0),
+			},
+			"billingEgressWarningGrace": {
+				Key:      "billing.egress_warning_grace",
+				DefValue: int64(0),
+			},
+			"billingSubscriptionGracePeriod": {
+				Key:      "billing.subscription_grace_period",
+				DefValue: time.Duration(0),
+			},
+			"billingEgressBlockThreshold": {
+				Key:      "billing.egress_block_threshold",
+				DefValue: int64(0),
+			},
+			"billingBurstWindow": {
+				Key:      "billing.burst_window",
+				DefValue: time.Duration(0),
+			},
+			"billingBurstReadLimit": {
+				Key:      "billing.burst_read_limit",
+				DefValue: 0,
+			},
+			"billingBurstWriteLimit": {
+				Key:      "billing.burst_write_limit",
+				DefValue: 0,
+			},
+			"billingEgressBurstWindow": {
+				Key:      "billing.egress_burst_window",
+				DefValue: time.Duration(0),
+			},
+			"billingEgressBurstLimit": {
+				Key:      "billing.egress_burst_limit",
+				DefValue: int64(0),
+			},
+			"billingReadWriteRatio": {
+				Key:      "billing.read_write_ratio",
+				DefValue: float64(0),
+			},
+			"billingReadWriteRatioWindow": {
+				Key:      "billing.read_write_ratio_window",
+				DefValue: time.Hour,
+			},
+			"billingReadWriteRatioMinReads": {
+				Key:      "billing.read_write_ratio_min_reads",
+				DefValue: int64(1000),
+			},
+			"billingReadWriteRatioExemptBillable": {
+				Key:      "billing.read_write_ratio_exempt_billable",
+				DefValue: false,
+			},
+			"billingDeferredUploads": {
+				Key:      "billing.deferred_uploads",
+				DefValue: false,
+			},
+			"billingDeferredUploadTTL": {
+				Key:      "billing.deferred_upload_ttl",
+				DefValue: time.Hour,
+			},
+			"billingListingEgress": {
+				Key:      "billing.listing_egress",
+				DefValue: "wire",
+			},
+			"billingListenMetering": {
+				Key:      "billing.listen_metering",
+				DefValue: "message",
+			},
+			"billingListenReadInterval": {
+				Key:      "billing.listen_read_interval",
+				DefValue: time.Minute,
+			},
+			"billingListenRevalidateInterval": {
+				Key:      "billing.listen_revalidate_interval",
+				DefValue: time.Duration(0),
+			},
+			"billingAccountMaxAge": {
+				Key:      "billing.account_max_age",
+				DefValue: time.Duration(0),
+			},
+			"billingStorageDeltaCheck": {
+				Key:      "billing.storage_delta_check",
+				DefValue: "off",
+			},
+			"billingStorageOwnerCheck": {
+				Key:      "billing.storage_owner_check",
+				DefValue: "off",
+			},
+			"billingStorageRounding": {
+				Key:      "billing.storage_rounding",
+				DefValue: "off",
+			},
+			"billingStorageRoundingUnit": {
+				Key:      "billing.storage_rounding_unit",
+				DefValue: int64(0),
+			},
+			"billingEgressRoundingUnit": {
+				Key:      "billing.egress_rounding_unit",
+				DefValue: int64(0),
+			},
+			"billingEgressReportThreshold": {
+				Key:      "billing.egress_report_threshold",
+				DefValue: int64(0),
+			},
+			"billingUsageReportTTL": {
+				Key:      "billing.usage_report_ttl",
+				DefValue: time.Minute,
+			},
+			"billingQuotaPriority": {
+				Key:      "billing.quota_priority",
+				DefValue: []string{},
+			},
+			"billingZeroFreeUnlimited": {
+				Key:      "billing.zero_free_unlimited",
+				DefValue: []string{},
+			},
+			"billingDevIgnoredMethods": {
+				Key:      "billing.dev_ignored_methods",
+				DefValue: []string{},
+			},
+			"billingOrgIgnoredMethods": {
+				Key:      "billing.org_ignored_methods",
+				DefValue: []string{},
+			},
+			"billingUserIgnoredMethods": {
+				Key:      "billing.user_ignored_methods",
+				DefValue: []string{},
+			},
+			"billingUsageIgnoredMethods": {
+				Key:      "billing.usage_ignored_methods",
+				DefValue: []string{},
+			},
+			"billingUsagePolicy": {
+				Key:      "billing.usage_policy",
+				DefValue: "",
+			},
+			"billingCoalesceGetCustomer": {
+				Key:      "billing.coalesce_get_customer",
+				DefValue: false,
+			},
+			"billingUsageRegion": {
+				Key:      "billing.usage_region",
+				DefValue: "",
+			},
+			"billingUsageEnvironment": {
+				Key:      "billing.usage_environment",
+				DefValue: "",
+			},
+			"billingUsageVerifySampleRate": {
+				Key:      "billing.usage_verify_sample_rate",
+				DefValue: float64(0),
+			},
+			"billingOwnerMetadataKeys": {
+				Key:      "billing.owner_metadata_keys",
+				DefValue: []string{},
+			},
+			"billingOwnerMetadataMaxSize": {
+				Key:      "billing.owner_metadata_max_size",
+				DefValue: 1024,
+			},
+			"billingPriceStoredData": {
+				Key:      "billing.price_stored_data",
+				DefValue: float64(0),
+			},
+			"billingPriceNetworkEgress": {
+				Key:      "billing.price_network_egress",
+				DefValue: float64(0),
+			},
+			"billingPriceInstanceReads": {
+				Key:      "billing.price_instance_reads",
+				DefValue: float64(0),
+			},
+			"billingPriceInstanceWrites": {
+				Key:      "billing.price_instance_writes",
+				DefValue: float64(0),
+			},
+			"billingQuotaExhaustedCode": {
+				Key:      "billing.quota_exhausted_code",
+				DefValue: "RESOURCE_EXHAUSTED",
+			},
+			"billingCustomerCreationWait": {
+				Key:      "billing.customer_creation_wait",
+				DefValue: time.Duration(0),
+			},
+			"billingCustomerCreationRate": {
+				Key:      "billing.customer_creation_rate",
+				DefValue: 0,
+			},
+			"billingPlaceholderEmail": {
+				Key:      "billing.placeholder_email",
+				DefValue: "",
+			},
+			"billingSupportSessionKey": {
+				Key:      "billing.support_session_key",
+				DefValue: "",
+			},
+			"billingSupportSessionMaxLifetime": {
+				Key:      "billing.support_session_max_lifetime",
+				DefValue: time.Hour * 24,
+			},
+			"billingVerifyMetering": {
+				Key:      "billing.verify_metering",
+				DefValue: "",
+			},
+			"billingFreeWriteTransactionReads": {
+				Key:      "billing.free_write_transaction_reads",
+				DefValue: false,
+			},
+			"billingHasMetering": {
+				Key:      "billing.has_metering",
+				DefValue: "",
+			},
+			"billingDenialLogLevel": {
+				Key:      "billing.denial_log_level",
+				DefValue: "",
+			},
+			"billingOwnerLabelSalt": {
+				Key:      "billing.owner_label_salt",
+				DefValue: "",
+			},
+			"billingDecisionExport": {
+				Key:      "billing.decision_export",
+				DefValue: "",
+			},
+			"billingDecisionExportBatchSize": {
+				Key:      "billing.decision_export_batch_size",
+				DefValue: 100,
+			},
+			"billingDecisionExportInterval": {
+				Key:      "billing.decision_export_interval",
+				DefValue: time.Second * 10,
+			},
+			"billingDecisionExportBuffer": {
+				Key:      "billing.decision_export_buffer",
+				DefValue: 10000,
+			},
+			"billingTrialBillable": {
+				Key:      "billing.trial_billable",
+				DefValue: false,
+			},
+			"billingNewAccountGracePeriod": {
+				Key:      "billing.new_account_grace_period",
+				DefValue: time.Duration(0),
+			},
 		},
 		EnvPre: "HUB",
 		Global: true,
@@ -187,6 +604,18 @@ func init() {
 		"logFile",
 		config.Flags["logFile"].DefValue.(string),
 		"Write logs to file")
+	rootCmd.PersistentFlags().String(
+		"methodValidation",
+		config.Flags["methodValidation"].DefValue.(string),
+		"How to handle hardcoded method names that aren't registered with the server (off, log, or fail)")
+	rootCmd.PersistentFlags().Int(
+		"maxConcurrentStreams",
+		config.Flags["maxConcurrentStreams"].DefValue.(int),
+		"Max number of streaming requests handled at once across all owners (zero disables)")
+	rootCmd.PersistentFlags().Bool(
+		"failClosedUnknownMethods",
+		config.Flags["failClosedUnknownMethods"].DefValue.(bool),
+		"Deny requests to methods that are neither ignored nor metered")
 
 	// Addresses
 	rootCmd.PersistentFlags().String(
@@ -243,6 +672,34 @@ func init() {
 		"bucketsArchiveMaxRepFactor",
 		config.Flags["bucketsArchiveMaxRepFactor"].DefValue.(int),
 		"Bucket archive max replication factor")
+	rootCmd.PersistentFlags().String(
+		"bucketsDefaultName",
+		config.Flags["bucketsDefaultName"].DefValue.(string),
+		"Name of a bucket created for each new user (empty disables default buckets)")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsDefaultStrict",
+		config.Flags["bucketsDefaultStrict"].DefValue.(bool),
+		"Fail a new user's first request if their default bucket can't be created")
+	rootCmd.PersistentFlags().StringSlice(
+		"bucketsStorageExcluded",
+		config.Flags["bucketsStorageExcluded"].DefValue.([]string),
+		"Keys of buckets whose storage is not limited or billed to the owner")
+	rootCmd.PersistentFlags().Int(
+		"bucketsMaxNumberPerOwner",
+		config.Flags["bucketsMaxNumberPerOwner"].DefValue.(int),
+		"Max number buckets per non-billable owner (0 disables the limit)")
+	rootCmd.PersistentFlags().Int(
+		"bucketsMaxNumberPerBillableOwner",
+		config.Flags["bucketsMaxNumberPerBillableOwner"].DefValue.(int),
+		"Max number buckets per billable owner (0 disables the limit)")
+	rootCmd.PersistentFlags().String(
+		"bucketsLimitEnforcement",
+		config.Flags["bucketsLimitEnforcement"].DefValue.(string),
+		"How bucket limits are enforced for concurrent creates (reserve or count)")
+	rootCmd.PersistentFlags().Int64(
+		"bucketsMaxObjectsPerOwner",
+		config.Flags["bucketsMaxObjectsPerOwner"].DefValue.(int64),
+		"Max number of objects per non-billable owner (0 disables the limit)")
 
 	// Threads
 	rootCmd.PersistentFlags().Int(
@@ -251,11 +708,25 @@ func init() {
 		"Max number threads per owner")
 
 	// Powergate
+	rootCmd.PersistentFlags().Bool(
+		"powergateDeferUserCreation",
+		config.Flags["powergateDeferUserCreation"].DefValue.(bool),
+		"Create new users without a Powergate user if Powergate fails, and retry it in the background")
+	rootCmd.PersistentFlags().Duration(
+		"powergateUserRetryInterval",
+		config.Flags["powergateUserRetryInterval"].DefValue.(time.Duration),
+		"Interval for retrying Powergate users whose creation was deferred")
 	rootCmd.PersistentFlags().String(
 		"powergateAdminToken",
 		config.Flags["powergateAdminToken"].DefValue.(string),
 		"Auth token for Powergate admin APIs")
 
+	// Admin
+	rootCmd.PersistentFlags().String(
+		"adminToken",
+		config.Flags["adminToken"].DefValue.(string),
+		"Auth token for hub admin APIs (defaults to the Powergate admin token)")
+
 	// Archives
 	// @todo: Move these under the powergate namespace
 	rootCmd.PersistentFlags().Duration(
@@ -273,6 +744,24 @@ func init() {
 		config.Flags["gatewaySubdomains"].DefValue.(bool),
 		"Enable gateway namespace redirects to subdomains")
 
+	// Metrics
+	rootCmd.PersistentFlags().Duration(
+		"metricsBusyOwnerSampleInterval",
+		config.Flags["metricsBusyOwnerSampleInterval"].DefValue.(time.Duration),
+		"How frequently to log the owners with the most in-flight requests; zero disables")
+	rootCmd.PersistentFlags().Int(
+		"metricsBusyOwnerSampleSize",
+		config.Flags["metricsBusyOwnerSampleSize"].DefValue.(int),
+		"Number of owners logged by each busy owner sample")
+	rootCmd.PersistentFlags().Duration(
+		"metricsQuotaHeadroomSampleInterval",
+		config.Flags["metricsQuotaHeadroomSampleInterval"].DefValue.(time.Duration),
+		"How frequently to record free allowance headroom across active non-billable owners; zero disables")
+	rootCmd.PersistentFlags().Int(
+		"metricsQuotaHeadroomSampleSize",
+		config.Flags["metricsQuotaHeadroomSampleSize"].DefValue.(int),
+		"Maximum number of owners fetched by each quota headroom sample")
+
 	// Cloudflare
 	// @todo: Change these to cloudflareDnsDomain, etc.
 	rootCmd.PersistentFlags().String(
@@ -301,12 +790,350 @@ func init() {
 		"customerioInviteTmpl",
 		config.Flags["customerioInviteTmpl"].DefValue.(string),
 		"Template ID for invite emails")
+	rootCmd.PersistentFlags().String(
+		"customerioSoftCapTmpl",
+		config.Flags["customerioSoftCapTmpl"].DefValue.(string),
+		"Template ID for storage soft cap emails")
 	// @todo: Change this to the customerio namespace
 	rootCmd.PersistentFlags().String(
 		"emailSessionSecret",
 		config.Flags["emailSessionSecret"].DefValue.(string),
 		"Session secret to use when testing email APIs")
 
+	// Billing
+	rootCmd.PersistentFlags().Duration(
+		"billingUsageFlushInterval",
+		config.Flags["billingUsageFlushInterval"].DefValue.(time.Duration),
+		"How frequently to send batched usage to the billing API (zero sends usage as requests complete)")
+	rootCmd.PersistentFlags().Int(
+		"billingUsageFlushConcurrency",
+		config.Flags["billingUsageFlushConcurrency"].DefValue.(int),
+		"Max number of owners whose batched usage is sent to the billing API in parallel")
+	rootCmd.PersistentFlags().Int(
+		"billingUsageBacklogMax",
+		config.Flags["billingUsageBacklogMax"].DefValue.(int),
+		"Max number of batched usage deltas held in memory (zero disables the cap)")
+	rootCmd.PersistentFlags().String(
+		"billingUsageBacklogOverflow",
+		config.Flags["billingUsageBacklogOverflow"].DefValue.(string),
+		"What to do when the usage backlog cap is exceeded (flush, dead_letter, or reject)")
+	rootCmd.PersistentFlags().Duration(
+		"billingUsageReplayInterval",
+		config.Flags["billingUsageReplayInterval"].DefValue.(time.Duration),
+		"Interval for replaying usage that failed to send to the billing API (zero disables dead-lettering)")
+	rootCmd.PersistentFlags().Duration(
+		"billingUsageDeadLetterMaxAge",
+		config.Flags["billingUsageDeadLetterMaxAge"].DefValue.(time.Duration),
+		"Age after which dead-lettered usage is dropped")
+	rootCmd.PersistentFlags().Bool(
+		"billingChaos",
+		config.Flags["billingChaos"].DefValue.(bool),
+		"Allow admins to inject latency and errors into billing calls (never enable in production)")
+	rootCmd.PersistentFlags().Duration(
+		"billingClockSkewInterval",
+		config.Flags["billingClockSkewInterval"].DefValue.(time.Duration),
+		"Interval for correcting usage windows to the billing API's clock (zero disables)")
+	rootCmd.PersistentFlags().Duration(
+		"billingClockSkewLogThreshold",
+		config.Flags["billingClockSkewLogThreshold"].DefValue.(time.Duration),
+		"Skew from the billing API's clock that is logged")
+	rootCmd.PersistentFlags().Duration(
+		"billingDecisionCacheTTL",
+		config.Flags["billingDecisionCacheTTL"].DefValue.(time.Duration),
+		"How long usage check outcomes are reused for identical requests from an owner (zero disables)")
+	rootCmd.PersistentFlags().Duration(
+		"billingRequestDedupTTL",
+		config.Flags["billingRequestDedupTTL"].DefValue.(time.Duration),
+		"How long mutation results are replayed for retries with the same idempotency key (zero disables)")
+	rootCmd.PersistentFlags().Int(
+		"billingGlobalRequestLimit",
+		config.Flags["billingGlobalRequestLimit"].DefValue.(int),
+		"Max requests per second across all owners; low-priority requests are shed first (zero disables)")
+	rootCmd.PersistentFlags().Float64(
+		"billingLowPriorityShare",
+		config.Flags["billingLowPriorityShare"].DefValue.(float64),
+		"Fraction of the global request limit that low-priority requests can use")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingHighPriorityOwners",
+		config.Flags["billingHighPriorityOwners"].DefValue.([]string),
+		"Keys of non-billable owners whose requests are high priority")
+	rootCmd.PersistentFlags().Int64(
+		"billingStorageSoftCap",
+		config.Flags["billingStorageSoftCap"].DefValue.(int64),
+		"Stored data in bytes at which non-billable owners are emailed a prompt to upgrade (zero disables)")
+	rootCmd.PersistentFlags().Duration(
+		"billingStorageSoftCapWindow",
+		config.Flags["billingStorageSoftCapWindow"].DefValue.(time.Duration),
+		"Time after which an owner can be emailed about the storage soft cap again")
+	rootCmd.PersistentFlags().Bool(
+		"billingAnonymousEgress",
+		config.Flags["billingAnonymousEgress"].DefValue.(bool),
+		"Bill network egress of PullPath requests without an account to the bucket owner")
+	rootCmd.PersistentFlags().Float64(
+		"billingCachedEgressMultiplier",
+		config.Flags["billingCachedEgressMultiplier"].DefValue.(float64),
+		"Multiplier applied to network egress of reads served by an upstream cache or CDN")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressWarnThreshold",
+		config.Flags["billingEgressWarnThreshold"].DefValue.(int64),
+		"Network egress in bytes at which non-billable owners are warned (zero disables the warning)")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressBlockThreshold",
+		config.Flags["billingEgressBlockThreshold"].DefValue.(int64),
+		"Network egress in bytes at which non-billable owners are blocked (zero disables the block)")
+	rootCmd.PersistentFlags().Bool(
+		"billingEgressPrecount",
+		config.Flags["billingEgressPrecount"].DefValue.(bool),
+		"Bill the expected network egress of reads when they start and reconcile it with the bytes sent")
+	rootCmd.PersistentFlags().Bool(
+		"billingEgressWarnThenBlock",
+		config.Flags["billingEgressWarnThenBlock"].DefValue.(bool),
+		"Allow one warned request per usage period after a non-billable owner exhausts their network egress")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressWarningGrace",
+		config.Flags["billingEgressWarningGrace"].DefValue.(int64),
+		"Network egress in bytes allowed for streams of the warned request")
+	rootCmd.PersistentFlags().Duration(
+		"billingSubscriptionGracePeriod",
+		config.Flags["billingSubscriptionGracePeriod"].DefValue.(time.Duration),
+		"Time reads are allowed after an owner's subscription lapses (writes are denied immediately)")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressThrottleThreshold",
+		config.Flags["billingEgressThrottleThreshold"].DefValue.(int64),
+		"Remaining network egress in bytes below which streams are throttled (zero disables)")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressThrottleRate",
+		config.Flags["billingEgressThrottleRate"].DefValue.(int64),
+		"Send rate in bytes per second of throttled streams at the throttle threshold")
+	rootCmd.PersistentFlags().Duration(
+		"billingBurstWindow",
+		config.Flags["billingBurstWindow"].DefValue.(time.Duration),
+		"Sliding window used to enforce threaddb read and write burst limits (zero disables burst limits)")
+	rootCmd.PersistentFlags().Int(
+		"billingBurstReadLimit",
+		config.Flags["billingBurstReadLimit"].DefValue.(int),
+		"Max threaddb read requests per owner within the burst window (zero disables the limit)")
+	rootCmd.PersistentFlags().Int(
+		"billingBurstWriteLimit",
+		config.Flags["billingBurstWriteLimit"].DefValue.(int),
+		"Max threaddb write requests per owner within the burst window (zero disables the limit)")
+	rootCmd.PersistentFlags().Duration(
+		"billingEgressBurstWindow",
+		config.Flags["billingEgressBurstWindow"].DefValue.(time.Duration),
+		"Sliding window used to enforce the network egress burst limit (zero disables the limit)")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressBurstLimit",
+		config.Flags["billingEgressBurstLimit"].DefValue.(int64),
+		"Max network egress bytes per owner within the egress burst window, checked when reads start (zero disables the limit)")
+	rootCmd.PersistentFlags().Float64(
+		"billingReadWriteRatio",
+		config.Flags["billingReadWriteRatio"].DefValue.(float64),
+		"Max ratio of threaddb reads to writes per owner within the ratio window (zero disables the limit)")
+	rootCmd.PersistentFlags().Duration(
+		"billingReadWriteRatioWindow",
+		config.Flags["billingReadWriteRatioWindow"].DefValue.(time.Duration),
+		"Fixed window used to enforce the threaddb read:write ratio")
+	rootCmd.PersistentFlags().Int64(
+		"billingReadWriteRatioMinReads",
+		config.Flags["billingReadWriteRatioMinReads"].DefValue.(int64),
+		"Threaddb reads per owner within the ratio window before the read:write ratio is enforced")
+	rootCmd.PersistentFlags().Bool(
+		"billingReadWriteRatioExemptBillable",
+		config.Flags["billingReadWriteRatioExemptBillable"].DefValue.(bool),
+		"Exempt billable owners from the threaddb read:write ratio")
+	rootCmd.PersistentFlags().Bool(
+		"billingDeferredUploads",
+		config.Flags["billingDeferredUploads"].DefValue.(bool),
+		"Report the stored data of chunked PushPath uploads once, when the upload is finalized")
+	rootCmd.PersistentFlags().Duration(
+		"billingDeferredUploadTTL",
+		config.Flags["billingDeferredUploadTTL"].DefValue.(time.Duration),
+		"Time after its last chunk that an upload that wasn't finalized is abandoned")
+	rootCmd.PersistentFlags().String(
+		"billingListingEgress",
+		config.Flags["billingListingEgress"].DefValue.(string),
+		"How the network egress of bucket listings is metered (wire, serialized, or off)")
+	rootCmd.PersistentFlags().String(
+		"billingListenMetering",
+		config.Flags["billingListenMetering"].DefValue.(string),
+		"How threaddb Listen streams consume instance reads (start, message, or duration)")
+	rootCmd.PersistentFlags().Duration(
+		"billingListenReadInterval",
+		config.Flags["billingListenReadInterval"].DefValue.(time.Duration),
+		"Stream duration that consumes one instance read when Listen is metered by duration")
+	rootCmd.PersistentFlags().Duration(
+		"billingListenRevalidateInterval",
+		config.Flags["billingListenRevalidateInterval"].DefValue.(time.Duration),
+		"How frequently to recheck the owner of an open Listen stream (zero disables)")
+	rootCmd.PersistentFlags().Duration(
+		"billingAccountMaxAge",
+		config.Flags["billingAccountMaxAge"].DefValue.(time.Duration),
+		"How long a stream uses its account before it's re-resolved and rechecked (zero disables)")
+	rootCmd.PersistentFlags().String(
+		"billingStorageDeltaCheck",
+		config.Flags["billingStorageDeltaCheck"].DefValue.(string),
+		"Strict check of storage delta signs (off, log, or reject)")
+	rootCmd.PersistentFlags().String(
+		"billingStorageOwnerCheck",
+		config.Flags["billingStorageOwnerCheck"].DefValue.(string),
+		"Strict check that storage methods complete with a bucket owner (off, log, or reject)")
+	rootCmd.PersistentFlags().String(
+		"billingStorageRounding",
+		config.Flags["billingStorageRounding"].DefValue.(string),
+		"Rounding of reported storage deltas (off, ceil, floor, or nearest)")
+	rootCmd.PersistentFlags().Int64(
+		"billingStorageRoundingUnit",
+		config.Flags["billingStorageRoundingUnit"].DefValue.(int64),
+		"Unit in bytes that storage deltas are rounded to (defaults to 8 MiB)")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressRoundingUnit",
+		config.Flags["billingEgressRoundingUnit"].DefValue.(int64),
+		"Unit in bytes that network egress deltas are rounded to with the storage rounding (zero reports raw bytes)")
+	rootCmd.PersistentFlags().Int64(
+		"billingEgressReportThreshold",
+		config.Flags["billingEgressReportThreshold"].DefValue.(int64),
+		"Smallest network egress delta in bytes that's reported, smaller deltas are accumulated (zero disables)")
+	rootCmd.PersistentFlags().Duration(
+		"billingUsageReportTTL",
+		config.Flags["billingUsageReportTTL"].DefValue.(time.Duration),
+		"Max time a sub-threshold usage delta is held before it's reported")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingQuotaPriority",
+		config.Flags["billingQuotaPriority"].DefValue.([]string),
+		"Usage keys in the order their exhaustion is reported when a request exceeds multiple quotas")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingZeroFreeUnlimited",
+		config.Flags["billingZeroFreeUnlimited"].DefValue.([]string),
+		"Usage keys for which a zero free allowance means unlimited instead of exhausted")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingDevIgnoredMethods",
+		config.Flags["billingDevIgnoredMethods"].DefValue.([]string),
+		"Full method names that are not metered for developer accounts")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingOrgIgnoredMethods",
+		config.Flags["billingOrgIgnoredMethods"].DefValue.([]string),
+		"Full method names that are not metered for organizations")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingUserIgnoredMethods",
+		config.Flags["billingUserIgnoredMethods"].DefValue.([]string),
+		"Full method names that are not metered for users")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingUsageIgnoredMethods",
+		config.Flags["billingUsageIgnoredMethods"].DefValue.([]string),
+		"Full method names that are authenticated but not metered for any owner")
+	rootCmd.PersistentFlags().String(
+		"billingUsagePolicy",
+		config.Flags["billingUsagePolicy"].DefValue.(string),
+		"Path to a JSON usage policy that replaces the default method metering policy")
+	rootCmd.PersistentFlags().Bool(
+		"billingCoalesceGetCustomer",
+		config.Flags["billingCoalesceGetCustomer"].DefValue.(bool),
+		"Share a single billing call among concurrent requests for the same customer")
+	rootCmd.PersistentFlags().String(
+		"billingUsageRegion",
+		config.Flags["billingUsageRegion"].DefValue.(string),
+		"Region label attached to all usage reported by this instance")
+	rootCmd.PersistentFlags().String(
+		"billingUsageEnvironment",
+		config.Flags["billingUsageEnvironment"].DefValue.(string),
+		"Environment label attached to all usage reported by this instance")
+	rootCmd.PersistentFlags().Float64(
+		"billingUsageVerifySampleRate",
+		config.Flags["billingUsageVerifySampleRate"].DefValue.(float64),
+		"Fraction of usage reports verified against the owner's totals in billingd (zero disables)")
+	rootCmd.PersistentFlags().StringSlice(
+		"billingOwnerMetadataKeys",
+		config.Flags["billingOwnerMetadataKeys"].DefValue.([]string),
+		"Keys of account metadata attached to usage reported for the owner, e.g., cost_center")
+	rootCmd.PersistentFlags().Int(
+		"billingOwnerMetadataMaxSize",
+		config.Flags["billingOwnerMetadataMaxSize"].DefValue.(int),
+		"Max total size in bytes of account metadata attached to usage")
+	rootCmd.PersistentFlags().Float64(
+		"billingPriceStoredData",
+		config.Flags["billingPriceStoredData"].DefValue.(float64),
+		"Price in USD per GiB of stored data used to estimate request costs")
+	rootCmd.PersistentFlags().Float64(
+		"billingPriceNetworkEgress",
+		config.Flags["billingPriceNetworkEgress"].DefValue.(float64),
+		"Price in USD per GiB of network egress used to estimate request costs")
+	rootCmd.PersistentFlags().Float64(
+		"billingPriceInstanceReads",
+		config.Flags["billingPriceInstanceReads"].DefValue.(float64),
+		"Price in USD per 10,000 threaddb instance reads used to estimate request costs")
+	rootCmd.PersistentFlags().Float64(
+		"billingPriceInstanceWrites",
+		config.Flags["billingPriceInstanceWrites"].DefValue.(float64),
+		"Price in USD per 10,000 threaddb instance writes used to estimate request costs")
+	rootCmd.PersistentFlags().String(
+		"billingQuotaExhaustedCode",
+		config.Flags["billingQuotaExhaustedCode"].DefValue.(string),
+		"gRPC code returned when a usage quota is exhausted, e.g., FAILED_PRECONDITION")
+	rootCmd.PersistentFlags().Duration(
+		"billingCustomerCreationWait",
+		config.Flags["billingCustomerCreationWait"].DefValue.(time.Duration),
+		"How long requests wait for a customer being created by a concurrent request (zero disables)")
+	rootCmd.PersistentFlags().Int(
+		"billingCustomerCreationRate",
+		config.Flags["billingCustomerCreationRate"].DefValue.(int),
+		"Max number of customers created per minute, after which new owners are told to retry later (zero disables)")
+	rootCmd.PersistentFlags().String(
+		"billingPlaceholderEmail",
+		config.Flags["billingPlaceholderEmail"].DefValue.(string),
+		"Email used to create billing customers whose email is rejected by the billing provider")
+	rootCmd.PersistentFlags().String(
+		"billingSupportSessionKey",
+		config.Flags["billingSupportSessionKey"].DefValue.(string),
+		"Multibase-encoded key used to validate support-session tokens (empty disables)")
+	rootCmd.PersistentFlags().Duration(
+		"billingSupportSessionMaxLifetime",
+		config.Flags["billingSupportSessionMaxLifetime"].DefValue.(time.Duration),
+		"Max time before a support-session token expires")
+	rootCmd.PersistentFlags().String(
+		"billingVerifyMetering",
+		config.Flags["billingVerifyMetering"].DefValue.(string),
+		"How threaddb Verify is metered: free, instance_reads (default), or another usage key")
+	rootCmd.PersistentFlags().String(
+		"billingHasMetering",
+		config.Flags["billingHasMetering"].DefValue.(string),
+		"How threaddb Has is metered: free, instance_reads (default), or another usage key, e.g., instance_cheap_reads")
+	rootCmd.PersistentFlags().Bool(
+		"billingFreeWriteTransactionReads",
+		config.Flags["billingFreeWriteTransactionReads"].DefValue.(bool),
+		"Bill threaddb WriteTransactions solely as writes, without metering the reads made within them")
+	rootCmd.PersistentFlags().String(
+		"billingDenialLogLevel",
+		config.Flags["billingDenialLogLevel"].DefValue.(string),
+		"Log level for requests denied by usage checks (debug, info, warn, or error; empty disables logging)")
+	rootCmd.PersistentFlags().String(
+		"billingOwnerLabelSalt",
+		config.Flags["billingOwnerLabelSalt"].DefValue.(string),
+		"Salt used to hash owner keys in metrics, traces, and logs (empty disables hashing)")
+	rootCmd.PersistentFlags().String(
+		"billingDecisionExport",
+		config.Flags["billingDecisionExport"].DefValue.(string),
+		"file:// or http(s):// URL that usage decisions are exported to for offline analysis (empty disables)")
+	rootCmd.PersistentFlags().Int(
+		"billingDecisionExportBatchSize",
+		config.Flags["billingDecisionExportBatchSize"].DefValue.(int),
+		"Number of usage decisions exported at once")
+	rootCmd.PersistentFlags().Duration(
+		"billingDecisionExportInterval",
+		config.Flags["billingDecisionExportInterval"].DefValue.(time.Duration),
+		"Max time a usage decision is buffered before it's exported")
+	rootCmd.PersistentFlags().Int(
+		"billingDecisionExportBuffer",
+		config.Flags["billingDecisionExportBuffer"].DefValue.(int),
+		"Max number of usage decisions buffered in memory before new decisions are dropped")
+	rootCmd.PersistentFlags().Bool(
+		"billingTrialBillable",
+		config.Flags["billingTrialBillable"].DefValue.(bool),
+		"Allow trialing customers flagged billable to use billable quotas instead of free-tier quotas")
+	rootCmd.PersistentFlags().Duration(
+		"billingNewAccountGracePeriod",
+		config.Flags["billingNewAccountGracePeriod"].DefValue.(time.Duration),
+		"Age before which new accounts are exempt from usage quotas (zero disables the exemption)")
+
 	err := cmd.BindFlags(config.Viper, rootCmd, config.Flags)
 	cmd.ErrCheck(err)
 }
@@ -336,6 +1163,9 @@ var rootCmd = &cobra.Command{
 		log.Debugf("loaded config: %s", string(settings))
 
 		debug := config.Viper.GetBool("log.debug")
+		methodValidation := config.Viper.GetString("method_validation")
+		maxConcurrentStreams := config.Viper.GetInt("max_concurrent_streams")
+		failClosedUnknownMethods := config.Viper.GetBool("fail_closed_unknown_methods")
 		logFile := config.Viper.GetString("log.file")
 		if logFile != "" {
 			err = cmd.SetupDefaultLoggingConfig(logFile)
@@ -358,12 +1188,24 @@ var rootCmd = &cobra.Command{
 
 		// Buckets
 		bucketsArchiveMaxRepFactor := config.Viper.GetInt("buckets.archive_max_rep_factor")
+		bucketsDefaultName := config.Viper.GetString("buckets.default_name")
+		bucketsDefaultStrict := config.Viper.GetBool("buckets.default_strict")
+		bucketsStorageExcluded := config.Viper.GetStringSlice("buckets.storage_excluded")
+		bucketsMaxNumberPerOwner := config.Viper.GetInt("buckets.max_number_per_owner")
+		bucketsMaxNumberPerBillableOwner := config.Viper.GetInt("buckets.max_number_per_billable_owner")
+		bucketsLimitEnforcement := config.Viper.GetString("buckets.limit_enforcement")
+		bucketsMaxObjectsPerOwner := config.Viper.GetInt64("buckets.max_objects_per_owner")
 
 		// Threads
 		threadsMaxNumberPerOwner := config.Viper.GetInt("threads.max_number_per_owner")
 
 		// Powergate
 		powergateAdminToken := config.Viper.GetString("powergate.admin_token")
+		powergateDeferUserCreation := config.Viper.GetBool("powergate.defer_user_creation")
+		powergateUserRetryInterval := config.Viper.GetDuration("powergate.user_retry_interval")
+
+		// Admin
+		adminToken := config.Viper.GetString("admin.token")
 
 		// Archives
 		archivesJobPollIntervalSlow := config.Viper.GetDuration("archives.job_poll_interval_slow")
@@ -372,6 +1214,12 @@ var rootCmd = &cobra.Command{
 		// Gateway
 		gatewaySubdomains := config.Viper.GetBool("gateway.subdomains")
 
+		// Metrics
+		metricsBusyOwnerSampleInterval := config.Viper.GetDuration("metrics.busy_owner_sample_interval")
+		metricsBusyOwnerSampleSize := config.Viper.GetInt("metrics.busy_owner_sample_size")
+		metricsQuotaHeadroomSampleInterval := config.Viper.GetDuration("metrics.quota_headroom_sample_interval")
+		metricsQuotaHeadroomSampleSize := config.Viper.GetInt("metrics.quota_headroom_sample_size")
+
 		// Cloudflare
 		dnsDomain := config.Viper.GetString("dns.domain")
 		dnsZoneID := config.Viper.GetString("dns.zone_id")
@@ -381,8 +1229,154 @@ var rootCmd = &cobra.Command{
 		customerioApiKey := config.Viper.GetString("customerio.api_key")
 		customerioConfirmTmpl := config.Viper.GetString("customerio.confirm_template")
 		customerioInviteTmpl := config.Viper.GetString("customerio.invite_template")
+		customerioSoftCapTmpl := config.Viper.GetString("customerio.soft_cap_template")
 		emailSessionSecret := config.Viper.GetString("email.session_secret")
 
+		// Billing
+		billingUsageFlushInterval := config.Viper.GetDuration("billing.usage_flush_interval")
+		billingUsageFlushConcurrency := config.Viper.GetInt("billing.usage_flush_concurrency")
+		billingUsageBacklogMax := config.Viper.GetInt("billing.usage_backlog_max")
+		billingUsageBacklogOverflow := config.Viper.GetString("billing.usage_backlog_overflow")
+		billingUsageReplayInterval := config.Viper.GetDuration("billing.usage_replay_interval")
+		billingUsageDeadLetterMaxAge := config.Viper.GetDuration("billing.usage_dead_letter_max_age")
+		billingChaos := config.Viper.GetBool("billing.chaos")
+		billingClockSkewInterval := config.Viper.GetDuration("billing.clock_skew_interval")
+		billingClockSkewLogThreshold := config.Viper.GetDuration("billing.clock_skew_log_threshold")
+		billingDecisionCacheTTL := config.Viper.GetDuration("billing.decision_cache_ttl")
+		billingRequestDedupTTL := config.Viper.GetDuration("billing.request_dedup_ttl")
+		billingGlobalRequestLimit := config.Viper.GetInt("billing.global_request_limit")
+		billingLowPriorityShare := config.Viper.GetFloat64("billing.low_priority_share")
+		billingHighPriorityOwners := config.Viper.GetStringSlice("billing.high_priority_owners")
+		billingStorageSoftCap := config.Viper.GetInt64("billing.storage_soft_cap")
+		billingStorageSoftCapWindow := config.Viper.GetDuration("billing.storage_soft_cap_window")
+		billingAnonymousEgress := config.Viper.GetBool("billing.anonymous_egress")
+		billingCachedEgressMultiplier := config.Viper.GetFloat64("billing.cached_egress_multiplier")
+		billingEgressWarnThreshold := config.Viper.GetInt64("billing.egress_warn_threshold")
+		billingEgressBlockThreshold := config.Viper.GetInt64("billing.egress_block_threshold")
+		billingEgressPrecount := config.Viper.GetBool("billing.egress_precount")
+		billingEgressWarnThenBlock := config.Viper.GetBool("billing.egress_warn_then_block")
+		billingEgressWarningGrace := config.Viper.GetInt64("billing.egress_warning_grace")
+		billingSubscriptionGracePeriod := config.Viper.GetDuration("billing.subscription_grace_period")
+		billingEgressThrottleThreshold := config.Viper.GetInt64("billing.egress_throttle_threshold")
+		billingEgressThrottleRate := config.Viper.GetInt64("billing.egress_throttle_rate")
+		billingBurstWindow := config.Viper.GetDuration("billing.burst_window")
+		billingBurstReadLimit := config.Viper.GetInt("billing.burst_read_limit")
+		billingBurstWriteLimit := config.Viper.GetInt("billing.burst_write_limit")
+		billingEgressBurstWindow := config.Viper.GetDuration("billing.egress_burst_window")
+		billingEgressBurstLimit := config.Viper.GetInt64("billing.egress_burst_limit")
+		billingReadWriteRatio := config.Viper.GetFloat64("billing.read_write_ratio")
+		billingReadWriteRatioWindow := config.Viper.GetDuration("billing.read_write_ratio_window")
+		billingReadWriteRatioMinReads := config.Viper.GetInt64("billing.read_write_ratio_min_reads")
+		billingReadWriteRatioExemptBillable := config.Viper.GetBool("billing.read_write_ratio_exempt_billable")
+		billingDeferredUploads := config.Viper.GetBool("billing.deferred_uploads")
+		billingDeferredUploadTTL := config.Viper.GetDuration("billing.deferred_upload_ttl")
+		billingListingEgress := config.Viper.GetString("billing.listing_egress")
+		billingListenMetering := config.Viper.GetString("billing.listen_metering")
+		billingListenReadInterval := config.Viper.GetDuration("billing.listen_read_interval")
+		billingListenRevalidateInterval := config.Viper.GetDuration("billing.listen_revalidate_interval")
+		billingAccountMaxAge := config.Viper.GetDuration("billing.account_max_age")
+		billingStorageDeltaCheck := config.Viper.GetString("billing.storage_delta_check")
+		billingStorageOwnerCheck := config.Viper.GetString("billing.storage_owner_check")
+		billingStorageRounding := config.Viper.GetString("billing.storage_rounding")
+		billingStorageRoundingUnit := config.Viper.GetInt64("billing.storage_rounding_unit")
+		billingEgressRoundingUnit := config.Viper.GetInt64("billing.egress_rounding_unit")
+		billingEgressReportThreshold := config.Viper.GetInt64("billing.egress_report_threshold")
+		billingUsageReportTTL := config.Viper.GetDuration("billing.usage_report_ttl")
+		billingQuotaPriority := config.Viper.GetStringSlice("billing.quota_priority")
+		billingZeroFreeUnlimited := config.Viper.GetStringSlice("billing.zero_free_unlimited")
+		ownerIgnoredMethods := map[mdb.AccountType][]string{
+			mdb.Dev:  config.Viper.GetStringSlice("billing.dev_ignored_methods"),
+			mdb.Org:  config.Viper.GetStringSlice("billing.org_ignored_methods"),
+			mdb.User: config.Viper.GetStringSlice("billing.user_ignored_methods"),
+		}
+		billingUsageIgnoredMethods := config.Viper.GetStringSlice("billing.usage_ignored_methods")
+		billingUsagePolicy := config.Viper.GetString("billing.usage_policy")
+		billingCoalesceGetCustomer := config.Viper.GetBool("billing.coalesce_get_customer")
+		billingUsageRegion := config.Viper.GetString("billing.usage_region")
+		billingUsageEnvironment := config.Viper.GetString("billing.usage_environment")
+		billingUsageVerifySampleRate := config.Viper.GetFloat64("billing.usage_verify_sample_rate")
+		billingOwnerMetadataKeys := config.Viper.GetStringSlice("billing.owner_metadata_keys")
+		billingOwnerMetadataMaxSize := config.Viper.GetInt("billing.owner_metadata_max_size")
+		billingPriceStoredData := config.Viper.GetFloat64("billing.price_stored_data")
+		billingPriceNetworkEgress := config.Viper.GetFloat64("billing.price_network_egress")
+		billingPriceInstanceReads := config.Viper.GetFloat64("billing.price_instance_reads")
+		billingPriceInstanceWrites := config.Viper.GetFloat64("billing.price_instance_writes")
+		billingQuotaExhaustedCode := config.Viper.GetString("billing.quota_exhausted_code")
+		billingCustomerCreationWait := config.Viper.GetDuration("billing.customer_creation_wait")
+		billingCustomerCreationRate := config.Viper.GetInt("billing.customer_creation_rate")
+		billingPlaceholderEmail := config.Viper.GetString("billing.placeholder_email")
+		billingSupportSessionKey := config.Viper.GetString("billing.support_session_key")
+		billingSupportSessionMaxLifetime := config.Viper.GetDuration("billing.support_session_max_lifetime")
+		billingVerifyMetering := config.Viper.GetString("billing.verify_metering")
+		billingHasMetering := config.Viper.GetString("billing.has_metering")
+		billingFreeWriteTransactionReads := config.Viper.GetBool("billing.free_write_transaction_reads")
+		billingDenialLogLevel := config.Viper.GetString("billing.denial_log_level")
+		billingOwnerLabelSalt := config.Viper.GetString("billing.owner_label_salt")
+		billingDecisionExport := config.Viper.GetString("billing.decision_export")
+		billingDecisionExportBatchSize := config.Viper.GetInt("billing.decision_export_batch_size")
+		billingDecisionExportInterval := config.Viper.GetDuration("billing.decision_export_interval")
+		billingDecisionExportBuffer := config.Viper.GetInt("billing.decision_export_buffer")
+		billingTrialBillable := config.Viper.GetBool("billing.trial_billable")
+		billingNewAccountGracePeriod := config.Viper.GetDuration("billing.new_account_grace_period")
+
+		var egressTiers []core.EgressTier
+		if billingEgressWarnThreshold > 0 {
+			egressTiers = append(egressTiers, core.EgressTier{
+				Threshold: billingEgressWarnThreshold,
+				Action:    core.EgressActionWarn,
+			})
+		}
+		if billingEgressBlockThreshold > 0 {
+			egressTiers = append(egressTiers, core.EgressTier{
+				Threshold: billingEgressBlockThreshold,
+				Action:    core.EgressActionBlock,
+			})
+		}
+
+		var usagePolicy core.UsagePolicy
+		if billingUsagePolicy != "" {
+			usagePolicy, err = core.LoadUsagePolicy(billingUsagePolicy)
+			cmd.ErrCheck(err)
+		}
+
+		usageLabels := make(map[string]string)
+		if billingUsageRegion != "" {
+			usageLabels["region"] = billingUsageRegion
+		}
+		if billingUsageEnvironment != "" {
+			usageLabels["environment"] = billingUsageEnvironment
+		}
+
+		usageRoundingUnits := make(map[string]int64)
+		if billingEgressRoundingUnit > 0 {
+			usageRoundingUnits["network_egress"] = billingEgressRoundingUnit
+		}
+
+		usageReportThresholds := make(map[string]int64)
+		if billingEgressReportThreshold > 0 {
+			usageReportThresholds["network_egress"] = billingEgressReportThreshold
+		}
+
+		usagePrices := make(map[string]core.UsagePrice)
+		if billingPriceStoredData > 0 {
+			usagePrices["stored_data"] = core.UsagePrice{Unit: 1 << 30, Price: billingPriceStoredData}
+		}
+		if billingPriceNetworkEgress > 0 {
+			usagePrices["network_egress"] = core.UsagePrice{Unit: 1 << 30, Price: billingPriceNetworkEgress}
+		}
+		if billingPriceInstanceReads > 0 {
+			usagePrices["instance_reads"] = core.UsagePrice{Unit: 10000, Price: billingPriceInstanceReads}
+		}
+		if billingPriceInstanceWrites > 0 {
+			usagePrices["instance_writes"] = core.UsagePrice{Unit: 10000, Price: billingPriceInstanceWrites}
+		}
+
+		var quotaExhaustedCode codes.Code
+		if billingQuotaExhaustedCode != "" {
+			err := quotaExhaustedCode.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(billingQuotaExhaustedCode))))
+			cmd.ErrCheck(err)
+		}
+
 		var opts []core.Option
 		if addrThreadsMongoUri != "" {
 			if addrThreadsMongoName == "" {
@@ -396,8 +1390,11 @@ var rootCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 		textile, err := core.NewTextile(ctx, core.Config{
-			Hub:   true,
-			Debug: debug,
+			Hub:                      true,
+			Debug:                    debug,
+			MethodValidation:         methodValidation,
+			MaxConcurrentStreams:     maxConcurrentStreams,
+			FailClosedUnknownMethods: failClosedUnknownMethods,
 			// Addresses
 			AddrAPI:          addrApi,
 			AddrAPIProxy:     addrApiProxy,
@@ -410,16 +1407,32 @@ var rootCmd = &cobra.Command{
 			AddrBillingAPI:   addrBillingApi,
 			AddrPowergateAPI: addrPowergateApi,
 			// Buckets
-			MaxBucketArchiveRepFactor: bucketsArchiveMaxRepFactor,
+			MaxBucketArchiveRepFactor:        bucketsArchiveMaxRepFactor,
+			DefaultBucketName:                bucketsDefaultName,
+			DefaultBucketStrict:              bucketsDefaultStrict,
+			StorageExcludedBuckets:           bucketsStorageExcluded,
+			MaxNumberBucketsPerOwner:         bucketsMaxNumberPerOwner,
+			MaxNumberBucketsPerBillableOwner: bucketsMaxNumberPerBillableOwner,
+			BucketLimitEnforcement:           bucketsLimitEnforcement,
+			MaxNumberObjectsPerOwner:         bucketsMaxObjectsPerOwner,
 			// Threads
 			MaxNumberThreadsPerOwner: threadsMaxNumberPerOwner,
 			// Powergate
-			PowergateAdminToken: powergateAdminToken,
+			PowergateAdminToken:        powergateAdminToken,
+			PowergateDeferUserCreation: powergateDeferUserCreation,
+			PowergateUserRetryInterval: powergateUserRetryInterval,
+			// Admin
+			AdminToken: adminToken,
 			// Archives
 			ArchiveJobPollIntervalSlow: archivesJobPollIntervalSlow,
 			ArchiveJobPollIntervalFast: archivesJobPollIntervalFast,
 			// Gateway
 			UseSubdomains: gatewaySubdomains,
+			// Metrics
+			BusyOwnerSampleInterval:     metricsBusyOwnerSampleInterval,
+			BusyOwnerSampleSize:         metricsBusyOwnerSampleSize,
+			QuotaHeadroomSampleInterval: metricsQuotaHeadroomSampleInterval,
+			QuotaHeadroomSampleSize:     metricsQuotaHeadroomSampleSize,
 			// Cloudflare
 			DNSDomain: dnsDomain,
 			DNSZoneID: dnsZoneID,
@@ -427,8 +1440,88 @@ var rootCmd = &cobra.Command{
 			// Customer.io
 			CustomerioConfirmTmpl: customerioConfirmTmpl,
 			CustomerioInviteTmpl:  customerioInviteTmpl,
+			CustomerioSoftCapTmpl: customerioSoftCapTmpl,
 			CustomerioAPIKey:      customerioApiKey,
 			EmailSessionSecret:    emailSessionSecret,
+			// Billing
+			UsageFlushInterval:      billingUsageFlushInterval,
+			UsageFlushConcurrency:   billingUsageFlushConcurrency,
+			UsageBacklogMax:         billingUsageBacklogMax,
+			UsageBacklogOverflow:    billingUsageBacklogOverflow,
+			UsageReplayInterval:     billingUsageReplayInterval,
+			UsageDeadLetterMaxAge:   billingUsageDeadLetterMaxAge,
+			BillingChaos:            billingChaos,
+			ClockSkewInterval:       billingClockSkewInterval,
+			ClockSkewLogThreshold:   billingClockSkewLogThreshold,
+			StorageSoftCap:          billingStorageSoftCap,
+			StorageSoftCapWindow:    billingStorageSoftCapWindow,
+			AnonymousEgress:         billingAnonymousEgress,
+			CachedEgressMultiplier:  billingCachedEgressMultiplier,
+			EgressTiers:             egressTiers,
+			EgressPrecount:          billingEgressPrecount,
+			EgressWarnThenBlock:     billingEgressWarnThenBlock,
+			EgressWarningGrace:      billingEgressWarningGrace,
+			SubscriptionGracePeriod: billingSubscriptionGracePeriod,
+			EgressThrottleThreshold: billingEgressThrottleThreshold,
+			EgressThrottleRate:      billingEgressThrottleRate,
+			UsageBurstWindow:        billingBurstWindow,
+			UsageBurstLimits: map[string]int{
+				"instance_reads":  billingBurstReadLimit,
+				"instance_writes": billingBurstWriteLimit,
+			},
+			EgressBurstWindow:            billingEgressBurstWindow,
+			EgressBurstLimit:             billingEgressBurstLimit,
+			ReadWriteRatio:               billingReadWriteRatio,
+			ReadWriteRatioWindow:         billingReadWriteRatioWindow,
+			ReadWriteRatioMinReads:       billingReadWriteRatioMinReads,
+			ReadWriteRatioExemptBillable: billingReadWriteRatioExemptBillable,
+			DeferredUploads:              billingDeferredUploads,
+			DeferredUploadTTL:            billingDeferredUploadTTL,
+			ListingEgress:                billingListingEgress,
+			ListenMetering:               billingListenMetering,
+			ListenReadInterval:           billingListenReadInterval,
+			ListenRevalidateInterval:     billingListenRevalidateInterval,
+			AccountMaxAge:                billingAccountMaxAge,
+			StorageDeltaCheck:            billingStorageDeltaCheck,
+			StorageOwnerCheck:            billingStorageOwnerCheck,
+			StorageRounding:              billingStorageRounding,
+			StorageRoundingUnit:          billingStorageRoundingUnit,
+			UsageRoundingUnits:           usageRoundingUnits,
+			UsageReportThresholds:        usageReportThresholds,
+			UsageReportTTL:               billingUsageReportTTL,
+			DecisionCacheTTL:             billingDecisionCacheTTL,
+			RequestDedupTTL:              billingRequestDedupTTL,
+			GlobalRequestLimit:           billingGlobalRequestLimit,
+			LowPriorityShare:             billingLowPriorityShare,
+			HighPriorityOwners:           billingHighPriorityOwners,
+			ZeroFreeUnlimited:            billingZeroFreeUnlimited,
+			QuotaPriority:                billingQuotaPriority,
+			OwnerIgnoredMethods:          ownerIgnoredMethods,
+			UsageIgnoredMethods:          billingUsageIgnoredMethods,
+			UsagePolicy:                  usagePolicy,
+			CoalesceGetCustomer:          billingCoalesceGetCustomer,
+			UsageLabels:                  usageLabels,
+			UsageVerifySampleRate:        billingUsageVerifySampleRate,
+			OwnerMetadataKeys:            billingOwnerMetadataKeys,
+			OwnerMetadataMaxSize:         billingOwnerMetadataMaxSize,
+			UsagePrices:                  usagePrices,
+			QuotaExhaustedCode:           quotaExhaustedCode,
+			CustomerCreationWait:         billingCustomerCreationWait,
+			CustomerCreationRate:         billingCustomerCreationRate,
+			PlaceholderEmail:             billingPlaceholderEmail,
+			SupportSessionKey:            billingSupportSessionKey,
+			SupportSessionMaxLifetime:    billingSupportSessionMaxLifetime,
+			VerifyMetering:               billingVerifyMetering,
+			HasMetering:                  billingHasMetering,
+			FreeWriteTransactionReads:    billingFreeWriteTransactionReads,
+			DenialLogLevel:               billingDenialLogLevel,
+			OwnerLabelSalt:               billingOwnerLabelSalt,
+			DecisionExport:               billingDecisionExport,
+			DecisionExportBatchSize:      billingDecisionExportBatchSize,
+			DecisionExportInterval:       billingDecisionExportInterval,
+			DecisionExportBuffer:         billingDecisionExportBuffer,
+			TrialBillable:                billingTrialBillable,
+			NewAccountGracePeriod:        billingNewAccountGracePeriod,
 		}, opts...)
 		cmd.ErrCheck(err)
 		textile.Bootstrap()
@@ -437,6 +1530,11 @@ var rootCmd = &cobra.Command{
 		fmt.Println("Your peer ID is " + textile.HostID().String())
 
 		cmd.HandleInterrupt(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+			defer cancel()
+			if err := textile.Drain(ctx); err != nil {
+				fmt.Println(err.Error())
+			}
 			if err := textile.Close(); err != nil {
 				fmt.Println(err.Error())
 			}
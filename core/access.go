@@ -0,0 +1,37 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	upb "github.com/textileio/textile/v2/api/usersd/pb"
+)
+
+// newDryRunContext returns a context that evaluates access without side effects.
+func newDryRunContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, usageCtxKey("dryRun"), true)
+}
+
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(usageCtxKey("dryRun")).(bool)
+	return dryRun
+}
+
+// CheckAccess returns whether or not a request to method with the credentials in ctx would be allowed.
+// The request is evaluated like it would be by the usage interceptor, but it's not handled, no usage
+// is recorded, and new users and customers are not collected.
+func (t *Textile) CheckAccess(ctx context.Context, method string) (*upb.CheckAccessResponse, error) {
+	_, err := t.evaluateAccess(newDryRunContext(ctx), method)
+	if err == nil {
+		return &upb.CheckAccessResponse{Allowed: true}, nil
+	}
+	var denial *denialError
+	if !errors.As(err, &denial) {
+		return nil, err
+	}
+	return &upb.CheckAccessResponse{
+		Reason:  string(denial.reason),
+		Code:    int32(denial.st.Code()),
+		Message: denial.st.Message(),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckAccess(t *testing.T) {
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{bc: bc, logDenial: logger.log}
+
+	// Allowed
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	res, err := tx.CheckAccess(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Empty(t, res.Reason)
+
+	// Bad subscription
+	suspended := newTestDev(t)
+	cus := newTestCustomer(suspended.Key)
+	cus.SubscriptionStatus = "canceled"
+	bc.setCustomer(cus)
+	res, err = tx.CheckAccess(newTestAccountContext(suspended), pushPathMethod)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, string(denialSuspension), res.Reason)
+	assert.Equal(t, int32(codes.FailedPrecondition), res.Code)
+	assert.NotEmpty(t, res.Message)
+
+	// Quota exhausted
+	exhausted := newTestDev(t)
+	cus = newTestCustomer(exhausted.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+	res, err = tx.CheckAccess(newTestAccountContext(exhausted), findMethod)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, string(denialQuota), res.Reason)
+	assert.Equal(t, int32(codes.ResourceExhausted), res.Code)
+
+	// The quota only applies to reads.
+	res, err = tx.CheckAccess(newTestAccountContext(exhausted), "/threads.pb.API/Save")
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// Dry-run denials are not logged.
+	assert.Empty(t, logger.entries)
+}
+
+func TestCheckAccess_NoSideEffects(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, bursts: newBurstLimiter(time.Minute, map[string]int{"instance_reads": 1})}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	for i := 0; i < 3; i++ {
+		res, err := tx.CheckAccess(newTestAccountContext(dev), findMethod)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+
+	// Checks did not count towards the burst limit.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	res, err := tx.CheckAccess(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, string(denialQuota), res.Reason)
+
+	// Missing customers are not created.
+	newDev := newTestDev(t)
+	res, err = tx.CheckAccess(newTestAccountContext(newDev), pushPathMethod)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	_, err = bc.GetCustomer(newTestAccountContext(newDev), newDev.Key)
+	require.Error(t, err)
+}
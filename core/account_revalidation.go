@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newStreamRequestContext marks a request as a stream, whose account may be revalidated while it's open.
+func newStreamRequestContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, usageCtxKey("streamRequest"), true)
+}
+
+func isStreamRequest(ctx context.Context) bool {
+	stream, _ := ctx.Value(usageCtxKey("streamRequest")).(bool)
+	return stream
+}
+
+// withAccountRevalidation re-resolves the account of a stream every Config.AccountMaxAge while it's open.
+func (t *Textile) withAccountRevalidation(ctx context.Context, method string) context.Context {
+	if t.conf.AccountMaxAge <= 0 || t.users == nil || !isStreamRequest(ctx) || isDryRun(ctx) {
+		return ctx
+	}
+	if t.methodExemption(method) != exemptNone || isSupportSessionRequest(ctx) {
+		return ctx
+	}
+	account, ok := mdb.AccountFromContext(ctx)
+	if !ok || account.Owner() == nil {
+		return ctx
+	}
+	return withStreamRevalidation(ctx, t.conf.AccountMaxAge, func(ctx context.Context) error {
+		return t.revalidateAccount(ctx, method, account)
+	})
+}
+
+// revalidateAccount returns an error if the account of a stream was removed, the user was removed
+// from the org the stream acts for, or the stream would be denied with the current account.
+// Failing to fetch the account doesn't terminate the stream.
+func (t *Textile) revalidateAccount(ctx context.Context, method string, account *mdb.AccountCtx) error {
+	user, err := t.reresolveAccount(ctx, account.User)
+	if err != nil {
+		return t.accountRevalidationError(ctx, method, account, err)
+	}
+	org, err := t.reresolveAccount(ctx, account.Org)
+	if err != nil {
+		return t.accountRevalidationError(ctx, method, account, err)
+	}
+	if user != nil && org != nil && !isOrgMember(org, user) {
+		return t.deny(ctx, method, account, denialPermission,
+			status.Error(codes.PermissionDenied, "User is not an org member"))
+	}
+	ctx = newDryRunContext(mdb.NewAccountContext(ctx, user, org))
+	_, err = t.evaluateAccess(ctx, method)
+	return err
+}
+
+// reresolveAccount fetches the current version of an account. Temporary user accounts,
+// which aren't stored, are returned as is.
+func (t *Textile) reresolveAccount(ctx context.Context, a *mdb.Account) (*mdb.Account, error) {
+	if a == nil || a.CreatedAt.IsZero() {
+		return a, nil
+	}
+	return t.users.Get(ctx, a.Key)
+}
+
+func (t *Textile) accountRevalidationError(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	err error,
+) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return t.deny(ctx, method, account, denialPermission, status.Error(codes.NotFound, "Account not found"))
+	}
+	log.Warnf("revalidating account for %s: %v", t.ownerKey(ctx, account), err)
+	return nil
+}
+
+func isOrgMember(org, user *mdb.Account) bool {
+	for _, m := range org.Members {
+		if m.Key.Equals(user.Key) {
+			return true
+		}
+	}
+	return false
+}
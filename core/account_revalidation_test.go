@@ -0,0 +1,121 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tpb "github.com/textileio/go-threads/api/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamServerInterceptor_AccountRevalidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(users *testUserStore, bc *testBillingClient, dev, org *mdb.Account)
+		code   codes.Code
+	}{
+		{
+			name: "removed",
+			change: func(users *testUserStore, _ *testBillingClient, dev, _ *mdb.Account) {
+				delete(users.users, dev.Key.String())
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "left org",
+			change: func(users *testUserStore, _ *testBillingClient, _, org *mdb.Account) {
+				left := *org
+				left.Members = nil
+				users.users[org.Key.String()] = &left
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "downgraded",
+			change: func(_ *testUserStore, bc *testBillingClient, _, org *mdb.Account) {
+				cus := newTestCustomer(org.Key)
+				cus.DailyUsage["instance_reads"].Free = 0
+				bc.setCustomer(cus)
+			},
+			code: codes.ResourceExhausted,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			users := newTestUserStore()
+			tx := &Textile{bc: bc, users: users, conf: Config{AccountMaxAge: 10 * time.Millisecond}}
+			dev := newTestDev(t)
+			org := newTestDev(t)
+			org.Type = mdb.Org
+			org.Members = []mdb.Member{{Key: dev.Key, Role: mdb.OrgMember}}
+			users.users[dev.Key.String()] = dev
+			users.users[org.Key.String()] = org
+			bc.setCustomer(newTestCustomer(org.Key))
+
+			opened := make(chan struct{})
+			handler := func(_ interface{}, ss grpc.ServerStream) error {
+				require.NoError(t, ss.SendMsg(&tpb.ListenReply{Instance: []byte("{}")}))
+				close(opened)
+				<-ss.Context().Done()
+				return status.Error(codes.Canceled, ss.Context().Err().Error())
+			}
+			done := make(chan error)
+			go func() {
+				ctx := mdb.NewAccountContext(context.Background(), dev, org)
+				stream := &testServerStream{ctx: ctx}
+				info := &grpc.StreamServerInfo{FullMethod: listenMethod, IsServerStream: true}
+				done <- streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+			}()
+
+			// The account changes after the stream is opened.
+			<-opened
+			users.lk.Lock()
+			tc.change(users, bc, dev, org)
+			users.lk.Unlock()
+
+			select {
+			case err := <-done:
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err))
+			case <-time.After(5 * time.Second):
+				t.Fatal("stream was not terminated")
+			}
+		})
+	}
+}
+
+func TestStreamServerInterceptor_AccountRevalidationValid(t *testing.T) {
+	bc := newTestBillingClient()
+	users := newTestUserStore()
+	tx := &Textile{bc: bc, users: users, conf: Config{AccountMaxAge: 10 * time.Millisecond}}
+	dev := newTestDev(t)
+	users.users[dev.Key.String()] = dev
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Valid accounts are re-resolved without terminating the stream.
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		select {
+		case <-ss.Context().Done():
+			return ss.Context().Err()
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	}
+	stream := &testServerStream{ctx: newTestAccountContext(dev)}
+	info := &grpc.StreamServerInfo{FullMethod: listenMethod, IsServerStream: true}
+	err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.NoError(t, err)
+
+	// Unary requests aren't revalidated.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), listenMethod)
+	require.NoError(t, err)
+	_, ok := streamRevalidationFromContext(ctx)
+	assert.False(t, ok)
+}
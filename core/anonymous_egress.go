@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/common"
+)
+
+// threadOwnerFunc resolves the owner of a thread, e.g., the publisher of a bucket.
+type threadOwnerFunc func(ctx context.Context, id thread.ID) (thread.PubKey, error)
+
+// newAnonymousPublisherContext marks a request without an account, whose network egress is
+// billed to the publisher.
+func newAnonymousPublisherContext(ctx context.Context, publisher thread.PubKey) context.Context {
+	return context.WithValue(ctx, usageCtxKey("anonymousPublisher"), publisher)
+}
+
+func anonymousPublisherFromContext(ctx context.Context) (thread.PubKey, bool) {
+	publisher, ok := ctx.Value(usageCtxKey("anonymousPublisher")).(thread.PubKey)
+	return publisher, ok
+}
+
+// anonymousPublisher returns the owner of the thread that a request without an account reads from.
+// There's no publisher if Config.AnonymousEgress isn't set or the thread can't be resolved.
+func (t *Textile) anonymousPublisher(ctx context.Context) (thread.PubKey, bool) {
+	if t.threadOwner == nil {
+		return nil, false
+	}
+	id, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		if id, ok = common.ThreadIDFromMD(ctx); !ok {
+			return nil, false
+		}
+	}
+	publisher, err := t.threadOwner(ctx, id)
+	if err != nil {
+		log.Debugf("resolving publisher of %s: %v", id, err)
+		return nil, false
+	}
+	return publisher, true
+}
+
+// evaluateAnonymousAccess attaches the publisher of an anonymous egress stream, e.g., PullPath
+// from a public bucket, so that post can bill them for it. Anonymous requests aren't denied.
+func (t *Textile) evaluateAnonymousAccess(ctx context.Context, method string) context.Context {
+	if !isEgressStreamMethod(method) || isDryRun(ctx) {
+		return ctx
+	}
+	publisher, ok := t.anonymousPublisher(ctx)
+	if !ok {
+		return ctx
+	}
+	ctx = newRequestIDContext(ctx)
+	return newAnonymousPublisherContext(ctx, publisher)
+}
+
+// postAnonymousUsage bills the network egress of an anonymous egress stream to its publisher.
+func (t *Textile) postAnonymousUsage(ctx context.Context, method string) error {
+	publisher, ok := anonymousPublisherFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	egress, ok := streamEgressFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	sent := t.billableEgress(egress, atomic.LoadInt64(&egress.bytes))
+	if sent <= 0 {
+		return nil
+	}
+	usage := map[string]int64{
+		"network_egress": sent,
+	}
+	// The request context may already be canceled if the client disconnected.
+	rctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+	defer cancel()
+	opts := usageKeyOptions(ctx, method, publisher, "network_egress")
+	return t.recordUsage(rctx, publisher, usage, opts...)
+}
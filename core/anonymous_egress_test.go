@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/api/common"
+	"google.golang.org/grpc"
+)
+
+func TestStreamServerInterceptor_AnonymousEgress(t *testing.T) {
+	bc := newTestBillingClient()
+	publisher := newTestDev(t)
+	bc.setCustomer(newTestCustomer(publisher.Key))
+	bucketThread := thread.NewIDV1(thread.Raw, 32)
+	tx := &Textile{bc: bc, threadOwner: func(_ context.Context, id thread.ID) (thread.PubKey, error) {
+		require.Equal(t, bucketThread, id)
+		return publisher.Key, nil
+	}}
+
+	msg := &bpb.PullPathResponse{Chunk: make([]byte, 1<<16)}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		return ss.SendMsg(msg)
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+
+	// An anonymous read of the publisher's bucket is billed to the publisher.
+	stream := &testServerStream{ctx: common.NewThreadIDContext(context.Background(), bucketThread)}
+	err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.NoError(t, err)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, publisher.Key.String(), incs[0].key)
+	assert.Equal(t, int64(proto.Size(msg)+msgHeaderLen), incs[0].usage["network_egress"])
+	assert.NotEmpty(t, incs[0].idempotencyKey)
+
+	// Anonymous reads aren't metered if the publisher can't be resolved.
+	stream = &testServerStream{ctx: context.Background()}
+	err = streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.NoError(t, err)
+	assert.Len(t, bc.getIncs(), 1)
+}
+
+func TestStreamServerInterceptor_AnonymousEgressDisabled(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		return ss.SendMsg(&bpb.PullPathResponse{Chunk: make([]byte, 1<<16)})
+	}
+	stream := &testServerStream{ctx: common.NewThreadIDContext(context.Background(), thread.NewIDV1(thread.Raw, 32))}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.NoError(t, err)
+	assert.Empty(t, bc.getIncs())
+}
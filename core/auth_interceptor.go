@@ -3,7 +3,6 @@ package core
 import (
 	"context"
 	"errors"
-	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/textileio/go-threads/core/thread"
@@ -70,7 +69,7 @@ func (t *Textile) newAuthCtx(ctx context.Context, method string, touchSession bo
 		if err != nil {
 			return ctx, status.Error(codes.Unauthenticated, "Invalid session")
 		}
-		if time.Now().After(session.ExpiresAt) {
+		if t.now().After(session.ExpiresAt) {
 			return ctx, status.Error(codes.Unauthenticated, "Expired session")
 		}
 		if touchSession {
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/sync/errgroup"
+)
+
+// batchUsageConcurrency is the max number of customers fetched at once by BatchGetUsage.
+const batchUsageConcurrency = 8
+
+// BatchGetUsage returns the daily usage of each owner in keys that has a billing customer,
+// keyed by owner. Owners without a customer are omitted. Customers are fetched from billingd
+// concurrently, and usage that's batched but not yet flushed is included.
+func (t *Textile) BatchGetUsage(ctx context.Context, keys []thread.PubKey) (map[string]map[string]*pb.Usage, error) {
+	if t.bc == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	var lk sync.Mutex
+	res := make(map[string]map[string]*pb.Usage)
+	sem := make(chan struct{}, batchUsageConcurrency)
+	eg, gctx := errgroup.WithContext(ctx)
+	for _, key := range keys {
+		key := key
+		sem <- struct{}{}
+		eg.Go(func() error {
+			defer func() { <-sem }()
+			cus, err := t.bc.GetCustomer(gctx, key)
+			if err != nil {
+				if strings.Contains(err.Error(), mongo.ErrNoDocuments.Error()) {
+					return nil
+				}
+				return fmt.Errorf("getting usage for %s: %v", key, err)
+			}
+			cus = t.applyPendingUsage(key, cus)
+			cus = t.applyResellerMultiplier(cus)
+			lk.Lock()
+			res[key.String()] = cus.DailyUsage
+			lk.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
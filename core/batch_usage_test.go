@@ -0,0 +1,52 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+func TestTextile_BatchGetUsage(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	keys := make([]thread.PubKey, 20)
+	for i := range keys {
+		keys[i] = newTestDev(t).Key
+		if i%2 == 0 {
+			cus := newTestCustomer(keys[i])
+			cus.DailyUsage["instance_reads"].Total = int64(i)
+			bc.setCustomer(cus)
+		}
+	}
+
+	// Owners without a customer are omitted.
+	usage, err := tx.BatchGetUsage(context.Background(), keys)
+	require.NoError(t, err)
+	assert.Len(t, usage, 10)
+	for i, k := range keys {
+		u, ok := usage[k.String()]
+		if i%2 != 0 {
+			assert.False(t, ok)
+			continue
+		}
+		require.True(t, ok)
+		assert.Equal(t, int64(i), u["instance_reads"].Total)
+	}
+}
+
+func TestTextile_BatchGetUsagePending(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, usage: newUsageBatcher(bc, time.Hour, 1)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"instance_reads": 3}))
+
+	// Usage that hasn't been flushed is included.
+	usage, err := tx.BatchGetUsage(context.Background(), []thread.PubKey{dev.Key})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), usage[dev.Key.String()]["instance_reads"].Total)
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errBillingChaosDisabled indicates billing chaos was configured at runtime without Config.BillingChaos.
+var errBillingChaosDisabled = status.Error(codes.FailedPrecondition, "billing chaos is not enabled")
+
+// errChaos is returned by billing calls that fail because of injected chaos.
+var errChaos = status.Error(codes.Unavailable, "billing chaos: injected error")
+
+// chaosBillingClient injects latency and errors into the billing calls made by the usage interceptor,
+// e.g., to test how requests behave when billingd is slow or failing.
+type chaosBillingClient struct {
+	billingClient
+
+	lk        sync.Mutex
+	latency   time.Duration
+	errorRate float64
+	rand      func() float64
+	sleep     func(ctx context.Context, d time.Duration) error
+}
+
+func newChaosBillingClient(bc billingClient) *chaosBillingClient {
+	return &chaosBillingClient{
+		billingClient: bc,
+		rand:          rand.Float64,
+		sleep:         sleepContext,
+	}
+}
+
+// set changes the latency added to each call and the fraction of calls that fail.
+func (c *chaosBillingClient) set(latency time.Duration, errorRate float64) error {
+	if latency < 0 {
+		return errors.New("latency must not be negative")
+	}
+	if errorRate < 0 || errorRate > 1 {
+		return errors.New("error rate must be between 0 and 1")
+	}
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	c.latency = latency
+	c.errorRate = errorRate
+	return nil
+}
+
+// inject waits for the configured latency and returns errChaos for the configured fraction of calls.
+func (c *chaosBillingClient) inject(ctx context.Context) error {
+	c.lk.Lock()
+	latency, errorRate := c.latency, c.errorRate
+	fail := errorRate > 0 && c.rand() < errorRate
+	c.lk.Unlock()
+	if latency > 0 {
+		if err := c.sleep(ctx, latency); err != nil {
+			return err
+		}
+	}
+	if fail {
+		return errChaos
+	}
+	return nil
+}
+
+func (c *chaosBillingClient) CreateCustomer(
+	ctx context.Context,
+	key thread.PubKey,
+	email string,
+	username string,
+	accountType mdb.AccountType,
+	opts ...billing.Option,
+) (string, error) {
+	if err := c.inject(ctx); err != nil {
+		return "", err
+	}
+	return c.billingClient.CreateCustomer(ctx, key, email, username, accountType, opts...)
+}
+
+func (c *chaosBillingClient) GetCustomer(ctx context.Context, key thread.PubKey) (*pb.GetCustomerResponse, error) {
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.billingClient.GetCustomer(ctx, key)
+}
+
+func (c *chaosBillingClient) IncCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.billingClient.IncCustomerUsage(ctx, key, productUsage, opts...)
+}
+
+func (c *chaosBillingClient) SetCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+) (*pb.SetCustomerUsageResponse, error) {
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.billingClient.SetCustomerUsage(ctx, key, productUsage)
+}
+
+// SetBillingChaos changes the latency and error rate injected into billing calls made by the
+// usage interceptor. Zero values stop injecting chaos. It requires Config.BillingChaos.
+func (t *Textile) SetBillingChaos(latency time.Duration, errorRate float64) error {
+	if t.chaos == nil {
+		return errBillingChaosDisabled
+	}
+	if err := t.chaos.set(latency, errorRate); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Warnf("billing chaos set to %s latency and %.2f error rate", latency, errorRate)
+	return nil
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_BillingChaos(t *testing.T) {
+	bc := newTestBillingClient()
+	chaos := newChaosBillingClient(bc)
+	var slept []time.Duration
+	chaos.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+	chaos.rand = func() float64 { return 0.5 }
+	tx := &Textile{bc: chaos, chaos: chaos}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Injected latency delays each billing call.
+	require.NoError(t, tx.SetBillingChaos(200*time.Millisecond, 0))
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{200 * time.Millisecond}, slept)
+
+	// Injected errors fail the calls they hit.
+	require.NoError(t, tx.SetBillingChaos(0, 0.6))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	require.NoError(t, tx.SetBillingChaos(0, 0.4))
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.SetBillingChaos(0, 1))
+	err = tx.postUsageFunc(ctx, pushPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Empty(t, bc.getIncs())
+
+	// Clearing chaos restores normal behavior.
+	require.NoError(t, tx.SetBillingChaos(0, 0))
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	assert.Len(t, bc.getIncs(), 1)
+}
+
+func TestTextile_SetBillingChaos(t *testing.T) {
+	tx := &Textile{bc: newTestBillingClient()}
+	err := tx.SetBillingChaos(time.Second, 0)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	tx.chaos = newChaosBillingClient(tx.bc)
+	err = tx.SetBillingChaos(0, 1.5)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = tx.SetBillingChaos(-time.Second, 0)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
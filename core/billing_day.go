@@ -0,0 +1,45 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// usageDay returns the billing day of a customer's daily usage of key, which billingd
+// may offset from midnight per customer. False is returned if the period is unknown.
+func usageDay(cus *pb.GetCustomerResponse, key string) (billingDay, bool) {
+	p := cus.DailyUsage[key].GetPeriod()
+	if p.GetUnixEnd() == 0 {
+		return billingDay{}, false
+	}
+	return billingDay{start: time.Unix(p.UnixStart, 0), end: time.Unix(p.UnixEnd, 0)}, true
+}
+
+// syncBurstDay aligns an owner's burst window for key with their billing day.
+func (t *Textile) syncBurstDay(ownerKey thread.PubKey, cus *pb.GetCustomerResponse, key string) {
+	if t.bursts == nil {
+		return
+	}
+	if day, ok := usageDay(cus, key); ok {
+		t.bursts.setDay(ownerKey.String(), key, day)
+	}
+}
+
+// syncEgressBurstDay aligns an owner's egress burst window with their billing day.
+func (t *Textile) syncEgressBurstDay(ownerKey thread.PubKey, cus *pb.GetCustomerResponse) {
+	if day, ok := usageDay(cus, "network_egress"); ok {
+		t.egressBursts.setDay(ownerKey.String(), "network_egress", day)
+	}
+}
+
+// withResetHint adds the time at which a customer's daily usage of key resets to err, if known.
+func withResetHint(err error, cus *pb.GetCustomerResponse, key string) error {
+	day, ok := usageDay(cus, key)
+	if !ok {
+		return err
+	}
+	return fmt.Errorf("%v, resets at %s", err, day.end.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_BillingDayHints(t *testing.T) {
+	now := time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC)
+	clock := newTestClock(now)
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:     bc,
+		clock:  clock,
+		bursts: newBurstLimiter(12*time.Hour, map[string]int{"instance_reads": 1}),
+	}
+	dev := newTestDev(t)
+	// The owner's billing day resets at 21:00 instead of midnight.
+	dayEnd := time.Date(2021, 3, 1, 21, 0, 0, 0, time.UTC)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Period = &pb.Period{
+		UnixStart: dayEnd.Add(-24 * time.Hour).Unix(),
+		UnixEnd:   dayEnd.Unix(),
+	}
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// The burst window resets with the billing day instead of after the full window.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "window resets at 2021-03-01T21:00:00Z")
+
+	clock.advance(time.Hour)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// Exhausted quotas include when the billing day resets.
+	cus.DailyUsage["instance_reads"].Free = 0
+	cus.DailyUsage["instance_reads"].Period = &pb.Period{
+		UnixStart: dayEnd.Unix(),
+		UnixEnd:   dayEnd.Add(24 * time.Hour).Unix(),
+	}
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "resets at 2021-03-02T21:00:00Z")
+}
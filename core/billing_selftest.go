@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
+	hpb "github.com/textileio/textile/v2/api/hubd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// selfTestEmail is the email of the disposable owner created by BillingSelfTest.
+	selfTestEmail = "billing-self-test@textile.io"
+	// selfTestUsername is the username of the disposable owner created by BillingSelfTest.
+	selfTestUsername = "billing-self-test"
+	// selfTestUsageKey is the usage incremented by BillingSelfTest.
+	selfTestUsageKey = "instance_reads"
+)
+
+// BillingSelfTest runs a synthetic request through the billing path with a disposable owner:
+// the owner's customer is fetched and created, its usage is incremented, and the increment is
+// read back. Steps after a failed step aren't run, and the disposable customer is always deleted.
+func (t *Textile) BillingSelfTest(ctx context.Context) ([]*hpb.BillingSelfTestResponse_Step, error) {
+	if t.bc == nil {
+		return nil, fmt.Errorf("billing is not enabled")
+	}
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	key := thread.NewLibp2pPubKey(pk)
+
+	var steps []*hpb.BillingSelfTestResponse_Step
+	run := func(name string, fn func() error) bool {
+		start := time.Now()
+		err := fn()
+		step := &hpb.BillingSelfTestResponse_Step{
+			Name:      name,
+			Ok:        err == nil,
+			LatencyNs: int64(time.Since(start)),
+		}
+		if err != nil {
+			step.Error = err.Error()
+		}
+		steps = append(steps, step)
+		return err == nil
+	}
+
+	var created bool
+	var before int64
+	for _, s := range []struct {
+		name string
+		fn   func() error
+	}{
+		{name: "get_customer", fn: func() error {
+			_, err := t.bc.GetCustomer(ctx, key)
+			if err == nil {
+				return fmt.Errorf("disposable owner %s already has a customer", key)
+			} else if !strings.Contains(err.Error(), mongo.ErrNoDocuments.Error()) {
+				return err
+			}
+			return nil
+		}},
+		{name: "create_customer", fn: func() error {
+			if _, err := t.bc.CreateCustomer(ctx, key, selfTestEmail, selfTestUsername, mdb.Dev); err != nil {
+				return err
+			}
+			created = true
+			cus, err := t.bc.GetCustomer(ctx, key)
+			if err != nil {
+				return err
+			}
+			before = cus.DailyUsage[selfTestUsageKey].GetTotal()
+			return nil
+		}},
+		{name: "inc_usage", fn: func() error {
+			_, err := t.bc.IncCustomerUsage(ctx, key, map[string]int64{selfTestUsageKey: 1})
+			return err
+		}},
+		{name: "verify_usage", fn: func() error {
+			cus, err := t.bc.GetCustomer(ctx, key)
+			if err != nil {
+				return err
+			}
+			if total := cus.DailyUsage[selfTestUsageKey].GetTotal(); total != before+1 {
+				return fmt.Errorf("%s total is %d, expected %d", selfTestUsageKey, total, before+1)
+			}
+			return nil
+		}},
+	} {
+		if !run(s.name, s.fn) {
+			break
+		}
+	}
+	if created {
+		run("delete_customer", func() error {
+			return t.bc.DeleteCustomer(ctx, key)
+		})
+	}
+	return steps, nil
+}
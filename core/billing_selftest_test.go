@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// failingIncBillingClient fails to increment usage.
+type failingIncBillingClient struct {
+	*testBillingClient
+}
+
+func (c *failingIncBillingClient) IncCustomerUsage(
+	context.Context,
+	thread.PubKey,
+	map[string]int64,
+	...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	return nil, errors.New("billing unavailable")
+}
+
+func TestTextile_BillingSelfTest(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	steps, err := tx.BillingSelfTest(context.Background())
+	require.NoError(t, err)
+	var names []string
+	for _, step := range steps {
+		assert.True(t, step.Ok, step.Error)
+		names = append(names, step.Name)
+	}
+	assert.Equal(t, []string{"get_customer", "create_customer", "inc_usage", "verify_usage", "delete_customer"}, names)
+
+	// The disposable owner is deleted.
+	assert.Empty(t, bc.customers)
+}
+
+func TestTextile_BillingSelfTestFailure(t *testing.T) {
+	bc := &failingIncBillingClient{testBillingClient: newTestBillingClient()}
+	tx := &Textile{bc: bc}
+
+	steps, err := tx.BillingSelfTest(context.Background())
+	require.NoError(t, err)
+	require.Len(t, steps, 4)
+	assert.True(t, steps[1].Ok)
+	assert.Equal(t, "inc_usage", steps[2].Name)
+	assert.False(t, steps[2].Ok)
+	assert.Equal(t, "billing unavailable", steps[2].Error)
+
+	// Later steps are skipped, but the disposable owner is still deleted.
+	assert.Equal(t, "delete_customer", steps[3].Name)
+	assert.True(t, steps[3].Ok)
+	assert.Empty(t, bc.customers)
+}
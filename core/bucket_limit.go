@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bucketCounter counts an owner's buckets.
+type bucketCounter interface {
+	// OwnerBucketCount returns the number of buckets in the owner's threads.
+	OwnerBucketCount(ctx context.Context, owner thread.PubKey) (int, error)
+}
+
+// bucketLimitEnforcement is how the bucket limit is enforced for concurrent Create requests.
+type bucketLimitEnforcement int
+
+const (
+	// bucketLimitReserve counts an owner's in-flight Create requests against their limit,
+	// so that concurrent requests can't race past it.
+	bucketLimitReserve bucketLimitEnforcement = iota
+	// bucketLimitCount only counts an owner's existing buckets.
+	bucketLimitCount
+)
+
+func parseBucketLimitEnforcement(mode string) (bucketLimitEnforcement, error) {
+	switch strings.ToLower(mode) {
+	case "", "reserve":
+		return bucketLimitReserve, nil
+	case "count":
+		return bucketLimitCount, nil
+	default:
+		return 0, fmt.Errorf("invalid bucket limit enforcement: %s", mode)
+	}
+}
+
+// pendingBuckets tracks each owner's Create requests that have not completed.
+type pendingBuckets struct {
+	lk     sync.Mutex
+	owners map[string]int
+}
+
+func newPendingBuckets() *pendingBuckets {
+	return &pendingBuckets{owners: make(map[string]int)}
+}
+
+// reserve adds a pending bucket for owner if their existing and pending buckets stay within limit.
+func (p *pendingBuckets) reserve(owner string, count, limit int) bool {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if count+p.owners[owner] >= limit {
+		return false
+	}
+	p.owners[owner]++
+	return true
+}
+
+// release removes a pending bucket for owner.
+func (p *pendingBuckets) release(owner string) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.owners[owner]--
+	if p.owners[owner] <= 0 {
+		delete(p.owners, owner)
+	}
+}
+
+// bucketReservation is a pending bucket that's released when its Create request completes.
+type bucketReservation struct {
+	owner   string
+	pending *pendingBuckets
+	once    sync.Once
+}
+
+func newBucketReservationContext(ctx context.Context, r *bucketReservation) context.Context {
+	return context.WithValue(ctx, usageCtxKey("bucketReservation"), r)
+}
+
+// releaseBucketReservation releases the pending bucket of the request in ctx, if any.
+// Only the first call has an effect.
+func releaseBucketReservation(ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	if r, ok := ctx.Value(usageCtxKey("bucketReservation")).(*bucketReservation); ok {
+		r.once.Do(func() {
+			r.pending.release(r.owner)
+		})
+	}
+}
+
+// maxBucketsPerOwner returns the bucket limit that applies to an owner, or zero if there's no limit.
+func (t *Textile) maxBucketsPerOwner(billable bool) int {
+	if billable {
+		return t.conf.MaxNumberBucketsPerBillableOwner
+	}
+	return t.conf.MaxNumberBucketsPerOwner
+}
+
+// checkBucketLimit denies a request to create a bucket if the owner already has the max number of buckets.
+// Unless the limit is only enforced by counting, the owner's other in-flight Create requests are included,
+// and the request's own pending bucket is attached to the returned context until the request completes.
+func (t *Textile) checkBucketLimit(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	billable bool,
+) (context.Context, error) {
+	limit := t.maxBucketsPerOwner(billable)
+	if limit <= 0 || t.bucketCount == nil {
+		return ctx, nil
+	}
+	// Buckets are counted for the owner billed for the request, as they are for quotas.
+	key := t.ownerKey(ctx, account)
+	count, err := t.bucketCount.OwnerBucketCount(ctx, key)
+	if err != nil {
+		return ctx, fmt.Errorf("counting buckets for %s: %v", key, err)
+	}
+	denied := count >= limit
+	if !denied && t.pendingBucks != nil && !isDryRun(ctx) {
+		if t.pendingBucks.reserve(key.String(), count, limit) {
+			ctx = newBucketReservationContext(ctx, &bucketReservation{owner: key.String(), pending: t.pendingBucks})
+		} else {
+			denied = true
+		}
+	}
+	if denied {
+		return ctx, t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, ErrTooManyBucketsPerOwner.Error()))
+	}
+	return ctx, nil
+}
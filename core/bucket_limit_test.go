@@ -0,0 +1,199 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_BucketLimit(t *testing.T) {
+	bc := newTestBillingClient()
+	counter := &testBucketCounter{counts: make(map[string]int)}
+	tx := &Textile{
+		bc:          bc,
+		bucketCount: counter,
+		conf:        Config{MaxNumberBucketsPerOwner: 2},
+	}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	counter.counts[dev.Key.String()] = 1
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), createMethod)
+	require.NoError(t, err)
+
+	// The owner is at the limit.
+	counter.counts[dev.Key.String()] = 2
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), createMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), ErrTooManyBucketsPerOwner.Error())
+
+	// Other bucket requests are not limited.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+}
+
+func TestPreUsageFunc_BucketLimitBillable(t *testing.T) {
+	bc := newTestBillingClient()
+	counter := &testBucketCounter{counts: make(map[string]int)}
+	tx := &Textile{
+		bc:          bc,
+		bucketCount: counter,
+		conf:        Config{MaxNumberBucketsPerOwner: 2},
+	}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.Billable = true
+	bc.setCustomer(cus)
+	counter.counts[dev.Key.String()] = 10
+
+	// Billable owners have no limit by default.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), createMethod)
+	require.NoError(t, err)
+
+	tx.conf.MaxNumberBucketsPerBillableOwner = 10
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), createMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestPreUsageFunc_BucketLimitBilledOwner(t *testing.T) {
+	bc := newTestBillingClient()
+	counter := &testBucketCounter{counts: make(map[string]int)}
+	workspace := newTestDev(t)
+	tx := &Textile{
+		bc:           bc,
+		bucketCount:  counter,
+		pendingBucks: newPendingBuckets(),
+		conf: Config{
+			MaxNumberBucketsPerOwner: 2,
+			OwnerResolver: func(context.Context, *mdb.AccountCtx) (thread.PubKey, mdb.AccountType, bool) {
+				return workspace.Key, mdb.Org, true
+			},
+		},
+	}
+	bc.setCustomer(newTestCustomer(workspace.Key))
+
+	// Buckets are limited for the billed owner, not the account owner.
+	dev := newTestDev(t)
+	counter.counts[workspace.Key.String()] = 2
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), createMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The pending bucket is reserved for the billed owner, too.
+	counter.counts[workspace.Key.String()] = 1
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), createMethod)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{workspace.Key.String(): 1}, tx.pendingBucks.owners)
+}
+
+func TestUnaryServerInterceptor_BucketLimitConcurrency(t *testing.T) {
+	tests := []struct {
+		mode    string
+		created int
+	}{
+		{mode: "reserve", created: 2},
+		// Without reservations, concurrent requests all see the same count.
+		{mode: "count", created: 20},
+	}
+	for _, tc := range tests {
+		t.Run(tc.mode, func(t *testing.T) {
+			enforcement, err := parseBucketLimitEnforcement(tc.mode)
+			require.NoError(t, err)
+			bc := newTestBillingClient()
+			counter := &testBucketCounter{counts: make(map[string]int)}
+			tx := &Textile{
+				bc:          bc,
+				bucketCount: counter,
+				conf:        Config{MaxNumberBucketsPerOwner: 5},
+			}
+			if enforcement == bucketLimitReserve {
+				tx.pendingBucks = newPendingBuckets()
+			}
+
+			dev := newTestDev(t)
+			bc.setCustomer(newTestCustomer(dev.Key))
+			counter.counts[dev.Key.String()] = 3
+
+			// Creates are held in their handler until every request has been checked.
+			var lk sync.Mutex
+			var checked, entered int
+			allChecked := make(chan struct{})
+			done := func(handled bool) {
+				lk.Lock()
+				defer lk.Unlock()
+				checked++
+				if handled {
+					entered++
+				}
+				if checked == 20 {
+					close(allChecked)
+				}
+			}
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				done(true)
+				<-allChecked
+				counter.add(dev.Key, 1)
+				return nil, nil
+			}
+			pre := func(ctx context.Context, method string) (context.Context, error) {
+				ctx, err := tx.preUsageFunc(ctx, method)
+				if err != nil {
+					done(false)
+				}
+				return ctx, err
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: createMethod}
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, _ = unaryServerInterceptor(pre, tx.postUsageFunc)(newTestAccountContext(dev), nil, info, handler)
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, tc.created, entered)
+			assert.Equal(t, 3+tc.created, counter.get(dev.Key))
+			if tx.pendingBucks != nil {
+				assert.Empty(t, tx.pendingBucks.owners)
+			}
+		})
+	}
+
+	_, err := parseBucketLimitEnforcement("lock")
+	require.Error(t, err)
+}
+
+type testBucketCounter struct {
+	lk     sync.Mutex
+	counts map[string]int
+}
+
+func (c *testBucketCounter) OwnerBucketCount(_ context.Context, owner thread.PubKey) (int, error) {
+	return c.get(owner), nil
+}
+
+func (c *testBucketCounter) add(owner thread.PubKey, n int) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	c.counts[owner.String()] += n
+}
+
+func (c *testBucketCounter) get(owner thread.PubKey) int {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	return c.counts[owner.String()]
+}
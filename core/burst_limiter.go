@@ -0,0 +1,148 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+// burstLimiter enforces a max number of requests, or an amount of usage, per owner and usage key
+// within a sliding time window. Windows don't extend past the start of the owner's
+// billing day, so they roll over when billingd resets the owner's daily usage.
+type burstLimiter struct {
+	window time.Duration
+	limits map[string]int64
+
+	lk     sync.Mutex
+	events map[string][]burstEvent
+	days   map[string]billingDay
+}
+
+// burstEvent is usage recorded by a burst limiter, e.g., a request or the bytes it's expected to send.
+type burstEvent struct {
+	at time.Time
+	n  int64
+}
+
+// billingDay is the period of an owner's daily usage for a usage key.
+type billingDay struct {
+	start time.Time
+	end   time.Time
+}
+
+func newBurstLimiter(window time.Duration, limits map[string]int) *burstLimiter {
+	l := newUsageBurstLimiter(window, make(map[string]int64))
+	for k, limit := range limits {
+		l.limits[k] = int64(limit)
+	}
+	return l
+}
+
+// newUsageBurstLimiter returns a limiter of the amount of usage per key, e.g., network_egress bytes.
+func newUsageBurstLimiter(window time.Duration, limits map[string]int64) *burstLimiter {
+	return &burstLimiter{
+		window: window,
+		limits: limits,
+		events: make(map[string][]burstEvent),
+		days:   make(map[string]billingDay),
+	}
+}
+
+// setDay records the current billing day of owner for usage key.
+func (l *burstLimiter) setDay(owner, key string, day billingDay) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	l.days[owner+"/"+key] = day
+}
+
+// bounds returns the start of the window ending at now for k, along with the end of the
+// owner's billing day, which is zero if unknown. A billing day that ended before now is
+// assumed to have rolled over. The caller must hold the lock.
+func (l *burstLimiter) bounds(k string, now time.Time) (time.Time, time.Time) {
+	start := now.Add(-l.window)
+	day, ok := l.days[k]
+	if !ok {
+		return start, time.Time{}
+	}
+	dayStart, dayEnd := day.start, day.end
+	if !now.Before(dayEnd) {
+		dayStart, dayEnd = dayEnd, time.Time{}
+	}
+	if dayStart.Add(-time.Nanosecond).After(start) {
+		start = dayStart.Add(-time.Nanosecond)
+	}
+	return start, dayEnd
+}
+
+// allow records a request for owner and usage key at now if it's within the limit.
+// If not, false is returned along with the time at which the window will allow another request.
+func (l *burstLimiter) allow(owner, key string, now time.Time) (bool, time.Time) {
+	return l.check(owner, key, 1, now, true)
+}
+
+// peek is like allow, but the request is not recorded.
+func (l *burstLimiter) peek(owner, key string, now time.Time) (bool, time.Time) {
+	return l.check(owner, key, 1, now, false)
+}
+
+// allowN is like allow, but records n of usage, e.g., bytes. Usage that exceeds the limit on its own
+// is allowed if there's no other usage within the window, so that it can't be denied indefinitely.
+func (l *burstLimiter) allowN(owner, key string, n int64, now time.Time) (bool, time.Time) {
+	return l.check(owner, key, n, now, true)
+}
+
+func (l *burstLimiter) check(owner, key string, n int64, now time.Time, record bool) (bool, time.Time) {
+	limit, ok := l.limits[key]
+	if !ok || limit <= 0 {
+		return true, time.Time{}
+	}
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	k := owner + "/" + key
+	events := l.events[k]
+	start, dayEnd := l.bounds(k, now)
+	i := 0
+	for i < len(events) && !events[i].at.After(start) {
+		i++
+	}
+	events = events[i:]
+	var used int64
+	for _, e := range events {
+		used += e.n
+	}
+	if used > 0 && used+n > limit {
+		l.events[k] = events
+		// The window allows n once enough of the oldest usage slides out.
+		var reset time.Time
+		for _, e := range events {
+			used -= e.n
+			reset = e.at.Add(l.window)
+			if used+n <= limit {
+				break
+			}
+		}
+		if !dayEnd.IsZero() && dayEnd.Before(reset) {
+			reset = dayEnd
+		}
+		return false, reset
+	}
+	if record {
+		events = append(events, burstEvent{at: now, n: n})
+	}
+	l.events[k] = events
+	return true, time.Time{}
+}
+
+// count returns the number of requests recorded for owner and usage key within the window ending at now.
+func (l *burstLimiter) count(owner, key string, now time.Time) int {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	k := owner + "/" + key
+	start, _ := l.bounds(k, now)
+	var n int
+	for _, e := range l.events[k] {
+		if e.at.After(start) {
+			n++
+		}
+	}
+	return n
+}
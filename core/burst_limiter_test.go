@@ -0,0 +1,90 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBurstLimiter_SlidingWindow(t *testing.T) {
+	l := newBurstLimiter(time.Minute, map[string]int{"instance_reads": 3})
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		ok, _ := l.allow("owner", "instance_reads", now.Add(time.Duration(i)*time.Second))
+		require.True(t, ok)
+	}
+	ok, reset := l.allow("owner", "instance_reads", now.Add(10*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, now.Add(time.Minute), reset)
+
+	// Other owners and keys are counted separately.
+	ok, _ = l.allow("other", "instance_reads", now.Add(10*time.Second))
+	assert.True(t, ok)
+	ok, _ = l.allow("owner", "instance_writes", now.Add(10*time.Second))
+	assert.True(t, ok)
+
+	// The oldest request slides out of the window.
+	ok, _ = l.allow("owner", "instance_reads", now.Add(time.Minute+time.Millisecond))
+	assert.True(t, ok)
+	ok, reset = l.allow("owner", "instance_reads", now.Add(time.Minute+2*time.Millisecond))
+	assert.False(t, ok)
+	assert.Equal(t, now.Add(time.Minute+time.Second), reset)
+}
+
+func TestPreUsageFunc_BurstLimit(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, bursts: newBurstLimiter(time.Minute, map[string]int{"instance_reads": 5})}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	for i := 0; i < 5; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+		require.NoError(t, err)
+	}
+
+	// The daily quota has plenty left, but the burst limit is reached.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "window resets at")
+
+	// Writes are not limited.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Save")
+	require.NoError(t, err)
+}
+
+func TestBurstLimiter_BillingDayRollover(t *testing.T) {
+	l := newBurstLimiter(time.Hour, map[string]int{"instance_reads": 2})
+	// The owner's billing day resets at 06:30 instead of midnight.
+	dayStart := time.Date(2021, 3, 1, 6, 30, 0, 0, time.UTC)
+	dayEnd := dayStart.Add(24 * time.Hour)
+	l.setDay("owner", "instance_reads", billingDay{start: dayStart.Add(-24 * time.Hour), end: dayStart})
+
+	for i := 0; i < 2; i++ {
+		ok, _ := l.allow("owner", "instance_reads", dayStart.Add(-10*time.Minute))
+		require.True(t, ok)
+	}
+	// The reset hint is the end of the billing day, which is before the window slides.
+	ok, reset := l.allow("owner", "instance_reads", dayStart.Add(-time.Minute))
+	assert.False(t, ok)
+	assert.Equal(t, dayStart, reset)
+
+	// The window rolls over at the reset boundary, even before the next billing day is synced.
+	ok, _ = l.allow("owner", "instance_reads", dayStart)
+	assert.True(t, ok)
+	assert.Equal(t, 1, l.count("owner", "instance_reads", dayStart))
+
+	// Windows that end before the next boundary are reported as usual.
+	l.setDay("owner", "instance_reads", billingDay{start: dayStart, end: dayEnd})
+	ok, _ = l.allow("owner", "instance_reads", dayStart.Add(time.Minute))
+	assert.True(t, ok)
+	ok, reset = l.allow("owner", "instance_reads", dayStart.Add(2*time.Minute))
+	assert.False(t, ok)
+	assert.Equal(t, dayStart.Add(time.Hour), reset)
+}
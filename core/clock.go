@@ -0,0 +1,24 @@
+package core
+
+import "time"
+
+// Clock tells the current time.
+// Time-based interceptor logic reads the time from a Clock so that it can be tested deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock is a Clock that reads the system wall clock.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// now returns the current time from the Textile's clock, or the wall clock if one isn't set.
+func (t *Textile) now() time.Time {
+	if t.clock == nil {
+		return time.Now()
+	}
+	return t.clock.Now()
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+)
+
+// defaultClockSkewLogThreshold is the default skew from billingd's clock that is logged.
+const defaultClockSkewLogThreshold = time.Second * 5
+
+// timeSource reports the current time of a remote service.
+// A zero time is returned if the service doesn't report it.
+type timeSource interface {
+	Now(ctx context.Context) (time.Time, error)
+}
+
+var _ timeSource = (*billing.Client)(nil)
+
+// skewedClock is a Clock that's periodically corrected to billingd's clock, so that usage
+// windows roll over and reset when billingd's do, even if the server clock has drifted.
+type skewedClock struct {
+	clock     Clock
+	src       timeSource
+	interval  time.Duration
+	threshold time.Duration
+	offset    int64 // nanoseconds
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newSkewedClock(clock Clock, src timeSource, interval, threshold time.Duration) *skewedClock {
+	if threshold <= 0 {
+		threshold = defaultClockSkewLogThreshold
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &skewedClock{
+		clock:     clock,
+		src:       src,
+		interval:  interval,
+		threshold: threshold,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+// Now returns the time of the underlying clock corrected by the last measured skew.
+func (c *skewedClock) Now() time.Time {
+	return c.clock.Now().Add(c.skew())
+}
+
+// skew returns how far the underlying clock is behind billingd's clock.
+func (c *skewedClock) skew() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.offset))
+}
+
+// start measures the skew now and again on the clock's interval.
+func (c *skewedClock) start() {
+	go func() {
+		defer close(c.done)
+		c.sync(c.ctx)
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+				c.sync(c.ctx)
+			}
+		}
+	}()
+}
+
+// sync measures the skew from the time source. The remote time is assumed to be read halfway
+// through the round trip. The last skew is kept if the time source fails or doesn't report a time.
+func (c *skewedClock) sync(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, statsTimeout)
+	defer cancel()
+	sent := c.clock.Now()
+	remote, err := c.src.Now(ctx)
+	if err != nil {
+		log.Errorf("clock skew: getting billing time: %v", err)
+		return
+	}
+	if remote.IsZero() {
+		return
+	}
+	recv := c.clock.Now()
+	skew := remote.Sub(sent.Add(recv.Sub(sent) / 2))
+	atomic.StoreInt64(&c.offset, int64(skew))
+	if skew >= c.threshold || -skew >= c.threshold {
+		log.Warnf("server clock is skewed by %s from billing, usage windows are corrected", skew)
+	}
+}
+
+// close stops measuring the skew.
+func (c *skewedClock) close() {
+	c.cancel()
+	<-c.done
+}
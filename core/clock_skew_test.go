@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+type testTimeSource struct {
+	now time.Time
+	err error
+}
+
+func (s *testTimeSource) Now(context.Context) (time.Time, error) {
+	return s.now, s.err
+}
+
+func TestSkewedClock_Sync(t *testing.T) {
+	now := time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC)
+	src := &testTimeSource{now: now.Add(10 * time.Minute)}
+	c := newSkewedClock(newTestClock(now), src, time.Minute, 0)
+	assert.Equal(t, now, c.Now())
+
+	c.sync(context.Background())
+	assert.Equal(t, 10*time.Minute, c.skew())
+	assert.Equal(t, now.Add(10*time.Minute), c.Now())
+
+	// The last skew is kept if billingd fails or doesn't report its time.
+	src.err = errors.New("unavailable")
+	c.sync(context.Background())
+	assert.Equal(t, 10*time.Minute, c.skew())
+	src.now, src.err = time.Time{}, nil
+	c.sync(context.Background())
+	assert.Equal(t, 10*time.Minute, c.skew())
+
+	// Clocks ahead of billingd are corrected too.
+	src.now = now.Add(-time.Minute)
+	c.sync(context.Background())
+	assert.Equal(t, now.Add(-time.Minute), c.Now())
+}
+
+func TestPreUsageFunc_ClockSkew(t *testing.T) {
+	now := time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC)
+	local := newTestClock(now)
+	// The server clock is almost an hour behind billingd.
+	clock := newSkewedClock(local, &testTimeSource{now: now.Add(59 * time.Minute)}, time.Minute, 0)
+	clock.sync(context.Background())
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:     bc,
+		clock:  clock,
+		bursts: newBurstLimiter(12*time.Hour, map[string]int{"instance_reads": 1}),
+	}
+	dev := newTestDev(t)
+	dayEnd := time.Date(2021, 3, 1, 21, 0, 0, 0, time.UTC)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Period = &pb.Period{
+		UnixStart: dayEnd.Add(-24 * time.Hour).Unix(),
+		UnixEnd:   dayEnd.Unix(),
+	}
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+
+	// The billing day has ended by billingd's clock, though not by the server's.
+	local.advance(2 * time.Minute)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+}
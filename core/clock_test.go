@@ -0,0 +1,91 @@
+package core
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_BurstLimitFakeClock(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:     bc,
+		clock:  clock,
+		bursts: newBurstLimiter(time.Minute, map[string]int{"instance_reads": 2}),
+	}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	clock.advance(30 * time.Second)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// The window resets exactly one minute after the first request.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "window resets at 2020-01-01T00:01:00Z")
+
+	// Just before the reset, the request is still denied.
+	clock.advance(30*time.Second - time.Millisecond)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+
+	// Once the first request leaves the window, another is allowed.
+	clock.advance(time.Millisecond)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+}
+
+func TestPreUsageFunc_GracePeriodFakeClock(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := newTestClock(start)
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	cus.GracePeriodEnd = start.Add(time.Hour).Unix()
+	bc.setCustomer(cus)
+
+	// Reads are allowed during the grace period.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	clock.advance(time.Hour)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// testClock is a Clock that only moves when advanced.
+type testClock struct {
+	lk  sync.Mutex
+	now time.Time
+}
+
+func newTestClock(now time.Time) *testClock {
+	return &testClock{now: now}
+}
+
+func (c *testClock) Now() time.Time {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	return c.now
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -26,6 +26,7 @@ import (
 	dbpb "github.com/textileio/go-threads/api/pb"
 	"github.com/textileio/go-threads/broadcast"
 	tc "github.com/textileio/go-threads/common"
+	"github.com/textileio/go-threads/core/thread"
 	kt "github.com/textileio/go-threads/db/keytransform"
 	netapi "github.com/textileio/go-threads/net/api"
 	netclient "github.com/textileio/go-threads/net/api/client"
@@ -42,6 +43,7 @@ import (
 	hpb "github.com/textileio/textile/v2/api/hubd/pb"
 	"github.com/textileio/textile/v2/api/usersd"
 	upb "github.com/textileio/textile/v2/api/usersd/pb"
+	"github.com/textileio/textile/v2/buckets"
 	"github.com/textileio/textile/v2/buckets/archive"
 	"github.com/textileio/textile/v2/buckets/archive/retrieval"
 	"github.com/textileio/textile/v2/buckets/archive/tracker"
@@ -52,14 +54,27 @@ import (
 	mdb "github.com/textileio/textile/v2/mongodb"
 	tdb "github.com/textileio/textile/v2/threaddb"
 	"github.com/textileio/textile/v2/util"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
+// defaultStorageReservationTTL is the default lifetime of a storage reservation.
+const defaultStorageReservationTTL = time.Hour
+
 var (
 	// ErrTooManyThreadsPerOwner indicates that the maximum amount of threads
 	// are created for an owner.
 	ErrTooManyThreadsPerOwner = errors.New("number of threads per owner exceeds quota")
 
+	// ErrTooManyBucketsPerOwner indicates that the maximum amount of buckets
+	// per owner has been reached.
+	ErrTooManyBucketsPerOwner = errors.New("number of buckets per owner exceeds quota")
+
+	// ErrExceedsStorageCap indicates the requested operation exceeds an owner's storage cap.
+	ErrExceedsStorageCap = errors.New("request exceeds storage cap")
+
 	log = logging.Logger("core")
 
 	// authIgnoredMethods are not intercepted by the auth interceptor.
@@ -67,6 +82,11 @@ var (
 		"/api.hubd.pb.APIService/Signup",
 		"/api.hubd.pb.APIService/Signin",
 		"/api.hubd.pb.APIService/IsUsernameAvailable",
+		"/api.hubd.pb.APIService/RecalculateStorage",
+		"/api.hubd.pb.APIService/BatchGetUsage",
+		"/api.hubd.pb.APIService/BillingSelfTest",
+		"/api.hubd.pb.APIService/SetBillingChaos",
+		"/api.hubd.pb.APIService/InvalidateCustomerCache",
 	}
 
 	// usageIgnoredMethods are not intercepted by the usage interceptor.
@@ -75,6 +95,28 @@ var (
 		"/api.hubd.pb.APIService/DestroyAccount",
 		"/api.hubd.pb.APIService/SetupBilling",
 		"/api.hubd.pb.APIService/GetBillingSession",
+		"/api.usersd.pb.APIService/CheckAccess",
+		"/api.usersd.pb.APIService/GetLimits",
+		"/api.usersd.pb.APIService/WatchUsage",
+	}
+
+	// egressStreamMethods are streaming methods whose network egress is measured
+	// by the stream interceptor instead of the stats handler.
+	egressStreamMethods = []string{
+		"/api.bucketsd.pb.APIService/PullPath",
+	}
+
+	// subscriptionReadMethods are bucket reads allowed during Config.SubscriptionGracePeriod,
+	// along with methods metered as threaddb reads.
+	subscriptionReadMethods = []string{
+		"/api.bucketsd.pb.APIService/List",
+		"/api.bucketsd.pb.APIService/Root",
+		"/api.bucketsd.pb.APIService/Links",
+		"/api.bucketsd.pb.APIService/ListPath",
+		"/api.bucketsd.pb.APIService/ListIpfsPath",
+		"/api.bucketsd.pb.APIService/PullPath",
+		"/api.bucketsd.pb.APIService/PullIpfsPath",
+		"/api.bucketsd.pb.APIService/PullPathAccessRoles",
 	}
 
 	// blockMethods are always blocked by auth.
@@ -131,9 +173,57 @@ type Textile struct {
 
 	th  *threads.Client
 	thn *netclient.Client
-	bc  *billing.Client
+	bc  billingClient
 	pc  *pow.Client
 
+	usage        *usageBatcher
+	reservations *buckets.StorageReservations
+	logDenial    denialLogger
+	logMismatch  mismatchLogger
+	logSupport   auditLogger
+	listenMode   listenMetering
+	listingMode  listingEgress
+	deltaCheck   storageDeltaCheck
+	ownerCheck   storageDeltaCheck
+	orphans      userParentFallback
+	deadLetters  usageDeadLetterStore
+	replayer     *usageReplayer
+	provisioner  bucketProvisioner
+	users        userStore
+	powUsers     powUserCreator
+	powRetrier   *powUserRetrier
+	bursts       *burstLimiter
+	egressBursts *burstLimiter
+	ratios       *readWriteRatio
+	uploads      *pendingUploads
+	clock        Clock
+	skew         *skewedClock
+	chaos        *chaosBillingClient
+	tracer       trace.Tracer
+	labelOwner   ownerLabeler
+	inflight     *inflightEgress
+	pendingBucks *pendingBuckets
+	throttle     *egressThrottle
+	warnings     *egressWarnings
+	lapses       *lapsedSubscriptions
+	softCaps     *storageSoftCaps
+	shedder      *loadShedder
+	decisions    *decisionCache
+	dedup        *requestDedup
+	creations    *customerCreations
+	newCustomers *burstLimiter
+	watchers     *usageWatchers
+	rounder      *usageRounder
+	thresholder  *usageThresholder
+	notifier     storageNotifier
+	requests     *inflightRequests
+	headroom     *quotaHeadroom
+	stopSampler  context.CancelFunc
+	storage      storageCounter
+	bucketCount  bucketCounter
+	threadOwner  threadOwnerFunc
+	decisionExp  *decisionExporter
+
 	bucks *tdb.Buckets
 	mail  *tdb.Mail
 
@@ -144,8 +234,11 @@ type Textile struct {
 	ipnsm *ipns.Manager
 	dnsm  *dns.Manager
 
-	server *grpc.Server
-	proxy  *http.Server
+	server       *grpc.Server
+	proxy        *http.Server
+	knownMethods map[string]struct{}
+	drainer      *drainer
+	streams      *streamLimiter
 
 	gateway            *gateway.Gateway
 	internalHubSession string
@@ -158,6 +251,18 @@ type Config struct {
 	Hub   bool
 	Debug bool
 
+	// MethodValidation is how method names in ignore lists, the usage policy, and promotions that
+	// aren't registered with the server are handled at startup, i.e., off, log, or fail. Defaults to log.
+	MethodValidation string
+	// FailClosedUnknownMethods denies requests to methods that are neither auth-ignored, usage-ignored,
+	// ignored for the owner's type, nor metered, so that new methods can't slip through unbilled.
+	// Methods that should be allowed without metering can be added to the usage policy with an empty policy.
+	FailClosedUnknownMethods bool
+	// MaxConcurrentStreams is the max number of streaming requests handled at once across all owners.
+	// New streams are rejected with Unavailable at the limit. Unary requests aren't limited.
+	// There's no limit when zero.
+	MaxConcurrentStreams int
+
 	// Addresses
 	AddrAPI          ma.Multiaddr
 	AddrAPIProxy     ma.Multiaddr
@@ -172,6 +277,27 @@ type Config struct {
 
 	// Buckets
 	MaxBucketArchiveRepFactor int
+	// StorageExcludedBuckets are keys of buckets, e.g., system buckets, whose storage
+	// is not limited or billed to the owner.
+	StorageExcludedBuckets []string
+	// DefaultBucketName is the name of a bucket created for each new user.
+	// New users don't get a default bucket when empty.
+	DefaultBucketName string
+	// DefaultBucketStrict fails a new user's first request if their default bucket can't be created.
+	DefaultBucketStrict bool
+	// MaxNumberBucketsPerOwner limits the number of buckets a non-billable owner can create.
+	// There's no limit when zero.
+	MaxNumberBucketsPerOwner int
+	// MaxNumberBucketsPerBillableOwner limits the number of buckets a billable owner can create.
+	// There's no limit when zero.
+	MaxNumberBucketsPerBillableOwner int
+	// BucketLimitEnforcement is how bucket limits are enforced for an owner's concurrent Create requests,
+	// i.e., reserve, which counts in-flight requests against the limit, or count, which only counts
+	// existing buckets and may let concurrent requests exceed the limit. Defaults to reserve.
+	BucketLimitEnforcement string
+	// MaxNumberObjectsPerOwner limits the number of objects a non-billable owner can store,
+	// independently of stored data. Objects are counted by billingd. There's no limit when zero.
+	MaxNumberObjectsPerOwner int64
 
 	// Threads
 	MaxNumberThreadsPerOwner int
@@ -179,6 +305,27 @@ type Config struct {
 
 	// Powergate
 	PowergateAdminToken string
+	// PowergateResellerAdminTokens maps reseller keys to the Powergate admin token used to provision
+	// Powergate users for their users, i.e., users whose parent is the reseller, for multi-tenant
+	// isolation. Other users are provisioned with PowergateAdminToken.
+	PowergateResellerAdminTokens map[string]string
+	// PowergateAdminTokenResolver picks the Powergate admin token used to provision the Powergate user
+	// of the user or account in the request context, e.g., by region. It replaces
+	// PowergateResellerAdminTokens when set, and defaults to PowergateAdminToken if no token is resolved.
+	PowergateAdminTokenResolver PowAdminTokenResolver
+	// PowergateDeferUserCreation allows new users to be created without a Powergate user if the
+	// Powergate admin API fails. The Powergate user is retried in the background.
+	PowergateDeferUserCreation bool
+	// PowergateUserRetryInterval is how often deferred Powergate users are retried. Defaults to one minute.
+	PowergateUserRetryInterval time.Duration
+
+	// Admin
+	// AdminToken authorizes hub admin APIs, e.g., RecalculateStorage. Defaults to PowergateAdminToken when empty.
+	// Admin APIs are disabled if neither is set.
+	AdminToken string
+	// AdminAuthorizer replaces the admin token check for hub admin APIs, e.g., to integrate a deployment's
+	// own RBAC. AdminToken is ignored when set.
+	AdminAuthorizer hubd.AdminAuthorizer
 
 	// Archives
 	ArchiveJobPollIntervalSlow time.Duration
@@ -187,6 +334,18 @@ type Config struct {
 	// Gateway
 	UseSubdomains bool
 
+	// Metrics
+	// BusyOwnerSampleInterval is how often the owners with the most in-flight requests are logged.
+	// They are not logged when zero.
+	BusyOwnerSampleInterval time.Duration
+	// BusyOwnerSampleSize is the number of owners logged by each sample. Defaults to 10.
+	BusyOwnerSampleSize int
+	// QuotaHeadroomSampleInterval is how often the free allowance remaining across recently active
+	// non-billable owners is recorded. It's not recorded when zero.
+	QuotaHeadroomSampleInterval time.Duration
+	// QuotaHeadroomSampleSize bounds the number of owners fetched by each sample. Defaults to 100.
+	QuotaHeadroomSampleSize int
+
 	// Cloudflare
 	DNSDomain string
 	DNSZoneID string
@@ -196,7 +355,290 @@ type Config struct {
 	CustomerioAPIKey      string
 	CustomerioConfirmTmpl string
 	CustomerioInviteTmpl  string
+	CustomerioSoftCapTmpl string
 	EmailSessionSecret    string
+
+	// Billing
+	// BillableStorageCaps maps owner keys to a hard stored data limit in bytes
+	// that is enforced even when the owner is billable, e.g., a contract cap.
+	BillableStorageCaps map[string]int64
+	// StorageSoftCap is the stored data in bytes at which non-billable owners are emailed a prompt to upgrade.
+	// It should be below the free allowance. Requests are not blocked. Owners are not notified when zero.
+	StorageSoftCap int64
+	// StorageSoftCapWindow is the time after which an owner can be notified again. Defaults to 30 days.
+	StorageSoftCapWindow time.Duration
+	// UsageFlushInterval is how often batched usage is sent to billingd.
+	// Usage is sent as each request completes when zero.
+	UsageFlushInterval time.Duration
+	// UsageFlushConcurrency bounds the number of owners whose batched usage is sent in parallel.
+	UsageFlushConcurrency int
+	// UsageBacklogMax caps the number of batched usage deltas held in memory, e.g., while billingd
+	// is down. UsageBacklogOverflow is applied when it's exceeded. There's no cap when zero.
+	UsageBacklogMax int
+	// UsageBacklogOverflow is what's done when UsageBacklogMax is exceeded, i.e., flush to send pending
+	// usage synchronously, dead_letter to move it to the dead-letter store, or reject to deny writes
+	// until the backlog drains. Defaults to flush. Forced flushes count against the attempts of usage
+	// that fails to send, and dead_letter requires UsageReplayInterval.
+	UsageBacklogOverflow string
+	// UsageReplayInterval is how often usage that failed to send to billingd is replayed from
+	// the dead-letter store. Failed usage is not dead-lettered when zero.
+	UsageReplayInterval time.Duration
+	// UsageDeadLetterMaxAge is the age after which dead-lettered usage is dropped. Defaults to one week.
+	UsageDeadLetterMaxAge time.Duration
+	// BillingChaos allows latency and errors to be injected into the billing calls made by the usage
+	// interceptor with the SetBillingChaos admin method, e.g., for resilience testing. It must not be
+	// set in production.
+	BillingChaos bool
+	// ClockSkewInterval is how often the server clock is compared to billingd's clock.
+	// When non-zero, usage windows and reset hints are computed with the server clock
+	// corrected by the measured skew.
+	ClockSkewInterval time.Duration
+	// ClockSkewLogThreshold is the skew from billingd's clock that is logged. Defaults to five seconds.
+	ClockSkewLogThreshold time.Duration
+	// SubscriptionGracePeriod is how long reads are allowed after an owner's subscription is first
+	// observed in a bad status, e.g., while a lapsed payment is retried. Writes are denied immediately.
+	// Reads are denied immediately when zero.
+	SubscriptionGracePeriod time.Duration
+	// StorageReservationTTL is how long reserved storage is held for an upload.
+	// Defaults to one hour.
+	StorageReservationTTL time.Duration
+	// DeferredUploads reports the stored data of chunked PushPath uploads once, when the upload
+	// is finalized. Chunks are correlated by the upload ID in the request metadata, and their
+	// stored data is reserved from the owner's available storage until then.
+	DeferredUploads bool
+	// DeferredUploadTTL is the time after its last chunk that an upload that wasn't finalized is
+	// abandoned, which releases its reserved storage. Defaults to one hour.
+	DeferredUploadTTL time.Duration
+	// AnonymousEgress bills the network egress of PullPath requests without an account, e.g., from
+	// public buckets, to the owner of the bucket's thread instead of passing them through unmetered.
+	AnonymousEgress bool
+	// CachedEgressMultiplier is applied to network egress of reads served by an upstream cache or CDN,
+	// e.g., 0.25 bills cache-served egress at a quarter of the origin rate. Defaults to 1 when zero.
+	CachedEgressMultiplier float64
+	// EgressTiers are applied to non-billable owners as their network egress grows.
+	EgressTiers []EgressTier
+	// EgressPrecount bills the network egress a read expects to send, e.g., the size of a pulled file,
+	// when the read starts so that it's seen by concurrent usage checks. The difference from the bytes
+	// that were sent is billed when the read completes, which is negative if the read failed.
+	EgressPrecount bool
+	// EgressWarnThenBlock allows a non-billable owner one request after exhausting their network egress
+	// in each usage period. The request includes a usage warning header, and later requests are denied.
+	EgressWarnThenBlock bool
+	// EgressWarningGrace is the network egress in bytes allowed for streams of the warned request.
+	EgressWarningGrace int64
+	// EgressThrottleThreshold is the remaining network egress in bytes below which the streams of
+	// non-billable owners are throttled, e.g., for PullPath. Streams aren't throttled when zero.
+	EgressThrottleThreshold int64
+	// EgressThrottleRate is the send rate in bytes per second of a throttled stream at
+	// EgressThrottleThreshold. The rate is reduced in proportion to the owner's remaining egress,
+	// and the stream is stopped when none is left.
+	EgressThrottleRate int64
+	// UsageBurstWindow is the sliding window used to enforce UsageBurstLimits.
+	UsageBurstWindow time.Duration
+	// UsageBurstLimits maps usage keys, i.e., instance_reads and instance_writes,
+	// to the max number of requests an owner can make within UsageBurstWindow.
+	UsageBurstLimits map[string]int
+	// EgressBurstWindow is the sliding window used to enforce EgressBurstLimit.
+	EgressBurstWindow time.Duration
+	// EgressBurstLimit is the max network egress in bytes an owner can send within EgressBurstWindow,
+	// separately from their daily egress quota. Reads are checked when they start using their estimated
+	// size, e.g., the size of the file pulled by PullPath. The limit isn't enforced when zero.
+	EgressBurstLimit int64
+	// ReadWriteRatio is the max ratio of threaddb reads to writes an owner can make within
+	// ReadWriteRatioWindow, e.g., to throttle scraping. Reads over the ratio are denied until the
+	// window resets. The ratio isn't enforced when zero.
+	ReadWriteRatio float64
+	// ReadWriteRatioWindow is the fixed window over which ReadWriteRatio is enforced. Defaults to one hour.
+	ReadWriteRatioWindow time.Duration
+	// ReadWriteRatioMinReads is the number of reads an owner can make within a window before
+	// ReadWriteRatio is enforced. Defaults to 1000.
+	ReadWriteRatioMinReads int64
+	// ReadWriteRatioExemptBillable exempts billable owners from ReadWriteRatio.
+	ReadWriteRatioExemptBillable bool
+	// TrialBillable allows trialing customers that are flagged billable to use billable quotas.
+	// By default, trialing customers are held to free-tier quotas until their trial converts.
+	TrialBillable bool
+	// NewAccountGracePeriod exempts owners whose account is younger than the period from usage quotas.
+	// Their usage is still recorded. There's no exemption when zero.
+	NewAccountGracePeriod time.Duration
+	// ListingEgress is how the network egress of bucket listings, i.e., List, ListPath, and ListIpfsPath
+	// responses, is metered: wire (bytes written to the wire, like other responses), serialized (the
+	// response's serialized size, regardless of compression), or off. Defaults to wire when empty.
+	ListingEgress string
+	// ListenMetering is how threaddb Listen streams consume instance reads, i.e., start (once when
+	// the stream is opened), message (once for each instance sent), or duration (once for each
+	// started ListenReadInterval the stream is open). Defaults to message when empty.
+	ListenMetering string
+	// ListenReadInterval is the stream duration that consumes one read when ListenMetering is duration.
+	// Defaults to one minute.
+	ListenReadInterval time.Duration
+	// ListenRevalidateInterval is how often the owner of an open Listen stream is rechecked, i.e., their
+	// subscription status and instance reads. The stream is terminated if the owner is no longer eligible.
+	// Streams are only checked when they're opened when zero.
+	ListenRevalidateInterval time.Duration
+	// AccountMaxAge is how long the account resolved when a stream is opened is used before it's
+	// re-resolved and the stream's usage check is rerun with it, e.g., to catch an account that was
+	// removed or downgraded. The stream is terminated if the account is no longer allowed to use it.
+	// Accounts are only resolved when a stream is opened when zero.
+	AccountMaxAge time.Duration
+	// StorageDeltaCheck is a strict mode that checks the sign of storage deltas, i.e., off, log, or reject.
+	// Deltas for Create and PushPath should not be negative, and deltas for Remove and RemovePath
+	// should not be positive. Anomalies are logged, and in reject mode, the request fails without its
+	// delta being recorded. Note that overwriting a path with smaller data is reported. Defaults to off.
+	StorageDeltaCheck string
+	// StorageOwnerCheck is a strict mode that checks storage methods complete with a bucket owner in their
+	// context, i.e., off, log, or reject. Without one, a method's stored data isn't recorded, which indicates
+	// a wiring bug. Anomalies are logged, and in reject mode, the request fails. Defaults to off.
+	StorageOwnerCheck string
+	// StorageRounding rounds reported storage deltas to a multiple of StorageRoundingUnit, and the deltas of
+	// keys in UsageRoundingUnits to their unit, i.e., off, ceil, floor, or nearest. Ceil is safest for the
+	// provider and floor is friendliest to users. Floor and nearest carry the remainder to the owner's next
+	// delta of the same key on this instance. Defaults to off.
+	StorageRounding string
+	// StorageRoundingUnit is the unit that storage deltas are rounded to. Defaults to 8 MiB.
+	StorageRoundingUnit int64
+	// UsageRoundingUnits maps other usage keys to the unit that their deltas are rounded to, e.g., 1 MiB
+	// for network_egress. Keys without a unit, e.g., instance_reads, are reported in raw counts.
+	UsageRoundingUnits map[string]int64
+	// UsageReportThresholds maps usage keys to the smallest delta that's reported to billingd. Smaller deltas
+	// are accumulated for each owner until they reach the threshold, or until they're older than
+	// UsageReportTTL, to reduce billingd writes. Keys without a threshold are always reported.
+	UsageReportThresholds map[string]int64
+	// UsageReportTTL is the max time a sub-threshold delta is held before it's reported. Defaults to one minute.
+	UsageReportTTL time.Duration
+	// CoalesceGetCustomer shares a single billingd call among concurrent requests that get the same customer.
+	CoalesceGetCustomer bool
+	// UsageLabels are attached to all usage reported by this instance, e.g., region and environment,
+	// so that billingd can split usage by label.
+	UsageLabels map[string]string
+	// UsageVerifySampleRate is the fraction of usage reports that are verified by re-fetching the owner
+	// and confirming their totals moved by the reported amounts. Discrepancies are logged.
+	// Usage is not verified when zero.
+	UsageVerifySampleRate float64
+	// OwnerMetadataKeys are keys of account metadata, e.g., cost center or plan name, that are attached
+	// to usage reported for the owner as labels. No metadata is attached when empty.
+	OwnerMetadataKeys []string
+	// OwnerMetadataMaxSize bounds the total size in bytes of metadata keys and values attached to usage.
+	// Defaults to 1024.
+	OwnerMetadataMaxSize int
+	// UsagePrices maps usage keys to their price, which is used to estimate the cost of metered requests.
+	// The estimate is returned to billable customers in the response trailer. Free customers see zero.
+	// Costs are not estimated when empty.
+	UsagePrices map[string]UsagePrice
+	// QuotaExhaustedCode is returned instead of ResourceExhausted when a request is denied because
+	// the owner exhausted a usage quota or cap, e.g., for clients that treat ResourceExhausted as retryable.
+	// Defaults to ResourceExhausted when OK.
+	QuotaExhaustedCode codes.Code
+	// ZeroFreeUnlimited are usage keys for which a zero Free allowance means unlimited instead of
+	// exhausted, e.g., for custom plans without a metered free tier. Only keys whose free allowance
+	// isn't used up by usage should be listed. Defaults to none, i.e., zero Free is exhausted.
+	ZeroFreeUnlimited []string
+	// QuotaPriority orders the usage keys whose exhaustion is reported when a request exceeds multiple
+	// quotas at once, e.g., stored_data before instance_reads. Unlisted keys are reported after listed
+	// keys in the order they're checked, i.e., network_egress, object_count, stored_data, then the
+	// method's quota.
+	QuotaPriority []string
+	// UsagePolicy maps methods to how their requests are checked and prepared for metering.
+	// Defaults to DefaultUsagePolicy.
+	UsagePolicy UsagePolicy
+	// VerifyMetering is how threaddb Verify requests are metered, i.e., free, instance_reads,
+	// or another usage key to meter them separately. Defaults to instance_reads.
+	VerifyMetering string
+	// HasMetering is how threaddb Has requests are metered, i.e., free, instance_reads, or another
+	// usage key, e.g., instance_cheap_reads, which has a larger allowance. Defaults to instance_reads.
+	HasMetering string
+	// FreeWriteTransactionReads stops metering the reads made within a threaddb WriteTransaction, e.g., finds,
+	// so that the transaction is billed solely as writes. Has within a transaction is metered per HasMetering.
+	FreeWriteTransactionReads bool
+	// DecisionCacheTTL is how long the outcome of a usage check is reused for identical requests
+	// from the same owner, e.g., a quick succession of reads. Cached outcomes are invalidated when
+	// the owner's recorded usage crosses a limit. Outcomes are not cached when zero.
+	DecisionCacheTTL time.Duration
+	// RequestDedupTTL is how long the result of a mutation is replayed for retries from the same owner
+	// with the same idempotency key, i.e., the x-textile-idempotency-key header. Mutations are not
+	// deduped when zero.
+	RequestDedupTTL time.Duration
+	// GlobalRequestLimit is the max number of requests per second checked by usage across all owners.
+	// Low-priority requests are shed first as the limit is approached. There's no limit when zero.
+	GlobalRequestLimit int
+	// LowPriorityShare is the fraction of GlobalRequestLimit that low-priority requests can use,
+	// leaving the rest for high-priority requests. Defaults to 0.8.
+	LowPriorityShare float64
+	// HighPriorityOwners are keys of non-billable owners whose requests are high priority.
+	// Billable owners are always high priority.
+	HighPriorityOwners []string
+	// OwnerIgnoredMethods maps owner types to methods that are not intercepted by the usage interceptor
+	// for owners of that type, in addition to the methods that are ignored for all owners.
+	OwnerIgnoredMethods map[mdb.AccountType][]string
+	// UsageIgnoredMethods are methods that are authenticated but not intercepted by the usage
+	// interceptor for any owner, in addition to the built-in usage ignored methods. Methods that
+	// skip auth are never metered, so listing them here has no effect.
+	UsageIgnoredMethods []string
+	// MethodPromotions maps methods to a window during which they're free, i.e., their usage isn't
+	// metered and doesn't count against quotas, e.g., for a promotional period.
+	MethodPromotions map[string]Promotion
+	// ResellerFreeMultipliers maps reseller keys, i.e., the parent keys of customers, to a multiplier of
+	// the free quotas of the reseller's customers, e.g., 2 doubles them. Customers without a parent
+	// aren't affected. A multiplier below 1 shrinks the free quotas, and negative multipliers are ignored.
+	ResellerFreeMultipliers map[string]float64
+	// OwnerMaintenance maps owner keys to a scheduled maintenance window during which
+	// the owner's requests fail with Unavailable.
+	OwnerMaintenance map[string]MaintenanceWindow
+	// CustomerCreationWait is how long a request waits for an owner's customer that's being created by
+	// a concurrent request, e.g., the owner's first requests, instead of failing. Requests don't wait when zero.
+	CustomerCreationWait time.Duration
+	// CustomerCreationRate is the max number of customers this instance creates per minute, e.g., to
+	// defend billingd against signup abuse. First requests from new owners over the rate fail with
+	// Unavailable and a retry-after header. Existing customers are unaffected. There's no limit when zero.
+	CustomerCreationRate int
+	// PlaceholderEmail is used to create a billing customer whose email is rejected by billingd,
+	// e.g., because it's malformed, if sanitizing the email doesn't fix it. The request fails with
+	// InvalidArgument when empty.
+	PlaceholderEmail string
+	// SupportSessionKey is the multibase-encoded key that support-session tokens are signed with.
+	// Requests with a valid token, e.g., from support impersonating a user, bypass quotas, aren't metered,
+	// and are audit-logged with the support actor. Support sessions are disabled when empty.
+	SupportSessionKey string
+	// SupportSessionMaxLifetime is the max time before a support-session token expires. Tokens that expire
+	// later than this from now are rejected. Defaults to one day.
+	SupportSessionMaxLifetime time.Duration
+	// DenialLogLevel is the level used to log requests denied by usage checks,
+	// i.e., debug, info, warn, or error. Denials are not logged when empty.
+	DenialLogLevel string
+	// OwnerLabelSalt is the salt of the one-way hash that's applied to owner keys wherever they appear
+	// in metrics, traces, and logs, e.g., denial and support-session audit logs, so that an owner's
+	// requests can be correlated without exposing their key. Billing calls always use the owner's key.
+	// Owner keys are not hashed when empty.
+	OwnerLabelSalt string
+	// DecisionExport is where every usage interceptor decision is exported for offline analysis, i.e.,
+	// a file:// URL that decisions are appended to as JSON lines, or an http(s):// webhook that batches
+	// of decisions are posted to. Decisions are not exported when empty.
+	DecisionExport string
+	// DecisionExportBatchSize is the number of decisions written to DecisionExport at once. Defaults to 100.
+	DecisionExportBatchSize int
+	// DecisionExportInterval is the max time a decision is buffered before it's written. Defaults to 10 seconds.
+	DecisionExportInterval time.Duration
+	// DecisionExportBuffer is the max number of decisions buffered in memory, after which new decisions
+	// are dropped. Defaults to 10000.
+	DecisionExportBuffer int
+	// AllowUsersWithoutAPIKey allows users authenticated without an API key, e.g., with a JWT,
+	// to become customers. Their parent is resolved with UserParentResolver.
+	AllowUsersWithoutAPIKey bool
+	// UserParentResolver resolves the parent of users without an API key when AllowUsersWithoutAPIKey
+	// is set. Defaults to the parent added to the request context with NewUserParentContext.
+	UserParentResolver ParentResolver
+	// UserParentFallback is how users whose parent account doesn't exist become customers, i.e.,
+	// none (fail), placeholder (create a placeholder parent customer), default (attach to
+	// UserParentFallbackKey), or standalone (create without a parent). Defaults to none.
+	UserParentFallback string
+	// UserParentFallbackKey is the parent of users with a missing parent when UserParentFallback is default.
+	UserParentFallbackKey thread.PubKey
+	// OwnerKeyNormalizer maps owner keys to the form used for billing, so that differently-encoded
+	// keys for the same owner are billed to one customer. Defaults to CanonicalPubKey.
+	OwnerKeyNormalizer KeyNormalizer
+	// OwnerResolver resolves the owner billed for a request, e.g., for project-scoped billing.
+	// Requests are billed to the account owner by default.
+	OwnerResolver OwnerResolver
 }
 
 func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, error) {
@@ -220,6 +662,91 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 	t := &Textile{
 		conf:               conf,
 		internalHubSession: util.MakeToken(32),
+		drainer:            newDrainer(),
+		clock:              args.Clock,
+		inflight:           newInflightEgress(),
+		requests:           newInflightRequests(),
+	}
+	if t.clock == nil {
+		t.clock = realClock{}
+	}
+	if conf.MaxConcurrentStreams > 0 {
+		t.streams = newStreamLimiter(conf.MaxConcurrentStreams)
+	}
+	if args.TracerProvider != nil {
+		t.tracer = args.TracerProvider.Tracer(tracerName)
+	}
+	t.labelOwner = newOwnerLabeler(conf.OwnerLabelSalt)
+	var err error
+	if t.logDenial, err = newDenialLogger(conf.DenialLogLevel); err != nil {
+		return nil, err
+	}
+	if t.listenMode, err = parseListenMetering(conf.ListenMetering); err != nil {
+		return nil, err
+	}
+	if t.listingMode, err = parseListingEgress(conf.ListingEgress); err != nil {
+		return nil, err
+	}
+	methodCheck, err := parseMethodValidation(conf.MethodValidation)
+	if err != nil {
+		return nil, err
+	}
+	if t.deltaCheck, err = parseStorageDeltaCheck(conf.StorageDeltaCheck); err != nil {
+		return nil, err
+	}
+	bucketLimit, err := parseBucketLimitEnforcement(conf.BucketLimitEnforcement)
+	if err != nil {
+		return nil, err
+	}
+	if bucketLimit == bucketLimitReserve {
+		t.pendingBucks = newPendingBuckets()
+	}
+	if t.ownerCheck, err = parseStorageOwnerCheck(conf.StorageOwnerCheck); err != nil {
+		return nil, err
+	}
+	overflow, err := parseUsageOverflow(conf.UsageBacklogOverflow)
+	if err != nil {
+		return nil, err
+	}
+	if overflow == usageOverflowDeadLetter && conf.UsageReplayInterval <= 0 {
+		return nil, fmt.Errorf("usage backlog overflow dead_letter requires usage replay")
+	}
+	if t.orphans, err = parseUserParentFallback(conf.UserParentFallback); err != nil {
+		return nil, err
+	}
+	rounding, err := parseStorageRounding(conf.StorageRounding)
+	if err != nil {
+		return nil, err
+	}
+	if rounding != storageRoundingOff {
+		units := make(map[string]int64)
+		for k, unit := range conf.UsageRoundingUnits {
+			units[k] = unit
+		}
+		if conf.StorageRoundingUnit > 0 {
+			units["stored_data"] = conf.StorageRoundingUnit
+		}
+		t.rounder = newUsageRounder(rounding, units)
+	}
+	if err := conf.UsagePolicy.validate(); err != nil {
+		return nil, err
+	}
+	if err := validateQuotaPriority(conf.QuotaPriority); err != nil {
+		return nil, err
+	}
+	if conf.UsageBurstWindow > 0 && len(conf.UsageBurstLimits) > 0 {
+		t.bursts = newBurstLimiter(conf.UsageBurstWindow, conf.UsageBurstLimits)
+	}
+	if conf.EgressBurstWindow > 0 && conf.EgressBurstLimit > 0 {
+		t.egressBursts = newUsageBurstLimiter(conf.EgressBurstWindow, map[string]int64{
+			"network_egress": conf.EgressBurstLimit,
+		})
+	}
+	if conf.ReadWriteRatio > 0 {
+		t.ratios = newReadWriteRatio(conf.ReadWriteRatio, conf.ReadWriteRatioWindow, conf.ReadWriteRatioMinReads)
+	}
+	if conf.DeferredUploads {
+		t.uploads = newPendingUploads(conf.DeferredUploadTTL)
 	}
 
 	// Configure clients
@@ -242,6 +769,25 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 	if err != nil {
 		return nil, err
 	}
+	if conf.Hub {
+		t.users = t.collections.Accounts
+		if t.pc != nil {
+			t.powUsers = &adminPowUsers{pc: t.pc}
+			if conf.PowergateDeferUserCreation {
+				t.powRetrier = newPowUserRetrier(t.users, t.powUsers, t.clock, conf.PowergateUserRetryInterval)
+				t.powRetrier.start()
+			}
+		}
+	}
+	if conf.AnonymousEgress {
+		t.threadOwner = func(ctx context.Context, id thread.ID) (thread.PubKey, error) {
+			th, err := t.collections.Threads.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return th.Owner, nil
+		}
+	}
 	t.ipnsm, err = ipns.NewManager(t.collections.IPNSKeys, ic.Key(), ic.Name(), conf.Debug)
 	if err != nil {
 		return nil, err
@@ -320,11 +866,83 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 	}
 
 	// Configure a billing client
+	var bc *billing.Client
 	if conf.AddrBillingAPI != "" {
-		t.bc, err = billing.NewClient(conf.AddrBillingAPI, grpc.WithInsecure())
+		bc, err = billing.NewClient(conf.AddrBillingAPI, grpc.WithInsecure())
 		if err != nil {
 			return nil, err
 		}
+		// Usage is reported with the instance labels regardless of how it's sent.
+		var ubc billingClient = bc
+		if len(conf.UsageLabels) > 0 {
+			ubc = newLabeledBillingClient(bc, conf.UsageLabels)
+		}
+		ubc = newPartialUsageBillingClient(ubc)
+		t.bc = ubc
+		if conf.BillingChaos {
+			log.Warn("billing chaos is enabled, which must not be used in production")
+			t.chaos = newChaosBillingClient(t.bc)
+			t.bc = t.chaos
+		}
+		t.watchers = newUsageWatchers()
+		if conf.ClockSkewInterval > 0 {
+			t.skew = newSkewedClock(t.clock, bc, conf.ClockSkewInterval, conf.ClockSkewLogThreshold)
+			t.clock = t.skew
+			t.skew.start()
+		}
+		if conf.CoalesceGetCustomer {
+			t.bc = newCoalescedBillingClient(t.bc)
+		}
+		if t.tracer != nil {
+			t.bc = newTracedBillingClient(t.bc, t.tracer, t.labelOwner)
+		}
+		if conf.UsageReplayInterval > 0 && t.collections.UsageDeadLetters != nil {
+			t.deadLetters = t.collections.UsageDeadLetters
+			t.replayer = newUsageReplayer(
+				t.deadLetters,
+				ubc,
+				t.clock,
+				conf.UsageReplayInterval,
+				conf.UsageDeadLetterMaxAge,
+			)
+			t.replayer.start()
+		}
+		if conf.UsageFlushInterval > 0 {
+			t.usage = newUsageBatcher(ubc, conf.UsageFlushInterval, conf.UsageFlushConcurrency)
+			t.usage.deadLetters = t.deadLetters
+			t.usage.maxPending = conf.UsageBacklogMax
+			t.usage.overflow = overflow
+			t.usage.start()
+		}
+		if len(conf.UsageReportThresholds) > 0 {
+			t.thresholder = newUsageThresholder(conf.UsageReportThresholds, conf.UsageReportTTL, t.now, t.reportHeldUsage)
+			t.thresholder.start()
+		}
+		if conf.QuotaHeadroomSampleInterval > 0 {
+			t.headroom = newQuotaHeadroom()
+		}
+		if conf.DecisionExport != "" {
+			backend, err := newDecisionBackend(conf.DecisionExport)
+			if err != nil {
+				return nil, err
+			}
+			t.decisionExp = newDecisionExporter(
+				backend,
+				conf.DecisionExportBatchSize,
+				conf.DecisionExportInterval,
+				conf.DecisionExportBuffer,
+			)
+			t.decisionExp.start()
+		}
+		if conf.EgressWarnThenBlock {
+			t.warnings = newEgressWarnings()
+		}
+		if conf.SubscriptionGracePeriod > 0 {
+			t.lapses = newLapsedSubscriptions()
+		}
+		if conf.EgressThrottleThreshold > 0 && conf.EgressThrottleRate > 0 {
+			t.throttle = newEgressThrottle(conf.EgressThrottleThreshold, conf.EgressThrottleRate)
+		}
 	}
 
 	jobFinalizedEvents := make(chan archive.JobEvent)
@@ -356,6 +974,7 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			email.Config{
 				ConfirmTmpl: conf.CustomerioConfirmTmpl,
 				InviteTmpl:  conf.CustomerioInviteTmpl,
+				SoftCapTmpl: conf.CustomerioSoftCapTmpl,
 				APIKey:      conf.CustomerioAPIKey,
 				Debug:       conf.Debug,
 			},
@@ -364,6 +983,27 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			return nil, err
 		}
 
+		if conf.DecisionCacheTTL > 0 {
+			t.decisions = newDecisionCache(conf.DecisionCacheTTL)
+		}
+		if conf.CustomerCreationRate > 0 {
+			t.newCustomers = newBurstLimiter(time.Minute, map[string]int{"customers": conf.CustomerCreationRate})
+		}
+		if conf.CustomerCreationWait > 0 {
+			t.creations = newCustomerCreations()
+		}
+		if conf.RequestDedupTTL > 0 {
+			t.dedup = newRequestDedup(conf.RequestDedupTTL)
+			t.dedup.start(t.clock)
+		}
+		if conf.GlobalRequestLimit > 0 {
+			t.shedder = newLoadShedder(conf.GlobalRequestLimit, conf.LowPriorityShare)
+		}
+		if conf.StorageSoftCap > 0 {
+			t.softCaps = newStorageSoftCaps()
+			t.notifier = cio
+		}
+
 		t.emailSessionBus = broadcast.NewBroadcaster(0)
 		hs = &hubd.Service{
 			Collections:         t.collections,
@@ -375,20 +1015,35 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			EmailSessionSecret:  conf.EmailSessionSecret,
 			IPFSClient:          ic,
 			IPNSManager:         t.ipnsm,
-			BillingClient:       t.bc,
+			BillingClient:       bc,
 			PowergateClient:     t.pc,
 			PowergateAdminToken: conf.PowergateAdminToken,
+			AdminToken:          conf.AdminToken,
+			AdminAuthorizer:     conf.AdminAuthorizer,
+			StorageRecalculator: t,
+			UsageQuerier:        t,
+			BillingSelfTester:   t,
+			ChaosController:     t,
+			CacheInvalidator:    t,
 		}
 		us = &usersd.Service{
 			Collections:     t.collections,
 			Mail:            t.mail,
-			BillingClient:   t.bc,
+			BillingClient:   bc,
 			FilRetrieval:    t.filRetrieval,
 			PowergateClient: t.pc,
+			AccessChecker:   t,
+			LimitReporter:   t,
+			UsageWatcher:    t,
 		}
 	}
 
 	t.buckLocks = nutil.NewSemaphorePool(1)
+	reservationTTL := conf.StorageReservationTTL
+	if reservationTTL == 0 {
+		reservationTTL = defaultStorageReservationTTL
+	}
+	t.reservations = buckets.NewStorageReservations(reservationTTL)
 	bs := &bucketsd.Service{
 		Collections:               t.collections,
 		Buckets:                   t.bucks,
@@ -402,11 +1057,36 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 		Semaphores:                t.buckLocks,
 		MaxBucketArchiveRepFactor: conf.MaxBucketArchiveRepFactor,
 		FilRetrieval:              t.filRetrieval,
+		StorageReservations:       t.reservations,
+		StorageExcludedBuckets:    make(map[string]struct{}),
+	}
+	for _, key := range conf.StorageExcludedBuckets {
+		bs.StorageExcludedBuckets[key] = struct{}{}
 	}
 
 	// We can avoid the chicken-egg-problem of below line in the future.
 	// For more info, see "TODO(**)" in buckd/service.go
 	t.filRetrieval.SetBucketCreator(bs)
+	if conf.Hub && conf.DefaultBucketName != "" {
+		t.provisioner = &threadBucketProvisioner{
+			collections: t.collections,
+			threads:     t.th,
+			buckets:     t.bucks,
+			creator:     bs,
+			session:     t.internalHubSession,
+			name:        conf.DefaultBucketName,
+		}
+	}
+	if conf.Hub {
+		counter := &threadStorageCounter{
+			collections: t.collections,
+			threads:     t.th,
+			ipfs:        ic,
+			session:     t.internalHubSession,
+		}
+		t.storage = counter
+		t.bucketCount = counter
+	}
 	t.filRetrieval.RunDaemon()
 
 	// Start serving
@@ -431,8 +1111,14 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 		}
 		grpcopts = []grpc.ServerOption{
 			grpcm.WithUnaryServerChain(
+				drainUnaryServerInterceptor(t.drainer),
+				tracingUnaryServerInterceptor(t.tracer),
 				auth.UnaryServerInterceptor(t.authFunc),
+				t.dedupUnaryServerInterceptor(),
+				// Metrics wrap the usage interceptor so that requests it denies are recorded.
+				metricsUnaryServerInterceptor(t.isKnownMethod, t.clock),
 				unaryServerInterceptor(t.preUsageFunc, t.postUsageFunc),
+				inflightUnaryServerInterceptor(t.requests, t.normalizeKey),
 				t.threadInterceptor(),
 				powInterceptor(
 					powergateServiceName,
@@ -440,36 +1126,68 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 					powergateServiceDesc,
 					powStub,
 					t.pc,
-					conf.PowergateAdminToken,
+					t.powAdminToken,
 					t.collections,
 				),
 			),
 			grpcm.WithStreamServerChain(
+				drainStreamServerInterceptor(t.drainer),
+				streamLimitStreamServerInterceptor(t.streams),
+				tracingStreamServerInterceptor(t.tracer),
 				auth.StreamServerInterceptor(t.authFunc),
+				t.dedupStreamServerInterceptor(),
+				metricsStreamServerInterceptor(t.isKnownMethod, t.clock),
 				streamServerInterceptor(t.preUsageFunc, t.postUsageFunc),
+				inflightStreamServerInterceptor(t.requests, t.normalizeKey),
 			),
 			grpc.StatsHandler(&StatsHandler{t: t}),
 		}
 	} else {
 		grpcopts = []grpc.ServerOption{
-			grpcm.WithUnaryServerChain(auth.UnaryServerInterceptor(t.noAuthFunc)),
-			grpcm.WithStreamServerChain(auth.StreamServerInterceptor(t.noAuthFunc)),
+			grpcm.WithUnaryServerChain(
+				drainUnaryServerInterceptor(t.drainer),
+				auth.UnaryServerInterceptor(t.noAuthFunc),
+			),
+			grpcm.WithStreamServerChain(
+				drainStreamServerInterceptor(t.drainer),
+				streamLimitStreamServerInterceptor(t.streams),
+				auth.StreamServerInterceptor(t.noAuthFunc),
+			),
 		}
 	}
 	t.server = grpc.NewServer(grpcopts...)
+	dbpb.RegisterAPIServer(t.server, ts)
+	netpb.RegisterAPIServer(t.server, ns)
+	if conf.Hub {
+		hpb.RegisterAPIServiceServer(t.server, hs)
+		upb.RegisterAPIServiceServer(t.server, us)
+		userPb.RegisterUserServiceServer(t.server, &userPb.UnimplementedUserServiceServer{})
+	}
+	bpb.RegisterAPIServiceServer(t.server, bs)
+	t.setKnownMethods()
+	if conf.Hub {
+		if err := t.validateMethods(methodCheck); err != nil {
+			return nil, err
+		}
+		if err := view.Register(metricViews...); err != nil {
+			return nil, err
+		}
+		if conf.BusyOwnerSampleInterval > 0 || t.headroom != nil {
+			var ctx context.Context
+			ctx, t.stopSampler = context.WithCancel(context.Background())
+			if conf.BusyOwnerSampleInterval > 0 {
+				go t.requests.sampleBusiest(ctx, conf.BusyOwnerSampleInterval, conf.BusyOwnerSampleSize, t.labelOwner)
+			}
+			if t.headroom != nil {
+				go t.sampleQuotaHeadroom(ctx, conf.QuotaHeadroomSampleInterval, conf.QuotaHeadroomSampleSize)
+			}
+		}
+	}
 	listener, err := net.Listen("tcp", target)
 	if err != nil {
 		return nil, err
 	}
 	go func() {
-		dbpb.RegisterAPIServer(t.server, ts)
-		netpb.RegisterAPIServer(t.server, ns)
-		if conf.Hub {
-			hpb.RegisterAPIServiceServer(t.server, hs)
-			upb.RegisterAPIServiceServer(t.server, us)
-			userPb.RegisterUserServiceServer(t.server, &userPb.UnimplementedUserServiceServer{})
-		}
-		bpb.RegisterAPIServiceServer(t.server, bs)
 		if err := t.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
 			log.Fatalf("serve error: %v", err)
 		}
@@ -578,6 +1296,33 @@ func (t *Textile) Close() error {
 	if err := t.th.Close(); err != nil {
 		return err
 	}
+	if t.thresholder != nil {
+		t.thresholder.close()
+		log.Info("held usage was reported")
+	}
+	if t.usage != nil {
+		t.usage.close()
+		log.Info("usage was flushed")
+	}
+	if t.replayer != nil {
+		t.replayer.close()
+	}
+	if t.decisionExp != nil {
+		t.decisionExp.close()
+		log.Info("decisions were exported")
+	}
+	if t.skew != nil {
+		t.skew.close()
+	}
+	if t.dedup != nil {
+		t.dedup.close()
+	}
+	if t.powRetrier != nil {
+		t.powRetrier.close()
+	}
+	if t.stopSampler != nil {
+		t.stopSampler()
+	}
 	if t.bc != nil {
 		if err := t.bc.Close(); err != nil {
 			return err
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"context"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"golang.org/x/sync/singleflight"
+)
+
+// coalescedBillingClient shares a single GetCustomer call to billingd among concurrent
+// requests for the same owner. Customers are treated as read-only, so all callers
+// receive the same response.
+type coalescedBillingClient struct {
+	billingClient
+	group singleflight.Group
+}
+
+func newCoalescedBillingClient(bc billingClient) billingClient {
+	return &coalescedBillingClient{billingClient: bc}
+}
+
+func (c *coalescedBillingClient) GetCustomer(ctx context.Context, key thread.PubKey) (*pb.GetCustomerResponse, error) {
+	ch := c.group.DoChan(key.String(), func() (interface{}, error) {
+		return c.billingClient.GetCustomer(ctx, key)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*pb.GetCustomerResponse), nil
+	}
+}
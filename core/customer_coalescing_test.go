@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+func TestPreUsageFunc_CoalesceGetCustomer(t *testing.T) {
+	bc := &gatedBillingClient{testBillingClient: newTestBillingClient(), release: make(chan struct{})}
+	tx := &Textile{bc: newCoalescedBillingClient(bc)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+			errs <- err
+		}()
+	}
+	// Give all requests a chance to join the call in flight before billingd responds.
+	time.Sleep(100 * time.Millisecond)
+	close(bc.release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(&bc.calls))
+
+	// Results are not cached once the call completes.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&bc.calls))
+}
+
+func TestCoalescedBillingClient_ContextCanceled(t *testing.T) {
+	bc := &gatedBillingClient{testBillingClient: newTestBillingClient(), release: make(chan struct{})}
+	defer close(bc.release)
+	c := newCoalescedBillingClient(bc)
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// A waiting caller gives up when its own context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := c.GetCustomer(ctx, dev.Key)
+	require.Error(t, err)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+// gatedBillingClient counts GetCustomer calls, which block until release is closed.
+type gatedBillingClient struct {
+	*testBillingClient
+	release chan struct{}
+	calls   int64
+}
+
+func (c *gatedBillingClient) GetCustomer(ctx context.Context, key thread.PubKey) (*pb.GetCustomerResponse, error) {
+	atomic.AddInt64(&c.calls, 1)
+	<-c.release
+	return c.testBillingClient.GetCustomer(ctx, key)
+}
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/common"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// customerWaitBackoff is the initial delay between attempts to get a customer that's being created.
+	customerWaitBackoff = 25 * time.Millisecond
+
+	// retryAfterHeader is the response header that tells clients how many seconds to wait before retrying.
+	retryAfterHeader = "retry-after"
+)
+
+// errCustomerCreationTimeout is returned when a customer being created by another request
+// doesn't appear within Config.CustomerCreationWait.
+var errCustomerCreationTimeout = status.Error(codes.Unavailable, "customer is being created, please try again")
+
+// customerCreations tracks the customers being created by this instance so that concurrent
+// first requests from an owner wait for the creation instead of racing it.
+type customerCreations struct {
+	lk     sync.Mutex
+	owners map[string]chan struct{}
+}
+
+func newCustomerCreations() *customerCreations {
+	return &customerCreations{owners: make(map[string]chan struct{})}
+}
+
+// claim registers a creation for owner and returns a func that must be called when it's done.
+// If another request is already creating the customer, its done channel is returned instead.
+func (c *customerCreations) claim(owner string) (func(), <-chan struct{}) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if done, ok := c.owners[owner]; ok {
+		return nil, done
+	}
+	done := make(chan struct{})
+	c.owners[owner] = done
+	return func() {
+		c.lk.Lock()
+		defer c.lk.Unlock()
+		delete(c.owners, owner)
+		close(done)
+	}, nil
+}
+
+func isCustomerNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), mongo.ErrNoDocuments.Error())
+}
+
+func isCustomerExists(err error) bool {
+	return err != nil && strings.Contains(err.Error(), common.ErrCustomerExists.Error())
+}
+
+// newCustomer creates and returns the customer for an owner that doesn't have one yet.
+// If Config.CustomerCreationWait is set, a request that races another request creating
+// the same customer waits for it instead of failing.
+func (t *Textile) newCustomer(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	ownerKey thread.PubKey,
+) (*pb.GetCustomerResponse, error) {
+	if t.creations == nil {
+		return t.createOwnerCustomer(ctx, method, account, ownerKey)
+	}
+	release, creating := t.creations.claim(ownerKey.String())
+	if creating != nil {
+		return t.awaitCustomer(ctx, ownerKey, creating)
+	}
+	defer release()
+	cus, err := t.createOwnerCustomer(ctx, method, account, ownerKey)
+	if isCustomerExists(err) {
+		// The customer was created by another instance.
+		return t.awaitCustomer(ctx, ownerKey, nil)
+	}
+	return cus, err
+}
+
+// createOwnerCustomer creates and returns the customer for an owner.
+func (t *Textile) createOwnerCustomer(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	ownerKey thread.PubKey,
+) (*pb.GetCustomerResponse, error) {
+	if err := t.checkCreationRate(ctx, method, account); err != nil {
+		return nil, err
+	}
+	email, err := t.getAccountCtxEmail(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	owner := account.Owner()
+	if typ := t.ownerType(ctx, account); !ownerKey.Equals(t.normalizeKey(owner.Key)) || typ != owner.Type {
+		// The customer is for an owner resolved by Config.OwnerResolver, which has no account.
+		owner = &mdb.Account{Key: ownerKey, Type: typ}
+	}
+	var opts []billing.Option
+	if owner.Type == mdb.User {
+		parentKey, ok := t.userParentKey(ctx)
+		if !ok {
+			return nil, t.deny(ctx, method, account, denialPermission,
+				status.Error(codes.PermissionDenied, "Bad API key"))
+		}
+		parent, email, err := t.userParent(ctx, ownerKey, parentKey)
+		if err != nil {
+			return nil, err
+		}
+		if parent != nil {
+			opts = append(opts, billing.WithParent(t.normalizeKey(parent.Key), email, parent.Type))
+		}
+	}
+	if err := t.createCustomer(ctx, ownerKey, email, owner, opts...); err != nil {
+		return nil, err
+	}
+	return t.bc.GetCustomer(ctx, ownerKey)
+}
+
+// checkCreationRate returns an error if creating another customer would exceed Config.CustomerCreationRate,
+// e.g., because of signup abuse. The client is told when to retry with the retry-after header.
+func (t *Textile) checkCreationRate(ctx context.Context, method string, account *mdb.AccountCtx) error {
+	if t.newCustomers == nil {
+		return nil
+	}
+	now := t.now()
+	ok, reset := t.newCustomers.allow("", "customers", now)
+	if ok {
+		return nil
+	}
+	wait := int64(math.Ceil(reset.Sub(now).Seconds()))
+	if wait < 1 {
+		wait = 1
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.FormatInt(wait, 10))); err != nil {
+		log.Debugf("setting retry-after header: %v", err)
+	}
+	return t.deny(ctx, method, account, denialSignup,
+		status.Errorf(codes.Unavailable, "too many new customers, retry after %d seconds", wait))
+}
+
+// awaitCustomer waits up to Config.CustomerCreationWait for a customer being created by another
+// request, i.e., for creating to be closed, and then for the customer to be found, backing off
+// between attempts.
+func (t *Textile) awaitCustomer(
+	ctx context.Context,
+	ownerKey thread.PubKey,
+	creating <-chan struct{},
+) (*pb.GetCustomerResponse, error) {
+	timer := time.NewTimer(t.conf.CustomerCreationWait)
+	defer timer.Stop()
+	if creating != nil {
+		select {
+		case <-creating:
+		case <-timer.C:
+			return nil, errCustomerCreationTimeout
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	backoff := customerWaitBackoff
+	for {
+		cus, err := t.bc.GetCustomer(ctx, ownerKey)
+		if !isCustomerNotFound(err) {
+			return cus, err
+		}
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-timer.C:
+			return nil, errCustomerCreationTimeout
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
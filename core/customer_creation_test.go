@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/common"
+	"github.com/textileio/textile/v2/buckets"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// slowCreateBillingClient blocks customer creation until it's released.
+type slowCreateBillingClient struct {
+	*testBillingClient
+	entered chan struct{}
+	release chan struct{}
+	creates int32
+}
+
+func (c *slowCreateBillingClient) CreateCustomer(
+	ctx context.Context,
+	key thread.PubKey,
+	email string,
+	username string,
+	accountType mdb.AccountType,
+	opts ...billing.Option,
+) (string, error) {
+	if atomic.AddInt32(&c.creates, 1) == 1 {
+		close(c.entered)
+	}
+	<-c.release
+	return c.testBillingClient.CreateCustomer(ctx, key, email, username, accountType, opts...)
+}
+
+func TestPreUsageFunc_AwaitsCustomerCreation(t *testing.T) {
+	bc := &slowCreateBillingClient{
+		testBillingClient: newTestBillingClient(),
+		entered:           make(chan struct{}),
+		release:           make(chan struct{}),
+	}
+	tx := &Textile{bc: bc, creations: newCustomerCreations(), conf: Config{CustomerCreationWait: 5 * time.Second}}
+	dev := newTestDev(t)
+
+	first := make(chan error)
+	go func() {
+		_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+		first <- err
+	}()
+	<-bc.entered
+
+	// The second request waits for the first to create the customer.
+	second := make(chan error)
+	go func() {
+		_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+		second <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(bc.release)
+
+	require.NoError(t, <-first)
+	require.NoError(t, <-second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&bc.creates))
+	assert.Len(t, bc.customers, 1)
+}
+
+// racedBillingClient behaves as if another instance created the customer first.
+type racedBillingClient struct {
+	*testBillingClient
+}
+
+func (c *racedBillingClient) CreateCustomer(
+	_ context.Context,
+	key thread.PubKey,
+	_ string,
+	_ string,
+	_ mdb.AccountType,
+	_ ...billing.Option,
+) (string, error) {
+	c.setCustomer(newTestCustomer(key))
+	return "", status.Error(codes.Unknown, common.ErrCustomerExists.Error())
+}
+
+func TestPreUsageFunc_AwaitsCustomerCreatedElsewhere(t *testing.T) {
+	bc := &racedBillingClient{testBillingClient: newTestBillingClient()}
+	dev := newTestDev(t)
+
+	// Without a wait, the race fails the request.
+	tx := &Textile{bc: bc}
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+
+	// With a wait, the customer created elsewhere is used.
+	delete(bc.customers, dev.Key.String())
+	tx = &Textile{bc: bc, creations: newCustomerCreations(), conf: Config{CustomerCreationWait: 5 * time.Second}}
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+}
+
+func TestAwaitCustomer_Timeout(t *testing.T) {
+	tx := &Textile{bc: newTestBillingClient(), conf: Config{CustomerCreationWait: 100 * time.Millisecond}}
+	dev := newTestDev(t)
+
+	// The wait is bounded.
+	_, err := tx.awaitCustomer(context.Background(), dev.Key, make(chan struct{}))
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = tx.awaitCustomer(context.Background(), dev.Key, nil)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestPreUsageFunc_CustomerCreationRate(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock, newCustomers: newBurstLimiter(time.Minute, map[string]int{"customers": 3})}
+
+	existing := newTestDev(t)
+	bc.setCustomer(newTestCustomer(existing.Key))
+
+	// New owners are created up to the rate.
+	for i := 0; i < 3; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(newTestDev(t)), findMethod)
+		require.NoError(t, err)
+	}
+
+	// Further new owners are told to retry later.
+	clock.advance(20 * time.Second)
+	ts := &testTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(newTestAccountContext(newTestDev(t)), ts)
+	_, err := tx.preUsageFunc(ctx, findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, []string{"40"}, ts.header.Get(retryAfterHeader))
+	assert.Len(t, bc.customers, 4)
+
+	// Existing customers are unaffected.
+	_, err = tx.preUsageFunc(newTestAccountContext(existing), findMethod)
+	require.NoError(t, err)
+
+	// New owners are created again once the window slides.
+	clock.advance(time.Minute)
+	_, err = tx.preUsageFunc(newTestAccountContext(newTestDev(t)), findMethod)
+	require.NoError(t, err)
+	assert.Len(t, bc.customers, 5)
+}
+
+func TestPreUsageFunc_NewUserFirstWrite(t *testing.T) {
+	bc := newTestBillingClient()
+	users := newTestUserStore()
+	tx := &Textile{bc: bc, users: users}
+	parent := newTestDev(t)
+	users.users[parent.Key.String()] = parent
+
+	// A brand-new user is collected by their first write.
+	user := newTestDev(t)
+	user.Type = mdb.User
+	user.CreatedAt = time.Time{}
+	ctx := mdb.NewAPIKeyContext(newTestAccountContext(user), &mdb.APIKey{Owner: parent.Key, Type: mdb.UserKey})
+	ctx, err := tx.preUsageFunc(ctx, pushPathMethod)
+	require.NoError(t, err)
+
+	// The write is gated against the new customer's full allowance and billed.
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(0), owner.StorageUsed)
+	assert.Equal(t, int64(5*gib), owner.StorageAvailable)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, user.Key.String(), incs[0].key)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/common"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isInvalidEmail returns whether or not err indicates billingd rejected a customer's email.
+func isInvalidEmail(err error) bool {
+	return status.Code(err) == codes.InvalidArgument && strings.Contains(err.Error(), common.ErrInvalidEmail.Error())
+}
+
+// invalidEmailError returns a client-facing error for an email rejected by billingd.
+func invalidEmailError(email string) error {
+	return status.Error(codes.InvalidArgument,
+		fmt.Sprintf("the account email %q was rejected by the billing provider, please update it and try again", email))
+}
+
+// sanitizeEmail removes characters commonly pasted around an email address.
+func sanitizeEmail(email string) string {
+	return strings.Trim(strings.TrimSpace(email), "<>\"'")
+}
+
+// createCustomer creates a billing customer for an owner. If billingd rejects the owner's email,
+// the customer is created once more with a sanitized email, or Config.PlaceholderEmail if
+// sanitizing doesn't change it. An InvalidArgument error is returned if no placeholder is configured
+// or the retry is also rejected.
+func (t *Textile) createCustomer(
+	ctx context.Context,
+	ownerKey thread.PubKey,
+	email string,
+	owner *mdb.Account,
+	opts ...billing.Option,
+) error {
+	_, err := t.bc.CreateCustomer(ctx, ownerKey, email, owner.Username, owner.Type, opts...)
+	if !isInvalidEmail(err) {
+		return err
+	}
+	if t.conf.PlaceholderEmail == "" {
+		return invalidEmailError(email)
+	}
+	fallback := sanitizeEmail(email)
+	if fallback == email {
+		fallback = t.conf.PlaceholderEmail
+	}
+	log.Warnf("email %q for %s was rejected, creating customer with %q", email, ownerKey, fallback)
+	if _, err := t.bc.CreateCustomer(ctx, ownerKey, fallback, owner.Username, owner.Type, opts...); err != nil {
+		if isInvalidEmail(err) {
+			return invalidEmailError(email)
+		}
+		return err
+	}
+	return nil
+}
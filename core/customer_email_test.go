@@ -0,0 +1,67 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/common"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_InvalidEmail(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       string
+		placeholder string
+		created     string
+	}{
+		{name: "rejected", email: "dev@@textile"},
+		{name: "sanitized", email: " <dev@textile.io> ", placeholder: "billing@textile.io", created: "dev@textile.io"},
+		{name: "placeholder", email: "dev@@textile", placeholder: "billing@textile.io", created: "billing@textile.io"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := &emailValidatingBillingClient{testBillingClient: newTestBillingClient()}
+			tx := &Textile{bc: bc, conf: Config{PlaceholderEmail: tc.placeholder}}
+
+			dev := newTestDev(t)
+			dev.Email = tc.email
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+			if tc.created == "" {
+				require.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Contains(t, err.Error(), "email")
+				return
+			}
+			require.NoError(t, err)
+			cus, err := bc.GetCustomer(context.Background(), dev.Key)
+			require.NoError(t, err)
+			assert.Equal(t, tc.created, cus.Email)
+		})
+	}
+}
+
+// emailValidatingBillingClient rejects emails like billingd does when they're rejected by the payment provider.
+type emailValidatingBillingClient struct {
+	*testBillingClient
+}
+
+func (c *emailValidatingBillingClient) CreateCustomer(
+	ctx context.Context,
+	key thread.PubKey,
+	email string,
+	username string,
+	accountType mdb.AccountType,
+	opts ...billing.Option,
+) (string, error) {
+	if email != "dev@textile.io" && email != "billing@textile.io" {
+		return "", status.Errorf(codes.InvalidArgument, "%v: %s", common.ErrInvalidEmail, email)
+	}
+	return c.testBillingClient.CreateCustomer(ctx, key, email, username, accountType, opts...)
+}
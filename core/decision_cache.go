@@ -0,0 +1,201 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// accessDecision is the outcome of a usage check that can be reused for identical requests.
+// headroom is how much more of each key an allowed owner can use before the outcome may change,
+// which is nil if their usage can't exhaust a quota.
+type accessDecision struct {
+	err      error
+	billable bool
+	headroom map[string]int64
+	expires  time.Time
+}
+
+// decisionCache memoizes usage check outcomes per owner and method for a short window.
+// An owner's decisions are invalidated when usage recorded for them may change the outcome.
+type decisionCache struct {
+	ttl time.Duration
+
+	lk     sync.Mutex
+	owners map[string]map[string]accessDecision
+}
+
+func newDecisionCache(ttl time.Duration) *decisionCache {
+	return &decisionCache{
+		ttl:    ttl,
+		owners: make(map[string]map[string]accessDecision),
+	}
+}
+
+// get returns the unexpired decision for owner and method.
+func (c *decisionCache) get(owner, method string, now time.Time) (accessDecision, bool) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	d, ok := c.owners[owner][method]
+	if !ok || !now.Before(d.expires) {
+		return accessDecision{}, false
+	}
+	return d, true
+}
+
+// put records a decision for owner and method made at now.
+func (c *decisionCache) put(owner, method string, d accessDecision, now time.Time) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	methods, ok := c.owners[owner]
+	if !ok {
+		methods = make(map[string]accessDecision)
+		c.owners[owner] = methods
+	}
+	d.expires = now.Add(c.ttl)
+	methods[method] = d
+}
+
+// consume applies usage recorded for owner to their decisions. Decisions that the usage may change
+// are dropped, i.e., allows whose headroom for a key is used up, and any decision if usage decreases.
+func (c *decisionCache) consume(owner string, usage map[string]int64) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	methods, ok := c.owners[owner]
+	if !ok {
+		return
+	}
+	for m, d := range methods {
+		for k, v := range usage {
+			if v < 0 {
+				delete(methods, m)
+				break
+			}
+			h, ok := d.headroom[k]
+			if !ok || d.err != nil {
+				continue
+			}
+			if h -= v; h <= 0 {
+				delete(methods, m)
+				break
+			}
+			d.headroom[k] = h
+		}
+	}
+	if len(methods) == 0 {
+		delete(c.owners, owner)
+	}
+}
+
+// invalidate drops all of owner's decisions.
+func (c *decisionCache) invalidate(owner string) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	delete(c.owners, owner)
+}
+
+// isDecisionCacheable returns whether or not the outcome of a usage check for method can be reused.
+// Only methods limited by a quota alone are cacheable, and not when checks that vary per request,
+// e.g., load shedding, are configured. Burst limits are still checked for each request.
+func (t *Textile) isDecisionCacheable(method string, dryRun bool, now time.Time) bool {
+	if t.decisions == nil || dryRun || t.shedder != nil || t.warnings != nil || len(t.conf.EgressTiers) > 0 {
+		return false
+	}
+	if isEgressStreamMethod(method) || t.isPromoted(method, now) {
+		return false
+	}
+	policy, ok := t.methodPolicy(method)
+	return ok && policy.Quota != "" && !policy.Storage && !policy.BucketLimit && !policy.ListenMeter
+}
+
+// cacheDecision records a decision for an owner and method if cacheable is true.
+// err is returned for convenience.
+func (t *Textile) cacheDecision(
+	cacheable bool,
+	ownerKey thread.PubKey,
+	method string,
+	cus *pb.GetCustomerResponse,
+	err error,
+	now time.Time,
+) error {
+	if cacheable {
+		d := accessDecision{err: err, billable: cus.Billable}
+		if err == nil {
+			d.headroom = t.usageHeadroom(cus, now)
+		}
+		t.decisions.put(ownerKey.String(), method, d, now)
+	}
+	return err
+}
+
+// usageHeadroom returns how much more of each key a customer can use before the key is exhausted,
+// see usageExhausted. Nil is returned for billable customers, whose usage doesn't exhaust quotas.
+func (t *Textile) usageHeadroom(cus *pb.GetCustomerResponse, now time.Time) map[string]int64 {
+	if cus.Billable {
+		return nil
+	}
+	headroom := make(map[string]int64)
+	for k, u := range cus.DailyUsage {
+		if u == nil || t.freeUnlimited(cus, k) {
+			continue
+		}
+		h := u.GetFree()
+		if now.Unix() < cus.GracePeriodEnd {
+			h += u.GetGrace()
+		}
+		headroom[k] = h
+	}
+	return headroom
+}
+
+// reuseDecision applies a cached decision to a request.
+func (t *Textile) reuseDecision(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	d accessDecision,
+	now time.Time,
+) (context.Context, error) {
+	if d.err != nil {
+		return ctx, d.err
+	}
+	if len(t.conf.UsagePrices) > 0 {
+		ctx = newUsageCostContext(ctx, d.billable)
+	}
+	policy, _ := t.methodPolicy(method)
+	if policy.Burst {
+		if err := t.checkBurst(ctx, method, account, policy.Quota, now); err != nil {
+			return ctx, err
+		}
+	}
+	if err := t.checkReadWriteRatio(ctx, method, account, policy.Quota, d.billable, now); err != nil {
+		return ctx, err
+	}
+	return ctx, nil
+}
+
+// invalidateDecisions drops an owner's cached decisions, e.g., when their customer changes.
+func (t *Textile) invalidateDecisions(ownerKey thread.PubKey) {
+	if t.decisions != nil {
+		t.decisions.invalidate(ownerKey.String())
+	}
+}
+
+// consumeDecisions applies usage recorded for an owner to their cached decisions.
+func (t *Textile) consumeDecisions(ownerKey thread.PubKey, usage map[string]int64) {
+	if t.decisions != nil {
+		t.decisions.consume(ownerKey.String(), usage)
+	}
+}
+
+// InvalidateCustomerCache drops an owner's cached decisions so that changes made to their customer
+// outside of the hub, e.g., a quota increase, are seen by their next request.
+func (t *Textile) InvalidateCustomerCache(key thread.PubKey) error {
+	t.invalidateDecisions(key)
+	log.Infof("invalidated cached decisions for %s", key)
+	return nil
+}
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_DecisionCache(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{bc: bc, clock: clock, decisions: newDecisionCache(500 * time.Millisecond)}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// The owner's reads are exhausted, but the cached allow is reused.
+	exhausted := newTestCustomer(dev.Key)
+	exhausted.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(exhausted)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// Decisions are per method.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Has")
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Recording usage that doesn't cross a limit keeps the cached allow.
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"instance_reads": 1}))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// Recording usage that crosses a limit invalidates the owner's decisions.
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"instance_reads": 49999}))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The cached deny is reused until it expires.
+	bc.setCustomer(cus)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	clock.advance(500 * time.Millisecond)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+}
+
+func TestPreUsageFunc_DecisionCacheReusedAfterRead(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{bc: bc, clock: clock, decisions: newDecisionCache(500 * time.Millisecond)}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	// The read is recorded, e.g., by the stats handler.
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"instance_reads": 1}))
+	require.Len(t, bc.getIncs(), 1)
+
+	// The second read within the TTL is served from the cache, even though the first read's
+	// usage was recorded and billingd now reports the owner's reads are exhausted.
+	exhausted := newTestCustomer(dev.Key)
+	exhausted.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(exhausted)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// The decision is made again once the TTL expires.
+	clock.advance(500 * time.Millisecond)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestDecisionCache_Consume(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newDecisionCache(time.Minute)
+	c.put("owner", findMethod, accessDecision{headroom: map[string]int64{"instance_reads": 2}}, now)
+	c.put("owner", saveMethod, accessDecision{err: errors.New("denied")}, now)
+	c.put("billable", findMethod, accessDecision{billable: true}, now)
+
+	// Allows are kept until their headroom is used up.
+	c.consume("owner", map[string]int64{"instance_reads": 1, "instance_writes": 10})
+	_, ok := c.get("owner", findMethod, now)
+	assert.True(t, ok)
+	c.consume("owner", map[string]int64{"instance_reads": 1})
+	_, ok = c.get("owner", findMethod, now)
+	assert.False(t, ok)
+
+	// Usage increases don't change a deny, but decreases may.
+	_, ok = c.get("owner", saveMethod, now)
+	assert.True(t, ok)
+	c.consume("owner", map[string]int64{"stored_data": -1})
+	_, ok = c.get("owner", saveMethod, now)
+	assert.False(t, ok)
+
+	// Billable owners' usage doesn't exhaust quotas.
+	c.consume("billable", map[string]int64{"instance_reads": 1000000})
+	_, ok = c.get("billable", findMethod, now)
+	assert.True(t, ok)
+}
+
+func TestPreUsageFunc_DecisionCacheBurst(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{
+		bc:        bc,
+		clock:     clock,
+		decisions: newDecisionCache(time.Second),
+		bursts:    newBurstLimiter(time.Minute, map[string]int{"instance_reads": 2}),
+	}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Burst limits are still enforced for cached allows.
+	for i := 0; i < 2; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+		require.NoError(t, err)
+	}
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "burst")
+}
+
+func TestTextile_InvalidateCustomerCache(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{bc: bc, clock: clock, decisions: newDecisionCache(time.Minute)}
+
+	dev := newTestDev(t)
+	exhausted := newTestCustomer(dev.Key)
+	exhausted.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(exhausted)
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The owner's quota is raised, but the cached deny is reused.
+	bc.setCustomer(newTestCustomer(dev.Key))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+
+	// Invalidating the cache applies the new quota to the next request.
+	require.NoError(t, tx.InvalidateCustomerCache(dev.Key))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// Invalidating is a no-op without a decision cache.
+	require.NoError(t, (&Textile{}).InvalidateCustomerCache(dev.Key))
+}
@@ -0,0 +1,317 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.opencensus.io/stats"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultDecisionExportBatchSize is the default number of decisions sent to the backend at once.
+	defaultDecisionExportBatchSize = 100
+
+	// defaultDecisionExportInterval is the default max time a decision is buffered before it's sent.
+	defaultDecisionExportInterval = time.Second * 10
+
+	// defaultDecisionExportBuffer is the default max number of decisions buffered in memory.
+	defaultDecisionExportBuffer = 10000
+
+	// decisionExportTimeout bounds sending a batch of decisions to the backend.
+	decisionExportTimeout = time.Second * 10
+)
+
+var mDecisionsDropped = stats.Int64(
+	"hub/usage/decisions_dropped",
+	"Number of interceptor decisions dropped by the decision exporter",
+	stats.UnitDimensionless,
+)
+
+// decisionEvent is an interceptor decision written to the decision export, e.g., for offline
+// abuse detection.
+type decisionEvent struct {
+	Time      time.Time `json:"time"`
+	Method    string    `json:"method"`
+	OwnerType string    `json:"owner_type"`
+	Allowed   bool      `json:"allowed"`
+	// Reason is why a request was denied, e.g., quota.
+	Reason string `json:"reason,omitempty"`
+	// Code is the status code the request was denied with or that its handler returned.
+	Code string `json:"code"`
+	// Usage is the usage recorded by the usage interceptor for an allowed request.
+	// threaddb reads and writes are metered by the stats handler and aren't included.
+	Usage map[string]int64 `json:"usage,omitempty"`
+	// LatencyMs is how long it took to deny a request, or to handle an allowed request.
+	LatencyMs float64 `json:"latency_ms"`
+}
+
+// decisionBackend is where exported decisions are written.
+type decisionBackend interface {
+	write(ctx context.Context, events []decisionEvent) error
+}
+
+// newDecisionBackend returns the backend for target, i.e., a file:// URL that decisions are appended to
+// as JSON lines, or an http(s):// webhook that batches of decisions are posted to as JSON arrays.
+func newDecisionBackend(target string) (decisionBackend, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("parsing decision export target: %v", err)
+	}
+	switch u.Scheme {
+	case "file":
+		return &fileDecisionBackend{path: u.Path}, nil
+	case "http", "https":
+		return &webhookDecisionBackend{url: target, client: &http.Client{Timeout: decisionExportTimeout}}, nil
+	default:
+		return nil, fmt.Errorf("invalid decision export target: %s", target)
+	}
+}
+
+// fileDecisionBackend appends decisions to a file as JSON lines.
+type fileDecisionBackend struct {
+	path string
+}
+
+func (b *fileDecisionBackend) write(_ context.Context, events []decisionEvent) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(b.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// webhookDecisionBackend posts batches of decisions to a URL as JSON arrays.
+type webhookDecisionBackend struct {
+	url    string
+	client *http.Client
+}
+
+func (b *webhookDecisionBackend) write(ctx context.Context, events []decisionEvent) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("decision webhook responded with %s", res.Status)
+	}
+	return nil
+}
+
+// decisionExporter buffers interceptor decisions and writes them to a backend in batches,
+// separately from denial logging. Decisions are dropped when the buffer is full or a batch
+// fails to be written, which is recorded by the decisions dropped metric.
+type decisionExporter struct {
+	backend   decisionBackend
+	batchSize int
+	interval  time.Duration
+	maxBuffer int
+
+	lk     sync.Mutex
+	buffer []decisionEvent
+
+	ready  chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newDecisionExporter(backend decisionBackend, batchSize int, interval time.Duration, maxBuffer int) *decisionExporter {
+	if batchSize <= 0 {
+		batchSize = defaultDecisionExportBatchSize
+	}
+	if interval <= 0 {
+		interval = defaultDecisionExportInterval
+	}
+	if maxBuffer <= 0 {
+		maxBuffer = defaultDecisionExportBuffer
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &decisionExporter{
+		backend:   backend,
+		batchSize: batchSize,
+		interval:  interval,
+		maxBuffer: maxBuffer,
+		ready:     make(chan struct{}, 1),
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+// start writes buffered decisions on the exporter's interval, or as soon as a batch is ready.
+func (e *decisionExporter) start() {
+	go func() {
+		defer close(e.done)
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-ticker.C:
+				e.flush(e.ctx)
+			case <-e.ready:
+				e.flush(e.ctx)
+			}
+		}
+	}()
+}
+
+// add buffers a decision, which is dropped if the buffer is full.
+func (e *decisionExporter) add(event decisionEvent) {
+	e.lk.Lock()
+	if len(e.buffer) >= e.maxBuffer {
+		e.lk.Unlock()
+		stats.Record(context.Background(), mDecisionsDropped.M(1))
+		return
+	}
+	e.buffer = append(e.buffer, event)
+	ready := len(e.buffer) >= e.batchSize
+	e.lk.Unlock()
+	if ready {
+		select {
+		case e.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flush writes all buffered decisions in batches. Batches that fail to be written are dropped.
+func (e *decisionExporter) flush(ctx context.Context) {
+	e.lk.Lock()
+	events := e.buffer
+	e.buffer = nil
+	e.lk.Unlock()
+	for len(events) > 0 {
+		n := e.batchSize
+		if n > len(events) {
+			n = len(events)
+		}
+		wctx, cancel := context.WithTimeout(ctx, decisionExportTimeout)
+		err := e.backend.write(wctx, events[:n])
+		cancel()
+		if err != nil {
+			log.Errorf("exporting %d decisions: %v", n, err)
+			stats.Record(context.Background(), mDecisionsDropped.M(int64(n)))
+		}
+		events = events[n:]
+	}
+}
+
+// close stops the exporter and writes any buffered decisions.
+func (e *decisionExporter) close() {
+	e.cancel()
+	<-e.done
+	e.flush(context.Background())
+}
+
+// decisionRecord tracks an allowed request so that its decision is exported once it's handled.
+type decisionRecord struct {
+	event    decisionEvent
+	start    time.Time
+	exporter *decisionExporter
+	now      func() time.Time
+	usage    *usageCost
+	once     sync.Once
+}
+
+func newDecisionRecordContext(ctx context.Context, r *decisionRecord) context.Context {
+	return context.WithValue(ctx, usageCtxKey("decisionRecord"), r)
+}
+
+func decisionRecordFromContext(ctx context.Context) (*decisionRecord, bool) {
+	r, ok := ctx.Value(usageCtxKey("decisionRecord")).(*decisionRecord)
+	return r, ok
+}
+
+// add tallies usage recorded for the request. It's a no-op if r is nil.
+func (r *decisionRecord) add(usage map[string]int64) {
+	if r == nil {
+		return
+	}
+	r.usage.add(usage)
+}
+
+// finish exports the decision with the outcome of the handler. Only the first call has an effect.
+func (r *decisionRecord) finish(err error) {
+	r.once.Do(func() {
+		r.event.Code = status.Code(err).String()
+		r.event.LatencyMs = float64(r.now().Sub(r.start)) / float64(time.Millisecond)
+		r.usage.lk.Lock()
+		if len(r.usage.usage) > 0 {
+			r.event.Usage = r.usage.usage
+		}
+		r.usage.lk.Unlock()
+		r.exporter.add(r.event)
+	})
+}
+
+// finishDecision exports the decision of the request in ctx, if any, with the outcome of its handler.
+func finishDecision(ctx context.Context, err error) {
+	if r, ok := decisionRecordFromContext(ctx); ok {
+		r.finish(err)
+	}
+}
+
+// exportDecision exports a denial, or attaches a record of an allowed request to ctx, which is exported
+// when the request is handled. Dry-run decisions and methods that aren't metered aren't exported.
+func (t *Textile) exportDecision(ctx context.Context, method string, start time.Time, err error) context.Context {
+	if t.decisionExp == nil || isDryRun(ctx) || t.methodExemption(method) != exemptNone {
+		return ctx
+	}
+	account, _ := mdb.AccountFromContext(ctx)
+	event := decisionEvent{
+		Time:      start,
+		Method:    method,
+		OwnerType: ownerTypeLabel(account),
+		Allowed:   err == nil,
+	}
+	if err != nil {
+		var derr *denialError
+		if errors.As(err, &derr) {
+			event.Reason = string(derr.reason)
+		}
+		event.Code = status.Code(err).String()
+		event.LatencyMs = float64(t.now().Sub(start)) / float64(time.Millisecond)
+		t.decisionExp.add(event)
+		return ctx
+	}
+	return newDecisionRecordContext(ctx, &decisionRecord{
+		event:    event,
+		start:    start,
+		exporter: t.decisionExp,
+		now:      t.now,
+		usage:    &usageCost{usage: make(map[string]int64)},
+	})
+}
@@ -0,0 +1,172 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecisionExporter_Batches(t *testing.T) {
+	backend := &testDecisionBackend{}
+	e := newDecisionExporter(backend, 2, time.Hour, 10)
+	for i := 0; i < 5; i++ {
+		e.add(decisionEvent{Method: findMethod})
+	}
+	e.flush(context.Background())
+	batches := backend.list()
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[0], 2)
+	assert.Len(t, batches[1], 2)
+	assert.Len(t, batches[2], 1)
+
+	// A full batch is written without waiting for the interval.
+	e.start()
+	defer e.close()
+	e.add(decisionEvent{Method: findMethod})
+	e.add(decisionEvent{Method: findMethod})
+	require.Eventually(t, func() bool { return len(backend.list()) == 4 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDecisionExporter_Overflow(t *testing.T) {
+	backend := &testDecisionBackend{}
+	e := newDecisionExporter(backend, 10, time.Hour, 2)
+	for i := 0; i < 3; i++ {
+		e.add(decisionEvent{Method: findMethod})
+	}
+	// Decisions beyond the buffer are dropped.
+	e.flush(context.Background())
+	batches := backend.list()
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0], 2)
+}
+
+func TestPreUsageFunc_DecisionExport(t *testing.T) {
+	bc := newTestBillingClient()
+	backend := &testDecisionBackend{}
+	tx := &Textile{bc: bc, decisionExp: newDecisionExporter(backend, 10, time.Hour, 10)}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	// Denials are exported with their reason.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+
+	// Allowed requests are exported with their usage once they're handled.
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageDelta = mib
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pushPathMethod}
+	_, err = unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(newTestAccountContext(dev), nil, info, handler)
+	require.NoError(t, err)
+
+	// Failed handlers are exported with their code.
+	handler = func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	_, err = unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(newTestAccountContext(dev), nil, info, handler)
+	require.Error(t, err)
+
+	// Dry runs aren't exported.
+	_, err = tx.preUsageFunc(newDryRunContext(newTestAccountContext(dev)), findMethod)
+	require.Error(t, err)
+
+	tx.decisionExp.flush(context.Background())
+	batches := backend.list()
+	require.Len(t, batches, 1)
+	events := batches[0]
+	require.Len(t, events, 3)
+
+	assert.Equal(t, findMethod, events[0].Method)
+	assert.Equal(t, "dev", events[0].OwnerType)
+	assert.False(t, events[0].Allowed)
+	assert.Equal(t, string(denialQuota), events[0].Reason)
+	assert.Equal(t, codes.ResourceExhausted.String(), events[0].Code)
+
+	assert.Equal(t, pushPathMethod, events[1].Method)
+	assert.True(t, events[1].Allowed)
+	assert.Empty(t, events[1].Reason)
+	assert.Equal(t, codes.OK.String(), events[1].Code)
+	assert.Equal(t, int64(mib), events[1].Usage["stored_data"])
+
+	assert.True(t, events[2].Allowed)
+	assert.Equal(t, codes.NotFound.String(), events[2].Code)
+	assert.Empty(t, events[2].Usage)
+}
+
+func TestDecisionBackend_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decisions.jsonl")
+	backend, err := newDecisionBackend("file://" + path)
+	require.NoError(t, err)
+	require.NoError(t, backend.write(context.Background(), []decisionEvent{{Method: findMethod}}))
+	require.NoError(t, backend.write(context.Background(), []decisionEvent{{Method: pushPathMethod, Allowed: true}}))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var events []decisionEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e decisionEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+	require.Len(t, events, 2)
+	assert.Equal(t, findMethod, events[0].Method)
+	assert.True(t, events[1].Allowed)
+}
+
+func TestDecisionBackend_Webhook(t *testing.T) {
+	var received []decisionEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	backend, err := newDecisionBackend(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, backend.write(context.Background(), []decisionEvent{{Method: findMethod}, {Method: saveMethod}}))
+	require.Len(t, received, 2)
+	assert.Equal(t, saveMethod, received[1].Method)
+
+	_, err = newDecisionBackend("kafka://decisions")
+	require.Error(t, err)
+}
+
+// testDecisionBackend is an in-memory decisionBackend.
+type testDecisionBackend struct {
+	lk      sync.Mutex
+	batches [][]decisionEvent
+}
+
+func (b *testDecisionBackend) write(_ context.Context, events []decisionEvent) error {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	b.batches = append(b.batches, append([]decisionEvent(nil), events...))
+	return nil
+}
+
+func (b *testDecisionBackend) list() [][]decisionEvent {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	return append([][]decisionEvent(nil), b.batches...)
+}
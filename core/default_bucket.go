@@ -0,0 +1,133 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	threads "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+	"github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/buckets/archive/retrieval"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	tdb "github.com/textileio/textile/v2/threaddb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// defaultBucketThreadName is the name of the thread that holds an owner's default bucket.
+	defaultBucketThreadName = "default-bucket"
+
+	// provisionTimeout is the max time allowed to provision a default bucket.
+	provisionTimeout = time.Minute
+)
+
+// bucketProvisioner provisions a default bucket for an owner.
+type bucketProvisioner interface {
+	// HasBucket returns whether or not the owner already has a default bucket.
+	HasBucket(ctx context.Context, owner thread.PubKey) (bool, error)
+	// CreateBucket creates a default bucket for the owner.
+	CreateBucket(ctx context.Context, owner thread.PubKey) error
+}
+
+// threadBucketProvisioner creates default buckets in a dedicated, named thread owned by the owner.
+type threadBucketProvisioner struct {
+	collections *mdb.Collections
+	threads     *threads.Client
+	buckets     *tdb.Buckets
+	creator     retrieval.BucketCreator
+	session     string
+	name        string
+}
+
+func (p *threadBucketProvisioner) HasBucket(ctx context.Context, owner thread.PubKey) (bool, error) {
+	th, err := p.collections.Threads.GetByName(ctx, defaultBucketThreadName, owner)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	ictx, cancel := newInternalContext(ctx, p.session)
+	defer cancel()
+	res, err := p.buckets.List(
+		ictx,
+		th.ID,
+		db.Where("name").Eq(p.name),
+		&tdb.Bucket{},
+	)
+	if err != nil {
+		return false, err
+	}
+	return len(res.([]*tdb.Bucket)) > 0, nil
+}
+
+func (p *threadBucketProvisioner) CreateBucket(ctx context.Context, owner thread.PubKey) error {
+	ictx, cancel := newInternalContext(ctx, p.session)
+	defer cancel()
+	th, err := p.collections.Threads.GetByName(ctx, defaultBucketThreadName, owner)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		id := thread.NewIDV1(thread.Raw, 32)
+		th, err = p.collections.Threads.Create(
+			common.NewThreadNameContext(ctx, defaultBucketThreadName),
+			id,
+			owner,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		if err := p.threads.NewDB(ictx, id, db.WithNewManagedName(defaultBucketThreadName)); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	return p.creator.CreateBucket(ictx, th.ID, thread.Token(""), p.name, false, cid.Undef)
+}
+
+// newInternalContext returns a context for internal API calls with session that carries none
+// of the request's credentials. The context inherits ctx's deadline.
+func newInternalContext(ctx context.Context, session string) (context.Context, context.CancelFunc) {
+	ictx := common.NewSessionContext(context.Background(), session)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(ictx, deadline)
+	}
+	return context.WithCancel(ictx)
+}
+
+// provisionDefaultBucket creates a default bucket for a new user if one is configured
+// and the user doesn't already have one. Failures are logged and ignored unless
+// the default bucket is configured as strict.
+func (t *Textile) provisionDefaultBucket(ctx context.Context, user *mdb.Account) error {
+	if t.provisioner == nil {
+		return nil
+	}
+	lck := t.buckLocks.Get(provisionLock(user.Key.String()))
+	lck.Acquire()
+	defer lck.Release()
+
+	err := func() error {
+		ctx, cancel := context.WithTimeout(ctx, provisionTimeout)
+		defer cancel()
+		ok, err := t.provisioner.HasBucket(ctx, user.Key)
+		if err != nil || ok {
+			return err
+		}
+		return t.provisioner.CreateBucket(ctx, user.Key)
+	}()
+	if err != nil {
+		if t.conf.DefaultBucketStrict {
+			return err
+		}
+		log.Errorf("provisioning default bucket for %s: %v", user.Key, err)
+	}
+	return nil
+}
+
+type provisionLock string
+
+func (l provisionLock) Key() string {
+	return "provision/" + string(l)
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	nutil "github.com/textileio/go-threads/net/util"
+)
+
+func TestProvisionDefaultBucket_Once(t *testing.T) {
+	p := newTestBucketProvisioner()
+	tx := &Textile{provisioner: p, buckLocks: nutil.NewSemaphorePool(1)}
+	t.Cleanup(tx.buckLocks.Stop)
+
+	user := newTestDev(t)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, tx.provisionDefaultBucket(context.Background(), user))
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, tx.provisionDefaultBucket(context.Background(), user))
+	assert.Equal(t, 1, p.created[user.Key.String()])
+
+	other := newTestDev(t)
+	require.NoError(t, tx.provisionDefaultBucket(context.Background(), other))
+	assert.Equal(t, 1, p.created[other.Key.String()])
+}
+
+func TestProvisionDefaultBucket_Failure(t *testing.T) {
+	p := newTestBucketProvisioner()
+	p.err = errors.New("threads unavailable")
+	tx := &Textile{provisioner: p, buckLocks: nutil.NewSemaphorePool(1)}
+	t.Cleanup(tx.buckLocks.Stop)
+
+	// Failures don't fail the request by default.
+	user := newTestDev(t)
+	require.NoError(t, tx.provisionDefaultBucket(context.Background(), user))
+
+	tx.conf.DefaultBucketStrict = true
+	err := tx.provisionDefaultBucket(context.Background(), user)
+	require.Error(t, err)
+
+	// A later request creates the bucket once the failure clears.
+	p.err = nil
+	require.NoError(t, tx.provisionDefaultBucket(context.Background(), user))
+	assert.Equal(t, 1, p.created[user.Key.String()])
+}
+
+func TestProvisionDefaultBucket_Disabled(t *testing.T) {
+	tx := &Textile{}
+	require.NoError(t, tx.provisionDefaultBucket(context.Background(), newTestDev(t)))
+}
+
+// testBucketProvisioner is an in-memory bucketProvisioner.
+type testBucketProvisioner struct {
+	lk      sync.Mutex
+	created map[string]int
+	err     error
+}
+
+func newTestBucketProvisioner() *testBucketProvisioner {
+	return &testBucketProvisioner{created: make(map[string]int)}
+}
+
+func (p *testBucketProvisioner) HasBucket(_ context.Context, owner thread.PubKey) (bool, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	return p.created[owner.String()] > 0, nil
+}
+
+func (p *testBucketProvisioner) CreateBucket(_ context.Context, owner thread.PubKey) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.created[owner.String()]++
+	return nil
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	apic "github.com/textileio/textile/v2/api/common"
+)
+
+// defaultDeferredUploadTTL is the default time after its last chunk that an abandoned upload is released.
+const defaultDeferredUploadTTL = time.Hour
+
+// pendingUpload is the stored data of an upload's chunks that hasn't been reported.
+type pendingUpload struct {
+	delta   int64
+	expires time.Time
+}
+
+// pendingUploads accumulates the stored data of chunked PushPath uploads until they're finalized.
+// Pending stored data is reserved from the owner's available storage. An upload that isn't
+// finalized within the TTL of its last chunk is abandoned, which releases its reservation.
+type pendingUploads struct {
+	ttl time.Duration
+
+	lk     sync.Mutex
+	owners map[string]map[string]*pendingUpload
+}
+
+func newPendingUploads(ttl time.Duration) *pendingUploads {
+	if ttl <= 0 {
+		ttl = defaultDeferredUploadTTL
+	}
+	return &pendingUploads{
+		ttl:    ttl,
+		owners: make(map[string]map[string]*pendingUpload),
+	}
+}
+
+// add accumulates the stored data of an upload chunk and extends the upload's TTL.
+func (u *pendingUploads) add(owner, id string, delta int64, now time.Time) {
+	u.lk.Lock()
+	defer u.lk.Unlock()
+	u.prune(owner, now)
+	uploads, ok := u.owners[owner]
+	if !ok {
+		uploads = make(map[string]*pendingUpload)
+		u.owners[owner] = uploads
+	}
+	p, ok := uploads[id]
+	if !ok {
+		p = &pendingUpload{}
+		uploads[id] = p
+	}
+	p.delta += delta
+	p.expires = now.Add(u.ttl)
+}
+
+// finish removes an upload and returns its pending stored data.
+func (u *pendingUploads) finish(owner, id string, now time.Time) int64 {
+	u.lk.Lock()
+	defer u.lk.Unlock()
+	u.prune(owner, now)
+	p, ok := u.owners[owner][id]
+	if !ok {
+		return 0
+	}
+	delete(u.owners[owner], id)
+	if len(u.owners[owner]) == 0 {
+		delete(u.owners, owner)
+	}
+	return p.delta
+}
+
+// pending returns the stored data of an owner's uploads that haven't been finalized or abandoned.
+func (u *pendingUploads) pending(owner string, now time.Time) int64 {
+	u.lk.Lock()
+	defer u.lk.Unlock()
+	u.prune(owner, now)
+	var total int64
+	for _, p := range u.owners[owner] {
+		total += p.delta
+	}
+	return total
+}
+
+// prune releases an owner's abandoned uploads. The lock must be held.
+func (u *pendingUploads) prune(owner string, now time.Time) {
+	for id, p := range u.owners[owner] {
+		if now.Before(p.expires) {
+			continue
+		}
+		log.Warnf("releasing %d bytes of abandoned upload %s for %s", p.delta, id, owner)
+		delete(u.owners[owner], id)
+	}
+	if len(u.owners[owner]) == 0 {
+		delete(u.owners, owner)
+	}
+}
+
+// deferUploadDelta accumulates the stored data of a PushPath chunk carrying an upload ID.
+// It returns the stored data to report and whether or not it should be reported, which is only
+// the case for the request that finalizes the upload, or for requests that aren't chunked.
+func (t *Textile) deferUploadDelta(ctx context.Context, method string, key thread.PubKey, delta int64) (int64, bool) {
+	if t.uploads == nil || method != "/api.bucketsd.pb.APIService/PushPath" {
+		return delta, true
+	}
+	id, finalize, ok := apic.UploadFromMD(ctx)
+	if !ok {
+		return delta, true
+	}
+	if !finalize {
+		t.uploads.add(key.String(), id, delta, t.now())
+		return 0, false
+	}
+	return delta + t.uploads.finish(key.String(), id, t.now()), true
+}
+
+// applyPendingUploads removes the pending stored data of an owner's chunked uploads from available.
+func (t *Textile) applyPendingUploads(key thread.PubKey, available int64, now time.Time) int64 {
+	if t.uploads == nil {
+		return available
+	}
+	available -= t.uploads.pending(key.String(), now)
+	if available < 0 {
+		available = 0
+	}
+	return available
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPostUsageFunc_DeferredUploads(t *testing.T) {
+	clock := newTestClock(time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock, uploads: newPendingUploads(time.Hour)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Chunks are reserved from available storage but not reported.
+	for i := 0; i < 3; i++ {
+		pushUploadChunk(t, tx, dev, "upload", false, mib)
+	}
+	assert.Empty(t, bc.getIncs())
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(5*gib-3*mib), owner.StorageAvailable)
+
+	// The finalize chunk reports the upload once.
+	pushUploadChunk(t, tx, dev, "upload", true, mib)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(4*mib), incs[0].usage["stored_data"])
+	assert.Equal(t, int64(0), tx.uploads.pending(dev.Key.String(), clock.Now()))
+
+	// Requests without an upload ID are reported immediately.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	require.Len(t, bc.getIncs(), 2)
+}
+
+func TestPostUsageFunc_DeferredUploadAbandoned(t *testing.T) {
+	clock := newTestClock(time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock, uploads: newPendingUploads(time.Hour)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	pushUploadChunk(t, tx, dev, "upload", false, mib)
+	clock.advance(59 * time.Minute)
+	pushUploadChunk(t, tx, dev, "upload", false, mib)
+	assert.Equal(t, int64(2*mib), tx.uploads.pending(dev.Key.String(), clock.Now()))
+
+	// The TTL is extended by each chunk, after which the reservation is released.
+	clock.advance(59 * time.Minute)
+	assert.Equal(t, int64(2*mib), tx.uploads.pending(dev.Key.String(), clock.Now()))
+	clock.advance(time.Minute)
+	assert.Equal(t, int64(0), tx.uploads.pending(dev.Key.String(), clock.Now()))
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(5*gib), owner.StorageAvailable)
+
+	// Finalizing an abandoned upload only reports the finalize chunk.
+	pushUploadChunk(t, tx, dev, "upload", true, mib)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+}
+
+// pushUploadChunk runs a PushPath chunk of an upload that stores delta bytes through the usage handlers.
+func pushUploadChunk(t *testing.T, tx *Textile, dev *mdb.Account, id string, finalize bool, delta int64) {
+	md := metadata.Pairs("x-textile-upload-id", id)
+	if finalize {
+		md.Set("x-textile-upload-finalize", "true")
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx, err := tx.preUsageFunc(mdb.NewAccountContext(ctx, dev, nil), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = delta
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+}
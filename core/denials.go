@@ -0,0 +1,139 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// denialReason describes why a request was denied.
+type denialReason string
+
+const (
+	// denialQuota indicates the owner exhausted a usage quota or cap.
+	denialQuota denialReason = "quota"
+	// denialSuspension indicates the owner's subscription is not in good standing.
+	denialSuspension denialReason = "suspension"
+	// denialPermission indicates the request lacked valid credentials.
+	denialPermission denialReason = "permission"
+	// denialMaintenance indicates the owner is in a scheduled maintenance window.
+	denialMaintenance denialReason = "maintenance"
+	// denialOverload indicates the request was shed by the global request limit.
+	denialOverload denialReason = "overload"
+	// denialSignup indicates a new owner's customer was not created because of the customer creation rate.
+	denialSignup denialReason = "signup"
+)
+
+// redacted replaces sensitive values in logged denials.
+const redacted = "[REDACTED]"
+
+// sensitiveMDKeys are request metadata keys whose values are redacted from logged denials.
+var sensitiveMDKeys = []string{
+	"authorization",
+	"x-textile-session",
+	"x-textile-api-key",
+	"x-textile-api-sig",
+	"x-textile-storage-reservation",
+	"x-textile-admin-token",
+	"x-textile-support-session",
+}
+
+// denialLogger logs a message with structured key-value pairs, e.g., log.Warnw.
+type denialLogger func(msg string, keysAndValues ...interface{})
+
+// newDenialLogger returns a denial logger for the given level.
+// A nil logger is returned when level is empty, which disables denial logging.
+func newDenialLogger(level string) (denialLogger, error) {
+	switch strings.ToLower(level) {
+	case "":
+		return nil, nil
+	case "debug":
+		return log.Debugw, nil
+	case "info":
+		return log.Infow, nil
+	case "warn":
+		return log.Warnw, nil
+	case "error":
+		return log.Errorw, nil
+	default:
+		return nil, fmt.Errorf("invalid denial log level: %s", level)
+	}
+}
+
+// denialError is a gRPC status error that records why a request was denied.
+type denialError struct {
+	reason denialReason
+	st     *status.Status
+}
+
+func (e *denialError) Error() string {
+	return e.st.Err().Error()
+}
+
+// GRPCStatus returns the status the request was denied with.
+func (e *denialError) GRPCStatus() *status.Status {
+	return e.st
+}
+
+// deny logs a denied request and returns err wrapped in a denialError.
+// Dry-run denials are not logged.
+func (t *Textile) deny(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	reason denialReason,
+	err error,
+) error {
+	st := status.Convert(err)
+	if reason == denialQuota && st.Code() == codes.ResourceExhausted && t.conf.QuotaExhaustedCode != codes.OK {
+		// Keep the message and details, only the code is replaced.
+		p := st.Proto()
+		p.Code = int32(t.conf.QuotaExhaustedCode)
+		st = status.FromProto(p)
+	}
+	derr := &denialError{reason: reason, st: st}
+	if t.logDenial == nil || isDryRun(ctx) {
+		return derr
+	}
+	var owner thread.PubKey
+	if account != nil && account.Owner() != nil {
+		owner = t.ownerKey(ctx, account)
+	}
+	var remote string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remote = p.Addr.String()
+	}
+	kvs := []interface{}{
+		"reason", string(reason),
+		"method", method,
+		"remote", remote,
+		"error", redactMD(ctx, err.Error()),
+	}
+	if owner != nil {
+		kvs = append(kvs, "owner", t.ownerLabel(owner))
+	}
+	t.logDenial("request denied", kvs...)
+	return derr
+}
+
+// redactMD removes sensitive request metadata values from s.
+func redactMD(ctx context.Context, s string) string {
+	md := metautils.ExtractIncoming(ctx)
+	for _, k := range sensitiveMDKeys {
+		v := md.Get(k)
+		if k == "authorization" {
+			v = strings.TrimPrefix(v, "bearer ")
+		}
+		if v != "" {
+			s = strings.ReplaceAll(s, v, redacted)
+		}
+	}
+	return s
+}
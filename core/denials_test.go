@@ -0,0 +1,143 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_LogsDenials(t *testing.T) {
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{bc: bc, logDenial: logger.log}
+
+	remote := &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 4006}
+	newCtx := func(ctx context.Context) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{Addr: remote})
+	}
+
+	// Quota denial
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+	_, err := tx.preUsageFunc(newCtx(newTestAccountContext(dev)), findMethod)
+	require.Error(t, err)
+	require.Len(t, logger.entries, 1)
+	entry := logger.entries[0]
+	assert.Equal(t, string(denialQuota), entry["reason"])
+	assert.Equal(t, findMethod, entry["method"])
+	assert.Equal(t, dev.Key.String(), entry["owner"])
+	assert.Equal(t, remote.String(), entry["remote"])
+
+	// Suspension denial
+	suspended := newTestDev(t)
+	cus = newTestCustomer(suspended.Key)
+	cus.SubscriptionStatus = "canceled"
+	bc.setCustomer(cus)
+	_, err = tx.preUsageFunc(newCtx(newTestAccountContext(suspended)), pushPathMethod)
+	require.Error(t, err)
+	require.Len(t, logger.entries, 2)
+	entry = logger.entries[1]
+	assert.Equal(t, string(denialSuspension), entry["reason"])
+	assert.Equal(t, pushPathMethod, entry["method"])
+	assert.Equal(t, suspended.Key.String(), entry["owner"])
+}
+
+func TestRedactMD(t *testing.T) {
+	md := metadata.Pairs(
+		"x-textile-api-key", "secretkey",
+		"authorization", "bearer secrettoken",
+	)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	s := redactMD(ctx, "bad key secretkey with token secrettoken")
+	assert.Equal(t, "bad key "+redacted+" with token "+redacted, s)
+}
+
+func TestNewDenialLogger(t *testing.T) {
+	l, err := newDenialLogger("")
+	require.NoError(t, err)
+	assert.Nil(t, l)
+	l, err = newDenialLogger("WARN")
+	require.NoError(t, err)
+	assert.NotNil(t, l)
+	_, err = newDenialLogger("loud")
+	require.Error(t, err)
+}
+
+type testDenialLogger struct {
+	entries []map[string]string
+}
+
+func (l *testDenialLogger) log(_ string, keysAndValues ...interface{}) {
+	entry := make(map[string]string)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		entry[keysAndValues[i].(string)] = keysAndValues[i+1].(string)
+	}
+	l.entries = append(l.entries, entry)
+}
+
+func TestPreUsageFunc_QuotaExhaustedCode(t *testing.T) {
+	exhaust := func(key string) func(*Textile, *pb.GetCustomerResponse) {
+		return func(_ *Textile, cus *pb.GetCustomerResponse) {
+			cus.DailyUsage[key].Free = 0
+		}
+	}
+	tests := []struct {
+		name   string
+		method string
+		setup  func(*Textile, *pb.GetCustomerResponse)
+	}{
+		{name: "egress", method: findMethod, setup: exhaust("network_egress")},
+		{name: "reads", method: findMethod, setup: exhaust("instance_reads")},
+		{name: "writes", method: "/threads.pb.API/Create", setup: exhaust("instance_writes")},
+		{name: "storage cap", method: pushPathMethod, setup: func(tx *Textile, cus *pb.GetCustomerResponse) {
+			cus.Billable = true
+			tx.conf.BillableStorageCaps = map[string]int64{cus.Key: 0}
+		}},
+		{name: "burst", method: findMethod, setup: func(tx *Textile, cus *pb.GetCustomerResponse) {
+			tx.bursts = newBurstLimiter(time.Minute, map[string]int{"instance_reads": 1})
+			tx.bursts.allow(cus.Key, "instance_reads", time.Now())
+		}},
+		{name: "egress tier", method: findMethod, setup: func(tx *Textile, _ *pb.GetCustomerResponse) {
+			tx.conf.EgressTiers = []EgressTier{{Threshold: 0, Action: EgressActionBlock}}
+		}},
+		{name: "bucket limit", method: createMethod, setup: func(tx *Textile, cus *pb.GetCustomerResponse) {
+			tx.bucketCount = &testBucketCounter{counts: map[string]int{cus.Key: 1}}
+			tx.conf.MaxNumberBucketsPerOwner = 1
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, code := range []codes.Code{codes.OK, codes.FailedPrecondition} {
+				bc := newTestBillingClient()
+				tx := &Textile{bc: bc, conf: Config{QuotaExhaustedCode: code}}
+				dev := newTestDev(t)
+				cus := newTestCustomer(dev.Key)
+				tc.setup(tx, cus)
+				bc.setCustomer(cus)
+
+				_, err := tx.preUsageFunc(newTestAccountContext(dev), tc.method)
+				require.Error(t, err)
+				want := code
+				if want == codes.OK {
+					want = codes.ResourceExhausted
+				}
+				assert.Equal(t, want, status.Code(err))
+				var derr *denialError
+				require.True(t, errors.As(err, &derr))
+				assert.Equal(t, denialQuota, derr.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errDraining is returned for new requests while the server is draining.
+var errDraining = status.Error(codes.Unavailable, "server is shutting down")
+
+// drainer tracks in-flight requests so they can finish before shutdown.
+type drainer struct {
+	lk       sync.Mutex
+	draining bool
+	inflight int
+	idle     chan struct{}
+}
+
+func newDrainer() *drainer {
+	return &drainer{idle: make(chan struct{})}
+}
+
+// enter records a new in-flight request.
+// False is returned if the drainer is draining and the request should be rejected.
+func (d *drainer) enter() bool {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inflight++
+	return true
+}
+
+// exit records the completion of an in-flight request.
+func (d *drainer) exit() {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	d.inflight--
+	if d.draining && d.inflight == 0 {
+		close(d.idle)
+	}
+}
+
+// drain stops new requests from entering and waits for in-flight requests to complete
+// or for ctx to be done.
+func (d *drainer) drain(ctx context.Context) error {
+	d.lk.Lock()
+	if !d.draining {
+		d.draining = true
+		if d.inflight == 0 {
+			close(d.idle)
+		}
+	}
+	d.lk.Unlock()
+	select {
+	case <-d.idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func drainUnaryServerInterceptor(d *drainer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !d.enter() {
+			return nil, errDraining
+		}
+		defer d.exit()
+		return handler(ctx, req)
+	}
+}
+
+func drainStreamServerInterceptor(d *drainer) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !d.enter() {
+			return errDraining
+		}
+		defer d.exit()
+		return handler(srv, stream)
+	}
+}
+
+// Drain stops accepting new requests and waits for in-flight requests, including streams,
+// to complete or for ctx to be done. Pending usage is then flushed to billingd.
+// Call Drain before Close to shut down gracefully.
+func (t *Textile) Drain(ctx context.Context) error {
+	err := t.drainer.drain(ctx)
+	if err != nil {
+		log.Warnf("draining: %v", err)
+	} else {
+		log.Info("in-flight requests were drained")
+	}
+	if t.usage != nil {
+		t.usage.flush(context.Background())
+		log.Info("usage was flushed")
+	}
+	return err
+}
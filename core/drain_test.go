@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrain_InFlightStream(t *testing.T) {
+	bc := newTestBillingClient()
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	tx := &Textile{bc: bc, drainer: newDrainer(), usage: newUsageBatcher(bc, time.Hour, 1)}
+
+	streaming := drainStreamServerInterceptor(tx.drainer)
+	unary := drainUnaryServerInterceptor(tx.drainer)
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	streamDone := make(chan error)
+	go func() {
+		streamDone <- streaming(nil, &testServerStream{ctx: context.Background()}, info,
+			func(interface{}, grpc.ServerStream) error {
+				close(started)
+				<-release
+				tx.usage.add(dev.Key, map[string]int64{"network_egress": 1024})
+				return nil
+			})
+	}()
+	<-started
+
+	drained := make(chan error)
+	go func() {
+		drained <- tx.Drain(context.Background())
+	}()
+
+	// New requests are rejected while draining.
+	require.Eventually(t, func() bool {
+		_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: findMethod},
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			})
+		return status.Code(err) == codes.Unavailable
+	}, time.Second, time.Millisecond)
+	select {
+	case <-drained:
+		t.Fatal("drain returned with an in-flight stream")
+	default:
+	}
+
+	// The in-flight stream finishes and its usage is flushed.
+	close(release)
+	require.NoError(t, <-streamDone)
+	require.NoError(t, <-drained)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(1024), incs[0].usage["network_egress"])
+}
+
+func TestDrain_Deadline(t *testing.T) {
+	tx := &Textile{drainer: newDrainer()}
+	unary := drainUnaryServerInterceptor(tx.drainer)
+
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	go func() {
+		_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: findMethod},
+			func(context.Context, interface{}) (interface{}, error) {
+				close(started)
+				<-release
+				return nil, nil
+			})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := tx.Drain(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestDrain_Idle(t *testing.T) {
+	tx := &Textile{drainer: newDrainer()}
+	require.NoError(t, tx.Drain(context.Background()))
+	require.NoError(t, tx.Drain(context.Background()))
+}
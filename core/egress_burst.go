@@ -0,0 +1,44 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// egressAdmission checks that an estimated n bytes can be sent by a read before it starts.
+type egressAdmission func(n int64) error
+
+func newEgressAdmissionContext(ctx context.Context, a egressAdmission) context.Context {
+	return context.WithValue(ctx, usageCtxKey("egressAdmission"), a)
+}
+
+// egressAdmissionFromContext returns the admission in ctx, or nil if egress bursts are not limited.
+func egressAdmissionFromContext(ctx context.Context) egressAdmission {
+	a, _ := ctx.Value(usageCtxKey("egressAdmission")).(egressAdmission)
+	return a
+}
+
+// newEgressAdmission returns an admission that denies a read if its estimated size would exceed the owner's
+// egress burst limit, i.e., Config.EgressBurstLimit bytes within Config.EgressBurstWindow, regardless of
+// the owner's daily egress quota. The estimate is counted against the limit when the read is admitted.
+func (t *Textile) newEgressAdmission(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	ownerKey thread.PubKey,
+) egressAdmission {
+	return func(n int64) error {
+		ok, reset := t.egressBursts.allowN(ownerKey.String(), "network_egress", n, t.now())
+		if ok {
+			return nil
+		}
+		err := fmt.Errorf("network_egress burst limit exceeded, window resets at %s", reset.UTC().Format(time.RFC3339))
+		return t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, err.Error()))
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBurstLimiter_AllowN(t *testing.T) {
+	l := newUsageBurstLimiter(time.Minute, map[string]int64{"network_egress": 10 * mib})
+	now := time.Now()
+
+	ok, _ := l.allowN("owner", "network_egress", 6*mib, now)
+	require.True(t, ok)
+	ok, _ = l.allowN("owner", "network_egress", 4*mib, now.Add(time.Second))
+	require.True(t, ok)
+	ok, reset := l.allowN("owner", "network_egress", mib, now.Add(2*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, now.Add(time.Minute), reset)
+
+	// A read larger than the limit is allowed once the window is empty.
+	ok, _ = l.allowN("owner", "network_egress", 20*mib, now.Add(2*time.Minute))
+	assert.True(t, ok)
+}
+
+func TestStreamServerInterceptor_EgressBurstLimit(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:           bc,
+		clock:        clock,
+		egressBursts: newUsageBurstLimiter(time.Minute, map[string]int64{"network_egress": 10 * mib}),
+	}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// The handler checks the size of the file before it's pulled.
+	pull := func(size int64) error {
+		handler := func(_ interface{}, ss grpc.ServerStream) error {
+			if err := buckets.AdmitEgress(ss.Context(), size); err != nil {
+				return err
+			}
+			return ss.SendMsg(&bpb.PullPathResponse{Chunk: make([]byte, 1024)})
+		}
+		stream := &testServerStream{ctx: newTestAccountContext(dev)}
+		info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+		return streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	}
+
+	require.NoError(t, pull(6*mib))
+	require.NoError(t, pull(4*mib))
+
+	// The daily egress quota has plenty left, but the burst limit is reached.
+	err := pull(mib)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "network_egress burst limit exceeded")
+
+	// Pulls are allowed again once the window slides.
+	clock.advance(time.Minute)
+	require.NoError(t, pull(mib))
+}
@@ -0,0 +1,16 @@
+package core
+
+import "math"
+
+// billableEgress returns the number of egress bytes billed for a stream that sent bytes.
+// Reads tagged as cache-served are discounted by the configured multiplier.
+func (t *Textile) billableEgress(egress *streamEgress, sent int64) int64 {
+	if egress == nil || !egress.source.CacheServed() {
+		return sent
+	}
+	multiplier := t.conf.CachedEgressMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	return int64(math.Round(float64(sent) * multiplier))
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+)
+
+func TestStreamServerInterceptor_CachedEgress(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{CachedEgressMultiplier: 0.25}}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	msg := &bpb.PullPathResponse{Chunk: make([]byte, 1<<16)}
+	size := int64(proto.Size(msg) + msgHeaderLen)
+	pull := func(cached bool) {
+		handler := func(_ interface{}, ss grpc.ServerStream) error {
+			if cached {
+				buckets.SetCacheServed(ss.Context())
+			}
+			return ss.SendMsg(msg)
+		}
+		stream := &testServerStream{ctx: newTestAccountContext(dev)}
+		info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+		err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+		require.NoError(t, err)
+	}
+
+	// Origin reads are billed in full.
+	pull(false)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, size, incs[0].usage["network_egress"])
+
+	// Cache-served reads are billed at the discounted rate.
+	pull(true)
+	incs = bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, size/4, incs[1].usage["network_egress"])
+}
+
+func TestBillableEgress_DefaultMultiplier(t *testing.T) {
+	tx := &Textile{}
+	egress := &streamEgress{source: &buckets.ReadSource{}}
+	buckets.SetCacheServed(buckets.NewReadSourceContext(context.Background(), egress.source))
+	assert.Equal(t, int64(1000), tx.billableEgress(egress, 1000))
+}
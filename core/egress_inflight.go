@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errEgressInFlight is returned when a stream would push an owner's in-flight egress past their allowance.
+var errEgressInFlight = status.Error(codes.ResourceExhausted, "network egress exhausted by in-flight transfers")
+
+// inflightEgress tracks the bytes sent by each owner's streams that have not completed.
+// Completed streams are billed, so their bytes are counted by usage checks instead.
+type inflightEgress struct {
+	lk     sync.Mutex
+	owners map[string]int64
+}
+
+func newInflightEgress() *inflightEgress {
+	return &inflightEgress{owners: make(map[string]int64)}
+}
+
+// reserve adds n in-flight bytes for owner if the total stays within limit.
+func (e *inflightEgress) reserve(owner string, n, limit int64) bool {
+	e.lk.Lock()
+	defer e.lk.Unlock()
+	if e.owners[owner]+n > limit {
+		return false
+	}
+	e.owners[owner] += n
+	return true
+}
+
+// release removes n in-flight bytes for owner.
+func (e *inflightEgress) release(owner string, n int64) {
+	e.lk.Lock()
+	defer e.lk.Unlock()
+	e.owners[owner] -= n
+	if e.owners[owner] <= 0 {
+		delete(e.owners, owner)
+	}
+}
+
+// get returns the owner's in-flight bytes.
+func (e *inflightEgress) get(owner string) int64 {
+	e.lk.Lock()
+	defer e.lk.Unlock()
+	return e.owners[owner]
+}
+
+// egressAllowance bounds the network egress of an owner's concurrent streams.
+type egressAllowance struct {
+	owner    string
+	limit    int64
+	tracker  *inflightEgress
+	throttle *egressThrottle
+	// deny records a send that would exceed the limit as a quota denial, see Textile.deny.
+	deny func(err error) error
+}
+
+// exceeded returns the error for a send that would exceed the allowance.
+func (a *egressAllowance) exceeded() error {
+	if a.deny == nil {
+		return errEgressInFlight
+	}
+	return a.deny(errEgressInFlight)
+}
+
+func newEgressAllowanceContext(ctx context.Context, allowance *egressAllowance) context.Context {
+	return context.WithValue(ctx, usageCtxKey("egressAllowance"), allowance)
+}
+
+func egressAllowanceFromContext(ctx context.Context) (*egressAllowance, bool) {
+	allowance, ok := ctx.Value(usageCtxKey("egressAllowance")).(*egressAllowance)
+	return allowance, ok
+}
+
+// egressLimit returns the network egress a non-billable customer has left.
+// False is returned if the customer's egress is not limited.
+func (t *Textile) egressLimit(cus *pb.GetCustomerResponse, now time.Time) (int64, bool) {
+	if cus.Billable || t.freeUnlimited(cus, "network_egress") {
+		return 0, false
+	}
+	if now.Unix() < cus.GracePeriodEnd {
+		return cus.DailyUsage["network_egress"].Grace, true
+	}
+	return cus.DailyUsage["network_egress"].Free, true
+}
+
+// isEgressStreamMethod returns whether or not method's egress is measured by the stream interceptor.
+func isEgressStreamMethod(method string) bool {
+	for _, m := range egressStreamMethods {
+		if method == m {
+			return true
+		}
+	}
+	return false
+}
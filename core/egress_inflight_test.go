@@ -0,0 +1,163 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamServerInterceptor_ConcurrentEgress(t *testing.T) {
+	chunk := &bpb.PullPathResponse{Chunk: make([]byte, 1024)}
+	size := int64(proto.Size(chunk) + msgHeaderLen)
+	tracker := newInflightEgress()
+	allowance := &egressAllowance{owner: "owner", limit: 3 * size, tracker: tracker}
+
+	pre := func(ctx context.Context, _ string) (context.Context, error) {
+		return newEgressAllowanceContext(ctx, allowance), nil
+	}
+	post := func(context.Context, string) error {
+		return nil
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	sendTwo := func(ss grpc.ServerStream) error {
+		for i := 0; i < 2; i++ {
+			if err := ss.SendMsg(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Each pull is within the allowance, but together they exceed it.
+	sent := make(chan struct{})
+	done := make(chan struct{})
+	errA := make(chan error, 1)
+	go func() {
+		handler := func(_ interface{}, ss grpc.ServerStream) error {
+			if err := sendTwo(ss); err != nil {
+				return err
+			}
+			close(sent)
+			<-done
+			return nil
+		}
+		errA <- streamServerInterceptor(pre, post)(nil, &testServerStream{ctx: context.Background()}, info, handler)
+	}()
+	<-sent
+	assert.Equal(t, 2*size, tracker.get("owner"))
+
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		return sendTwo(ss)
+	}
+	err := streamServerInterceptor(pre, post)(nil, &testServerStream{ctx: context.Background()}, info, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 2*size, tracker.get("owner"))
+
+	// Completed pulls release their in-flight bytes.
+	close(done)
+	require.NoError(t, <-errA)
+	assert.Equal(t, int64(0), tracker.get("owner"))
+
+	err = streamServerInterceptor(pre, post)(nil, &testServerStream{ctx: context.Background()}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), tracker.get("owner"))
+}
+
+func TestStreamServerInterceptor_EgressSendFailure(t *testing.T) {
+	tracker := newInflightEgress()
+	allowance := &egressAllowance{owner: "owner", limit: 1 << 20, tracker: tracker}
+	pre := func(ctx context.Context, _ string) (context.Context, error) {
+		return newEgressAllowanceContext(ctx, allowance), nil
+	}
+	post := func(context.Context, string) error {
+		return nil
+	}
+	errDisconnected := errors.New("client disconnected")
+	stream := &testServerStream{ctx: context.Background(), failAfter: 1, failErr: errDisconnected}
+	chunk := &bpb.PullPathResponse{Chunk: make([]byte, 10)}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		if err := ss.SendMsg(chunk); err != nil {
+			return err
+		}
+		// The failed send is not held in flight.
+		err := ss.SendMsg(chunk)
+		assert.Equal(t, int64(proto.Size(chunk)+msgHeaderLen), tracker.get("owner"))
+		return err
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(pre, post)(nil, stream, info, handler)
+	require.True(t, errors.Is(err, errDisconnected))
+	assert.Equal(t, int64(0), tracker.get("owner"))
+}
+
+func TestPreUsageFunc_InflightEgress(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, inflight: newInflightEgress()}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["network_egress"].Free = 1000
+	bc.setCustomer(cus)
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.NoError(t, err)
+	allowance, ok := egressAllowanceFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(1000), allowance.limit)
+
+	// New pulls are denied while other pulls hold the remaining egress.
+	require.True(t, tx.inflight.reserve(dev.Key.String(), 1000, allowance.limit))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	tx.inflight.release(dev.Key.String(), 1000)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.NoError(t, err)
+
+	// Billable owners are not limited.
+	cus.Billable = true
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.NoError(t, err)
+	_, ok = egressAllowanceFromContext(ctx)
+	assert.False(t, ok)
+}
+
+func TestStreamServerInterceptor_InflightEgressDenial(t *testing.T) {
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{
+		bc:        bc,
+		inflight:  newInflightEgress(),
+		logDenial: logger.log,
+		conf:      Config{QuotaExhaustedCode: codes.FailedPrecondition},
+	}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["network_egress"].Free = 100
+	bc.setCustomer(cus)
+
+	// A send that exceeds the allowance mid-stream is denied like any other quota denial.
+	chunk := &bpb.PullPathResponse{Chunk: make([]byte, 1024)}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		return ss.SendMsg(chunk)
+	}
+	post := func(context.Context, string) error {
+		return nil
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(tx.preUsageFunc, post)(nil, &testServerStream{ctx: newTestAccountContext(dev)}, info, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, logger.entries, 1)
+	assert.Equal(t, string(denialQuota), logger.entries[0]["reason"])
+}
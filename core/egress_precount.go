@@ -0,0 +1,37 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// egressPrecounter bills network egress that a read expects to send before it's sent.
+type egressPrecounter func(n int64)
+
+func newEgressPrecounterContext(ctx context.Context, p egressPrecounter) context.Context {
+	return context.WithValue(ctx, usageCtxKey("egressPrecounter"), p)
+}
+
+// egressPrecounterFromContext returns the precounter in ctx, or nil if egress is not precounted.
+func egressPrecounterFromContext(ctx context.Context) egressPrecounter {
+	p, _ := ctx.Value(usageCtxKey("egressPrecounter")).(egressPrecounter)
+	return p
+}
+
+// newEgressPrecounter returns a precounter that bills an owner for the egress reported by a request.
+// Each report is keyed separately so that reports for the same request aren't deduped.
+func (t *Textile) newEgressPrecounter(ctx context.Context, method string, ownerKey thread.PubKey) egressPrecounter {
+	var reports int64
+	return func(n int64) {
+		event := fmt.Sprintf("network_egress_precount_%d", atomic.AddInt64(&reports, 1))
+		opts := usageKeyOptions(ctx, method, ownerKey, event)
+		ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+		defer cancel()
+		if err := t.recordUsage(ctx, ownerKey, map[string]int64{"network_egress": n}, opts...); err != nil {
+			log.Errorf("precounting egress for %s: %v", ownerKey, err)
+		}
+	}
+}
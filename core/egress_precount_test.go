@@ -0,0 +1,68 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+)
+
+func TestStreamServerInterceptor_EgressPrecount(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{EgressPrecount: true}}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	msg := &bpb.PullPathResponse{Chunk: make([]byte, 1<<16)}
+	size := int64(proto.Size(msg) + msgHeaderLen)
+	errAborted := errors.New("client aborted")
+
+	// The client aborts after one chunk of a file that was precounted in full.
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		buckets.PrecountEgress(ss.Context(), 10*mib)
+		if err := ss.SendMsg(msg); err != nil {
+			return err
+		}
+		return errAborted
+	}
+	stream := &testServerStream{ctx: newTestAccountContext(dev)}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.Equal(t, errAborted, err)
+
+	// The precount is billed up front, and the over-count is reported as a negative adjustment.
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, int64(10*mib), incs[0].usage["network_egress"])
+	assert.Equal(t, size-10*mib, incs[1].usage["network_egress"])
+	assert.NotEqual(t, incs[0].idempotencyKey, incs[1].idempotencyKey)
+}
+
+func TestStreamServerInterceptor_EgressPrecountDisabled(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	msg := &bpb.PullPathResponse{Chunk: make([]byte, 1<<16)}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		buckets.PrecountEgress(ss.Context(), 10*mib)
+		return ss.SendMsg(msg)
+	}
+	stream := &testServerStream{ctx: newTestAccountContext(dev)}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.NoError(t, err)
+
+	// Only sent bytes are billed.
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(proto.Size(msg)+msgHeaderLen), incs[0].usage["network_egress"])
+}
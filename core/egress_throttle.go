@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// egressThrottle slows the sends of an owner's streams as their remaining network egress shrinks,
+// so that transfers wind down instead of being cut off when the allowance runs out.
+type egressThrottle struct {
+	// threshold is the remaining egress in bytes below which sends are throttled.
+	threshold int64
+	// rate is the send rate in bytes per second at threshold, which is reduced in proportion
+	// to the remaining egress.
+	rate  int64
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newEgressThrottle(threshold, rate int64) *egressThrottle {
+	return &egressThrottle{
+		threshold: threshold,
+		rate:      rate,
+		sleep:     sleepContext,
+	}
+}
+
+// delay returns how long to wait before sending n bytes with remaining bytes of egress left.
+// Sends that exceed the remaining egress are not delayed since they're stopped by the allowance.
+func (th *egressThrottle) delay(n, remaining int64) time.Duration {
+	if remaining >= th.threshold || remaining <= 0 || th.rate <= 0 {
+		return 0
+	}
+	rate := float64(th.rate) * float64(remaining) / float64(th.threshold)
+	return time.Duration(float64(n) / rate * float64(time.Second))
+}
+
+// wait blocks until n bytes can be sent with remaining bytes of egress left, or ctx is done.
+func (th *egressThrottle) wait(ctx context.Context, n, remaining int64) error {
+	if d := th.delay(n, remaining); d > 0 {
+		return th.sleep(ctx, d)
+	}
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEgressThrottle_Delay(t *testing.T) {
+	th := newEgressThrottle(1000, 100)
+
+	// Sends aren't delayed above the threshold.
+	assert.Equal(t, time.Duration(0), th.delay(100, 1000))
+	assert.Equal(t, time.Duration(0), th.delay(100, 5000))
+
+	// The rate is reduced in proportion to the remaining egress.
+	assert.Equal(t, 1250*time.Millisecond, th.delay(100, 800))
+	assert.Equal(t, 2*time.Second, th.delay(100, 500))
+	assert.Equal(t, 10*time.Second, th.delay(100, 100))
+
+	// The allowance stops sends when no egress is left.
+	assert.Equal(t, time.Duration(0), th.delay(100, 0))
+}
+
+func TestStreamServerInterceptor_EgressThrottle(t *testing.T) {
+	chunk := &bpb.PullPathResponse{Chunk: make([]byte, 1024)}
+	size := int64(proto.Size(chunk) + msgHeaderLen)
+	th := newEgressThrottle(4*size, size)
+	var delays []time.Duration
+	th.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	tracker := newInflightEgress()
+	allowance := &egressAllowance{owner: "owner", limit: 6 * size, tracker: tracker, throttle: th}
+	pre := func(ctx context.Context, _ string) (context.Context, error) {
+		return newEgressAllowanceContext(ctx, allowance), nil
+	}
+	post := func(context.Context, string) error {
+		return nil
+	}
+	var sent int
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		for {
+			if err := ss.SendMsg(chunk); err != nil {
+				return err
+			}
+			sent++
+		}
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(pre, post)(nil, &testServerStream{ctx: context.Background()}, info, handler)
+
+	// The stream is stopped when the allowance runs out.
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 6, sent)
+
+	// Throughput decreases as the remaining egress shrinks below the threshold.
+	assert.Equal(t, []time.Duration{4 * time.Second / 3, 2 * time.Second, 4 * time.Second}, delays)
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// usageWarningHeader is the response header used to warn clients about their usage.
+const usageWarningHeader = "x-textile-usage-warning"
+
+// EgressAction describes how requests are handled while an owner's network egress is in a tier.
+type EgressAction string
+
+const (
+	// EgressActionAllow allows requests.
+	EgressActionAllow EgressAction = "allow"
+	// EgressActionWarn allows requests, but adds a usage warning to the response header.
+	EgressActionWarn EgressAction = "warn"
+	// EgressActionBlock denies requests.
+	EgressActionBlock EgressAction = "block"
+)
+
+// EgressTier applies an action to non-billable owners once their cumulative network egress reaches a threshold.
+type EgressTier struct {
+	// Threshold is the cumulative network egress in bytes at which the tier starts.
+	Threshold int64
+	// Action is applied to requests while egress is in the tier.
+	Action EgressAction
+}
+
+// egressTier returns the tier with the highest threshold reached by total.
+// An allow tier starting at zero is returned if no thresholds are reached.
+func egressTier(tiers []EgressTier, total int64) EgressTier {
+	tier := EgressTier{Action: EgressActionAllow}
+	for _, t := range tiers {
+		if total >= t.Threshold && t.Threshold >= tier.Threshold {
+			tier = t
+		}
+	}
+	return tier
+}
+
+// checkEgressTier applies the configured egress tier for a non-billable owner.
+func (t *Textile) checkEgressTier(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	cus *pb.GetCustomerResponse,
+) error {
+	if len(t.conf.EgressTiers) == 0 || cus.Billable {
+		return nil
+	}
+	total := cus.DailyUsage["network_egress"].Total
+	tier := egressTier(t.conf.EgressTiers, total)
+	switch tier.Action {
+	case EgressActionWarn:
+		if isDryRun(ctx) {
+			return nil
+		}
+		msg := fmt.Sprintf("network egress of %d bytes exceeds warning threshold of %d bytes", total, tier.Threshold)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(usageWarningHeader, msg)); err != nil {
+			log.Debugf("setting usage warning header: %v", err)
+		}
+	case EgressActionBlock:
+		err := fmt.Errorf("network egress exhausted: %d bytes exceeds limit of %d bytes", total, tier.Threshold)
+		return t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, err.Error()))
+	}
+	return nil
+}
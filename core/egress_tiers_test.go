@@ -0,0 +1,94 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_EgressTiers(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	tx.conf.EgressTiers = []EgressTier{
+		{Threshold: 2 * gib, Action: EgressActionBlock},
+		{Threshold: gib, Action: EgressActionWarn},
+	}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+
+	request := func() (metadata.MD, error) {
+		ts := &testTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(newTestAccountContext(dev), ts)
+		_, err := tx.preUsageFunc(ctx, pullPathMethod)
+		return ts.header, err
+	}
+
+	// Free tier
+	cus.DailyUsage["network_egress"].Total = gib - 1
+	header, err := request()
+	require.NoError(t, err)
+	assert.Empty(t, header.Get(usageWarningHeader))
+
+	// Warning tier
+	cus.DailyUsage["network_egress"].Total = gib
+	header, err = request()
+	require.NoError(t, err)
+	require.Len(t, header.Get(usageWarningHeader), 1)
+	assert.Contains(t, header.Get(usageWarningHeader)[0], "exceeds warning threshold")
+
+	// Blocked tier
+	cus.DailyUsage["network_egress"].Total = 2 * gib
+	_, err = request()
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Billable owners are not tiered.
+	cus.Billable = true
+	header, err = request()
+	require.NoError(t, err)
+	assert.Empty(t, header.Get(usageWarningHeader))
+}
+
+func TestEgressTier(t *testing.T) {
+	tiers := []EgressTier{
+		{Threshold: 100, Action: EgressActionWarn},
+		{Threshold: 200, Action: EgressActionBlock},
+	}
+	assert.Equal(t, EgressActionAllow, egressTier(tiers, 0).Action)
+	assert.Equal(t, EgressActionAllow, egressTier(tiers, 99).Action)
+	assert.Equal(t, EgressActionWarn, egressTier(tiers, 100).Action)
+	assert.Equal(t, EgressActionWarn, egressTier(tiers, 199).Action)
+	assert.Equal(t, EgressActionBlock, egressTier(tiers, 200).Action)
+	assert.Equal(t, EgressActionAllow, egressTier(nil, 1000).Action)
+}
+
+// testTransportStream is a grpc.ServerTransportStream that records headers and trailers.
+type testTransportStream struct {
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *testTransportStream) Method() string {
+	return ""
+}
+
+func (s *testTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *testTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *testTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// usageWarningCodeHeader is the response header used to tell clients why they were warned.
+	usageWarningCodeHeader = "x-textile-usage-warning-code"
+	// egressLimitWarningCode warns that the next request over the network egress limit will be denied.
+	egressLimitWarningCode = "network_egress_limit"
+)
+
+// egressWarnings records the owners that have been warned about exhausting their network egress
+// within the current usage period.
+type egressWarnings struct {
+	lk     sync.Mutex
+	warned map[string]int64
+}
+
+func newEgressWarnings() *egressWarnings {
+	return &egressWarnings{warned: make(map[string]int64)}
+}
+
+// warn returns true if owner has not been warned within the period starting at periodStart,
+// in which case the warning is recorded unless dryRun is true. Warnings from earlier periods
+// are forgotten.
+func (w *egressWarnings) warn(owner string, periodStart int64, dryRun bool) bool {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+	if start, ok := w.warned[owner]; ok && start >= periodStart {
+		return false
+	}
+	if !dryRun {
+		w.warned[owner] = periodStart
+	}
+	return true
+}
+
+// warnEgressLimit returns whether or not an owner that has exhausted their network egress is
+// allowed a final request under the warn-then-block policy. Allowed requests are given the
+// configured grace allowance and a usage warning header. Dry runs don't use the warning.
+func (t *Textile) warnEgressLimit(
+	ctx context.Context,
+	ownerKey thread.PubKey,
+	cus *pb.GetCustomerResponse,
+	dryRun bool,
+) bool {
+	if t.warnings == nil {
+		return false
+	}
+	periodStart := cus.DailyUsage["network_egress"].GetPeriod().GetUnixStart()
+	if !t.warnings.warn(ownerKey.String(), periodStart, dryRun) {
+		return false
+	} else if dryRun {
+		return true
+	}
+	msg := fmt.Sprintf("network egress exhausted: further requests will be denied after %d bytes",
+		t.conf.EgressWarningGrace)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(
+		usageWarningHeader, msg,
+		usageWarningCodeHeader, egressLimitWarningCode,
+	)); err != nil {
+		log.Debugf("setting usage warning header: %v", err)
+	}
+	return true
+}
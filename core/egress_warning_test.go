@@ -0,0 +1,82 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_EgressWarnThenBlock(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:       bc,
+		inflight: newInflightEgress(),
+		warnings: newEgressWarnings(),
+		conf:     Config{EgressWarnThenBlock: true, EgressWarningGrace: mib},
+	}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["network_egress"].Free = 0
+	cus.DailyUsage["network_egress"].Period = &pb.Period{UnixStart: 100, UnixEnd: 200}
+	bc.setCustomer(cus)
+
+	request := func(dryRun bool) (metadata.MD, *egressAllowance, error) {
+		ts := &testTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(newTestAccountContext(dev), ts)
+		if dryRun {
+			ctx = newDryRunContext(ctx)
+		}
+		ctx, err := tx.preUsageFunc(ctx, pullPathMethod)
+		allowance, _ := egressAllowanceFromContext(ctx)
+		return ts.header, allowance, err
+	}
+
+	// Dry runs don't use the warning.
+	_, _, err := request(true)
+	require.NoError(t, err)
+
+	// The first request over the limit is warned and given the grace allowance.
+	header, allowance, err := request(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{egressLimitWarningCode}, header.Get(usageWarningCodeHeader))
+	require.Len(t, header.Get(usageWarningHeader), 1)
+	require.NotNil(t, allowance)
+	assert.Equal(t, int64(mib), allowance.limit)
+
+	// The next is blocked.
+	_, _, err = request(false)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, _, err = request(true)
+	require.Error(t, err)
+
+	// The warning is reset in the next period.
+	cus.DailyUsage["network_egress"].Period = &pb.Period{UnixStart: 200, UnixEnd: 300}
+	header, _, err = request(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{egressLimitWarningCode}, header.Get(usageWarningCodeHeader))
+	_, _, err = request(false)
+	require.Error(t, err)
+}
+
+func TestPreUsageFunc_EgressBlockWithoutWarning(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["network_egress"].Free = 0
+	bc.setCustomer(cus)
+
+	// Without the policy, the first request over the limit is blocked.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
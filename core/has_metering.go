@@ -0,0 +1,9 @@
+package core
+
+// hasMethod is threaddb's Has, a cheap existence check that's metered as a read by default.
+const hasMethod = "/threads.pb.API/Has"
+
+// hasUsageKey returns the usage key that Has is metered against, or an empty string if it's free.
+func (t *Textile) hasUsageKey() string {
+	return readMeteringKey(t.conf.HasMetering)
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_HasMetering(t *testing.T) {
+	tests := []struct {
+		name       string
+		metering   string
+		cheapReads int64
+		code       codes.Code
+	}{
+		{name: "default", metering: "", cheapReads: 500000, code: codes.ResourceExhausted},
+		{name: "free", metering: readMeteringFree, code: codes.OK},
+		{name: "cheap key", metering: "instance_cheap_reads", cheapReads: 500000, code: codes.OK},
+		{name: "cheap key exhausted", metering: "instance_cheap_reads", code: codes.ResourceExhausted},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, conf: Config{HasMetering: tc.metering}}
+			dev := newTestDev(t)
+			cus := newTestCustomer(dev.Key)
+			cus.DailyUsage["instance_reads"].Free = 0
+			cus.DailyUsage["instance_cheap_reads"] = &pb.Usage{Free: tc.cheapReads}
+			bc.setCustomer(cus)
+
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), hasMethod)
+			assert.Equal(t, tc.code, status.Code(err))
+
+			// Other reads are still checked.
+			_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+			require.Error(t, err)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+	}
+}
+
+func TestPreUsageFunc_HasMeteringMissingKey(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{HasMetering: "instance_cheap_reads"}}
+	dev := newTestDev(t)
+	// An existing customer whose subscription predates instance_cheap_reads.
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), hasMethod)
+	require.NoError(t, err)
+}
+
+func TestThreadsUsage_HasMetering(t *testing.T) {
+	rs := &requestStats{reads: 2, writes: 1, has: 3}
+
+	tx := &Textile{}
+	assert.Equal(t, map[string]int64{
+		"network_egress":  0,
+		"instance_reads":  5,
+		"instance_writes": 1,
+	}, tx.threadsUsage(rs))
+
+	tx.conf.HasMetering = readMeteringFree
+	assert.Equal(t, map[string]int64{
+		"network_egress":  0,
+		"instance_reads":  2,
+		"instance_writes": 1,
+	}, tx.threadsUsage(rs))
+
+	tx.conf.HasMetering = "instance_cheap_reads"
+	assert.Equal(t, map[string]int64{
+		"network_egress":       0,
+		"instance_reads":       2,
+		"instance_writes":      1,
+		"instance_cheap_reads": 3,
+	}, tx.threadsUsage(rs))
+}
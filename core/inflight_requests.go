@@ -0,0 +1,177 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"google.golang.org/grpc"
+)
+
+// defaultBusyOwnerSampleSize is the default number of owners logged by the busy owner sampler.
+const defaultBusyOwnerSampleSize = 10
+
+var (
+	keyOwnerType = tag.MustNewKey("owner_type")
+
+	mInflightRequests = stats.Int64(
+		"hub/rpc/inflight_requests",
+		"Number of requests being handled",
+		stats.UnitDimensionless,
+	)
+)
+
+// ownerTypeLabel returns the metric label for an owner type.
+// Requests without an account are labeled "none".
+func ownerTypeLabel(account *mdb.AccountCtx) string {
+	if account == nil || account.Owner() == nil {
+		return "none"
+	}
+	return accountTypeLabel(account.Owner().Type)
+}
+
+// accountTypeLabel returns the label for an account type.
+func accountTypeLabel(t mdb.AccountType) string {
+	switch t {
+	case mdb.Dev:
+		return "dev"
+	case mdb.Org:
+		return "org"
+	case mdb.User:
+		return "user"
+	default:
+		return "unknown"
+	}
+}
+
+// inflightRequests tracks the requests being handled by owner type, which is exported as a gauge,
+// and by owner key, which is only sampled in logs in order to bound metric cardinality.
+type inflightRequests struct {
+	lk      sync.Mutex
+	byType  map[string]int64
+	byOwner map[string]int64
+}
+
+func newInflightRequests() *inflightRequests {
+	return &inflightRequests{
+		byType:  make(map[string]int64),
+		byOwner: make(map[string]int64),
+	}
+}
+
+// start records a request that has entered its handler.
+// The returned func must be called when the handler exits.
+func (r *inflightRequests) start(ownerType, owner string) func() {
+	r.add(ownerType, owner, 1)
+	return func() {
+		r.add(ownerType, owner, -1)
+	}
+}
+
+func (r *inflightRequests) add(ownerType, owner string, n int64) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	r.byType[ownerType] += n
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(keyOwnerType, ownerType),
+	}, mInflightRequests.M(r.byType[ownerType]))
+	if owner == "" {
+		return
+	}
+	r.byOwner[owner] += n
+	if r.byOwner[owner] <= 0 {
+		delete(r.byOwner, owner)
+	}
+}
+
+// ownerRequests is the number of requests being handled for an owner.
+type ownerRequests struct {
+	owner string
+	count int64
+}
+
+// busiest returns up to n owners with the most requests being handled, busiest first.
+func (r *inflightRequests) busiest(n int) []ownerRequests {
+	r.lk.Lock()
+	list := make([]ownerRequests, 0, len(r.byOwner))
+	for owner, count := range r.byOwner {
+		list = append(list, ownerRequests{owner: owner, count: count})
+	}
+	r.lk.Unlock()
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].count == list[j].count {
+			return list[i].owner < list[j].owner
+		}
+		return list[i].count > list[j].count
+	})
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
+
+// sampleBusiest logs the n busiest owners, labeled by label, on interval until ctx is done.
+func (r *inflightRequests) sampleBusiest(ctx context.Context, interval time.Duration, n int, label ownerLabeler) {
+	if n <= 0 {
+		n = defaultBusyOwnerSampleSize
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			busiest := r.busiest(n)
+			if len(busiest) == 0 {
+				continue
+			}
+			parts := make([]string, len(busiest))
+			for i, o := range busiest {
+				parts[i] = fmt.Sprintf("%s=%d", label(o.owner), o.count)
+			}
+			log.Infof("busiest owners by in-flight requests: %s", strings.Join(parts, " "))
+		}
+	}
+}
+
+// inflightUnaryServerInterceptor tracks in-flight unary requests.
+func inflightUnaryServerInterceptor(r *inflightRequests, normalize KeyNormalizer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		defer r.start(requestOwner(ctx, normalize))()
+		return handler(ctx, req)
+	}
+}
+
+// inflightStreamServerInterceptor tracks in-flight stream requests.
+func inflightStreamServerInterceptor(r *inflightRequests, normalize KeyNormalizer) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		defer r.start(requestOwner(stream.Context(), normalize))()
+		return handler(srv, stream)
+	}
+}
+
+// requestOwner returns the owner type label and normalized owner key of the account in ctx.
+func requestOwner(ctx context.Context, normalize KeyNormalizer) (string, string) {
+	account, ok := mdb.AccountFromContext(ctx)
+	if !ok || account.Owner() == nil {
+		return ownerTypeLabel(nil), ""
+	}
+	return ownerTypeLabel(account), normalize(account.Owner().Key).String()
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"google.golang.org/grpc"
+)
+
+func TestInflightUnaryServerInterceptor_Gauge(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	requests := newInflightRequests()
+	intercept := inflightUnaryServerInterceptor(requests, CanonicalPubKey)
+	dev := newTestDev(t)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := intercept(newTestAccountContext(dev), nil, &grpc.UnaryServerInfo{FullMethod: pushPathMethod},
+			func(context.Context, interface{}) (interface{}, error) {
+				close(entered)
+				<-release
+				return nil, nil
+			})
+		done <- err
+	}()
+
+	// The gauge rises while the request is in-flight.
+	<-entered
+	assert.Equal(t, float64(1), inflightGauge(t, "dev"))
+	busiest := requests.busiest(10)
+	require.Len(t, busiest, 1)
+	assert.Equal(t, dev.Key.String(), busiest[0].owner)
+	assert.Equal(t, int64(1), busiest[0].count)
+
+	// And falls after it's handled.
+	close(release)
+	require.NoError(t, <-done)
+	assert.Equal(t, float64(0), inflightGauge(t, "dev"))
+	assert.Empty(t, requests.busiest(10))
+}
+
+func TestInflightStreamServerInterceptor_Gauge(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	requests := newInflightRequests()
+	intercept := inflightStreamServerInterceptor(requests, CanonicalPubKey)
+
+	var during float64
+	err := intercept(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: listenMethod},
+		func(interface{}, grpc.ServerStream) error {
+			during = inflightGauge(t, "none")
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), during)
+	assert.Equal(t, float64(0), inflightGauge(t, "none"))
+}
+
+func TestInflightRequests_Busiest(t *testing.T) {
+	requests := newInflightRequests()
+	var dones []func()
+	for owner, n := range map[string]int{"a": 1, "b": 3, "c": 2} {
+		for i := 0; i < n; i++ {
+			dones = append(dones, requests.start("dev", owner))
+		}
+	}
+
+	busiest := requests.busiest(2)
+	require.Len(t, busiest, 2)
+	assert.Equal(t, ownerRequests{owner: "b", count: 3}, busiest[0])
+	assert.Equal(t, ownerRequests{owner: "c", count: 2}, busiest[1])
+
+	for _, done := range dones {
+		done()
+	}
+	assert.Empty(t, requests.busiest(2))
+}
+
+// inflightGauge returns the in-flight requests gauge value for an owner type.
+func inflightGauge(t *testing.T, ownerType string) float64 {
+	rows, err := view.RetrieveData(mInflightRequests.Name())
+	require.NoError(t, err)
+	for _, row := range rows {
+		for _, tg := range row.Tags {
+			if tg.Key == keyOwnerType && tg.Value == ownerType {
+				return row.Data.(*view.LastValueData).Value
+			}
+		}
+	}
+	t.Fatalf("no in-flight requests recorded for owner type %s", ownerType)
+	return 0
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	upb "github.com/textileio/textile/v2/api/usersd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLimits returns every limit enforced for the owner in ctx along with their current usage,
+// i.e., daily quotas, burst limits, the in-flight egress limit, bucket and thread limits, and
+// storage caps. Limits that are not enforced for the owner are omitted.
+func (t *Textile) GetLimits(ctx context.Context) (*upb.GetLimitsResponse, error) {
+	account, ok := mdb.AccountFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Account is required")
+	}
+	ownerKey := t.ownerKey(ctx, account)
+	key := ownerKey.String()
+	now := t.now()
+
+	res := &upb.GetLimitsResponse{
+		MaxBucketArchiveRepFactor: int32(t.conf.MaxBucketArchiveRepFactor),
+	}
+	var cus *pb.GetCustomerResponse
+	if t.bc != nil {
+		var err error
+		cus, err = t.bc.GetCustomer(ctx, ownerKey)
+		if err != nil && !strings.Contains(err.Error(), mongo.ErrNoDocuments.Error()) {
+			return nil, err
+		}
+	}
+	if cus != nil {
+		cus = t.applyPendingUsage(ownerKey, cus)
+		cus = t.applyResellerMultiplier(cus)
+		cus = t.applyTrialPolicy(cus)
+		exempt := t.isNewAccount(account.Owner(), now)
+		res.Billable = cus.Billable
+		res.GracePeriodEnd = cus.GracePeriodEnd
+		res.DailyQuotas = make(map[string]*upb.QuotaLimit)
+		for k, u := range cus.DailyUsage {
+			res.DailyQuotas[k] = &upb.QuotaLimit{
+				Used:      u.Total,
+				Free:      u.Free,
+				Grace:     u.Grace,
+				Exhausted: !exempt && t.usageExhausted(cus, k, now),
+			}
+		}
+		if t.inflight != nil {
+			if limit, ok := t.egressLimit(cus, now); ok {
+				res.InflightEgress = &upb.Limit{Limit: limit, Used: t.inflight.get(key)}
+			}
+		}
+		if limit, ok := t.conf.BillableStorageCaps[key]; ok && cus.Billable {
+			res.StoredDataCap = &upb.Limit{Limit: limit, Used: cus.DailyUsage["stored_data"].Total}
+		}
+	}
+	if t.bursts != nil {
+		res.BurstWindow = int64(t.bursts.window.Seconds())
+		res.BurstLimits = make(map[string]*upb.Limit)
+		for k, limit := range t.bursts.limits {
+			if limit <= 0 {
+				continue
+			}
+			if cus != nil {
+				t.syncBurstDay(ownerKey, cus, k)
+			}
+			res.BurstLimits[k] = &upb.Limit{Limit: int64(limit), Used: int64(t.bursts.count(key, k, now))}
+		}
+	}
+	if limit := t.maxBucketsPerOwner(res.Billable); limit > 0 {
+		res.Buckets = &upb.Limit{Limit: int64(limit)}
+		if t.bucketCount != nil {
+			count, err := t.bucketCount.OwnerBucketCount(ctx, account.Owner().Key)
+			if err != nil {
+				return nil, fmt.Errorf("counting buckets for %s: %v", account.Owner().Key, err)
+			}
+			res.Buckets.Used = int64(count)
+		}
+	}
+	if limit := t.conf.MaxNumberThreadsPerOwner; limit > 0 {
+		res.Threads = &upb.Limit{Limit: int64(limit)}
+		if t.collections != nil && t.collections.Threads != nil {
+			thds, err := t.collections.Threads.ListByOwner(ctx, account.Owner().Key)
+			if err != nil {
+				return nil, fmt.Errorf("listing threads for %s: %v", account.Owner().Key, err)
+			}
+			res.Threads.Used = int64(len(thds))
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	upb "github.com/textileio/textile/v2/api/usersd/pb"
+)
+
+func TestGetLimits(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	buckets := &testBucketCounter{counts: make(map[string]int)}
+	tx := &Textile{
+		bc:          bc,
+		clock:       clock,
+		inflight:    newInflightEgress(),
+		bucketCount: buckets,
+		bursts:      newBurstLimiter(time.Minute, map[string]int{"instance_reads": 100, "instance_writes": 10}),
+		conf: Config{
+			MaxNumberBucketsPerOwner:         5,
+			MaxNumberBucketsPerBillableOwner: 50,
+			MaxNumberThreadsPerOwner:         20,
+			MaxBucketArchiveRepFactor:        4,
+		},
+	}
+
+	// Free owners.
+	free := newTestDev(t)
+	cus := newTestCustomer(free.Key)
+	cus.DailyUsage["instance_reads"].Total = 100
+	cus.DailyUsage["instance_reads"].Free = 49900
+	bc.setCustomer(cus)
+	buckets.counts[free.Key.String()] = 3
+	require.True(t, tx.inflight.reserve(free.Key.String(), mib, 10*gib))
+	for i := 0; i < 2; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(free), findMethod)
+		require.NoError(t, err)
+	}
+
+	res, err := tx.GetLimits(newTestAccountContext(free))
+	require.NoError(t, err)
+	assert.False(t, res.Billable)
+	assert.Equal(t, &upb.QuotaLimit{Used: 100, Free: 49900, Grace: 1000000}, res.DailyQuotas["instance_reads"])
+	assert.Equal(t, &upb.QuotaLimit{Free: 5 * gib, Grace: 1000 * gib}, res.DailyQuotas["stored_data"])
+	assert.Len(t, res.DailyQuotas, 4)
+	assert.Equal(t, int64(60), res.BurstWindow)
+	assert.Equal(t, &upb.Limit{Limit: 100, Used: 2}, res.BurstLimits["instance_reads"])
+	assert.Equal(t, &upb.Limit{Limit: 10}, res.BurstLimits["instance_writes"])
+	assert.Equal(t, &upb.Limit{Limit: 10 * gib, Used: mib}, res.InflightEgress)
+	assert.Equal(t, &upb.Limit{Limit: 5, Used: 3}, res.Buckets)
+	assert.Equal(t, &upb.Limit{Limit: 20}, res.Threads)
+	assert.Nil(t, res.StoredDataCap)
+	assert.Equal(t, int32(4), res.MaxBucketArchiveRepFactor)
+
+	// Billable owners.
+	billable := newTestDev(t)
+	cus = newTestCustomer(billable.Key)
+	cus.Billable = true
+	cus.DailyUsage["stored_data"].Total = 8 * gib
+	cus.DailyUsage["stored_data"].Free = 0
+	bc.setCustomer(cus)
+	tx.conf.BillableStorageCaps = map[string]int64{billable.Key.String(): 10 * gib}
+
+	res, err = tx.GetLimits(newTestAccountContext(billable))
+	require.NoError(t, err)
+	assert.True(t, res.Billable)
+	assert.False(t, res.DailyQuotas["stored_data"].Exhausted)
+	assert.Nil(t, res.InflightEgress)
+	assert.Equal(t, &upb.Limit{Limit: 50}, res.Buckets)
+	assert.Equal(t, &upb.Limit{Limit: 10 * gib, Used: 8 * gib}, res.StoredDataCap)
+}
+
+func TestGetLimits_Exhausted(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_writes"].Free = 0
+	bc.setCustomer(cus)
+
+	res, err := tx.GetLimits(newTestAccountContext(dev))
+	require.NoError(t, err)
+	assert.True(t, res.DailyQuotas["instance_writes"].Exhausted)
+	assert.False(t, res.DailyQuotas["instance_reads"].Exhausted)
+
+	// Unconfigured limits are omitted.
+	assert.Nil(t, res.BurstLimits)
+	assert.Nil(t, res.Buckets)
+	assert.Nil(t, res.Threads)
+
+	// Customers that don't exist yet have no quotas.
+	res, err = tx.GetLimits(newTestAccountContext(newTestDev(t)))
+	require.NoError(t, err)
+	assert.Empty(t, res.DailyQuotas)
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tpb "github.com/textileio/go-threads/api/pb"
+	"google.golang.org/grpc"
+)
+
+const listenMethod = "/threads.pb.API/Listen"
+
+// defaultListenReadInterval is the stream duration that consumes one instance read
+// when Listen is metered by duration.
+const defaultListenReadInterval = time.Minute
+
+// listenMetering is how a Listen stream consumes instance_reads.
+type listenMetering int
+
+const (
+	// listenMeteringMessage consumes one read for each instance sent to the listener.
+	listenMeteringMessage listenMetering = iota
+	// listenMeteringStart consumes one read when the stream is opened.
+	listenMeteringStart
+	// listenMeteringDuration consumes one read for each started interval the stream is open.
+	listenMeteringDuration
+)
+
+func parseListenMetering(mode string) (listenMetering, error) {
+	switch strings.ToLower(mode) {
+	case "", "message":
+		return listenMeteringMessage, nil
+	case "start":
+		return listenMeteringStart, nil
+	case "duration":
+		return listenMeteringDuration, nil
+	default:
+		return 0, fmt.Errorf("invalid listen metering mode: %s", mode)
+	}
+}
+
+// listenMeter tallies the instance reads consumed by a Listen stream.
+type listenMeter struct {
+	mode     listenMetering
+	started  time.Time
+	interval time.Duration
+	messages int64
+}
+
+func (t *Textile) newListenMeter(now time.Time) *listenMeter {
+	interval := t.conf.ListenReadInterval
+	if interval <= 0 {
+		interval = defaultListenReadInterval
+	}
+	return &listenMeter{mode: t.listenMode, started: now, interval: interval}
+}
+
+func newListenMeterContext(ctx context.Context, meter *listenMeter) context.Context {
+	return context.WithValue(ctx, usageCtxKey("listenMeter"), meter)
+}
+
+func listenMeterFromContext(ctx context.Context) (*listenMeter, bool) {
+	meter, ok := ctx.Value(usageCtxKey("listenMeter")).(*listenMeter)
+	return meter, ok
+}
+
+// reads returns the number of instance reads consumed by the stream as of now.
+func (m *listenMeter) reads(now time.Time) int64 {
+	switch m.mode {
+	case listenMeteringStart:
+		return 1
+	case listenMeteringDuration:
+		elapsed := now.Sub(m.started)
+		if elapsed <= 0 {
+			return 1
+		}
+		return int64((elapsed + m.interval - 1) / m.interval)
+	default:
+		return atomic.LoadInt64(&m.messages)
+	}
+}
+
+// listenServerStream wraps a Listen stream, counting the instances that are successfully sent.
+type listenServerStream struct {
+	grpc.ServerStream
+	meter *listenMeter
+}
+
+func (s *listenServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if reply, ok := m.(*tpb.ListenReply); ok && reply.Instance != nil {
+		atomic.AddInt64(&s.meter.messages, 1)
+	}
+	return nil
+}
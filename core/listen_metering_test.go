@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tpb "github.com/textileio/go-threads/api/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamServerInterceptor_ListenMetering(t *testing.T) {
+	tests := []struct {
+		mode  string
+		reads int64
+	}{
+		{mode: "start", reads: 1},
+		{mode: "message", reads: 3},
+		{mode: "duration", reads: 3},
+	}
+	for _, tc := range tests {
+		t.Run(tc.mode, func(t *testing.T) {
+			mode, err := parseListenMetering(tc.mode)
+			require.NoError(t, err)
+			clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, clock: clock, listenMode: mode}
+
+			dev := newTestDev(t)
+			bc.setCustomer(newTestCustomer(dev.Key))
+
+			// Three instances are sent over two and a half minutes before the client goes away.
+			handler := func(_ interface{}, ss grpc.ServerStream) error {
+				for i := 0; i < 3; i++ {
+					require.NoError(t, ss.SendMsg(&tpb.ListenReply{Instance: []byte("{}")}))
+				}
+				require.NoError(t, ss.SendMsg(&tpb.ListenReply{}))
+				clock.advance(150 * time.Second)
+				return status.Error(codes.Canceled, context.Canceled.Error())
+			}
+			stream := &testServerStream{ctx: newTestAccountContext(dev)}
+			info := &grpc.StreamServerInfo{FullMethod: listenMethod, IsServerStream: true}
+			err = streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+			require.Error(t, err)
+
+			incs := bc.getIncs()
+			require.Len(t, incs, 1)
+			assert.Equal(t, tc.reads, incs[0].usage["instance_reads"])
+		})
+	}
+}
+
+func TestPreUsageFunc_ListenMeteringExhausted(t *testing.T) {
+	for _, mode := range []listenMetering{listenMeteringStart, listenMeteringMessage, listenMeteringDuration} {
+		bc := newTestBillingClient()
+		tx := &Textile{bc: bc, listenMode: mode}
+
+		dev := newTestDev(t)
+		cus := newTestCustomer(dev.Key)
+		cus.DailyUsage["instance_reads"].Free = 0
+		bc.setCustomer(cus)
+
+		_, err := tx.preUsageFunc(newTestAccountContext(dev), listenMethod)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+}
+
+func TestParseListenMetering(t *testing.T) {
+	mode, err := parseListenMetering("")
+	require.NoError(t, err)
+	assert.Equal(t, listenMeteringMessage, mode)
+	_, err = parseListenMetering("forever")
+	require.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// listingEgress is how the network egress of bucket listing responses is metered.
+type listingEgress int
+
+const (
+	// listingEgressWire meters listings by the bytes written to the wire, like other responses.
+	listingEgressWire listingEgress = iota
+	// listingEgressSerialized meters listings by their serialized size, regardless of compression.
+	listingEgressSerialized
+	// listingEgressOff doesn't meter listings.
+	listingEgressOff
+)
+
+func parseListingEgress(mode string) (listingEgress, error) {
+	switch strings.ToLower(mode) {
+	case "", "wire":
+		return listingEgressWire, nil
+	case "serialized":
+		return listingEgressSerialized, nil
+	case "off":
+		return listingEgressOff, nil
+	default:
+		return 0, fmt.Errorf("invalid listing egress: %s", mode)
+	}
+}
+
+// listingEgress returns the network egress metered for a listing response that was sent
+// with wire bytes.
+func (t *Textile) listingEgress(payload interface{}, wire int64) int64 {
+	switch t.listingMode {
+	case listingEgressSerialized:
+		if msg, ok := payload.(proto.Message); ok {
+			return int64(proto.Size(msg) + msgHeaderLen)
+		}
+		return wire
+	case listingEgressOff:
+		return 0
+	default:
+		return wire
+	}
+}
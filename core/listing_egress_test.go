@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"google.golang.org/grpc/stats"
+)
+
+func TestStatsHandler_ListingEgress(t *testing.T) {
+	items := make([]*bpb.PathItem, 1000)
+	for i := range items {
+		items[i] = &bpb.PathItem{
+			Cid:  "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+			Name: fmt.Sprintf("file-%d", i),
+			Path: fmt.Sprintf("/dir/file-%d", i),
+			Size: 1024,
+		}
+	}
+	listing := &bpb.ListPathResponse{Item: &bpb.PathItem{IsDir: true, Items: items, ItemsCount: int32(len(items))}}
+	const wire = 4096 // Compressed on the wire
+	serialized := int64(proto.Size(listing) + msgHeaderLen)
+	require.Greater(t, serialized, int64(wire))
+
+	tests := []struct {
+		name   string
+		mode   listingEgress
+		egress int64
+	}{
+		{name: "wire", mode: listingEgressWire, egress: wire},
+		{name: "serialized", mode: listingEgressSerialized, egress: serialized},
+		{name: "off", mode: listingEgressOff, egress: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &StatsHandler{t: &Textile{bc: newTestBillingClient(), listingMode: tc.mode}}
+			dev := newTestDev(t)
+			rs := &requestStats{key: dev.Key}
+			ctx := context.WithValue(context.Background(), statsCtxKey("requestStats"), rs)
+			h.HandleRPC(ctx, &stats.OutPayload{Payload: listing, WireLength: wire})
+			assert.Equal(t, tc.egress, rs.egress)
+
+			// Other responses are always metered by wire bytes.
+			rs.egress = 0
+			h.HandleRPC(ctx, &stats.OutPayload{Payload: &bpb.PullPathAccessRolesResponse{}, WireLength: wire})
+			assert.Equal(t, int64(wire), rs.egress)
+		})
+	}
+}
+
+func TestParseListingEgress(t *testing.T) {
+	mode, err := parseListingEgress("")
+	require.NoError(t, err)
+	assert.Equal(t, listingEgressWire, mode)
+	mode, err = parseListingEgress("Serialized")
+	require.NoError(t, err)
+	assert.Equal(t, listingEgressSerialized, mode)
+	mode, err = parseListingEgress("off")
+	require.NoError(t, err)
+	assert.Equal(t, listingEgressOff, mode)
+	_, err = parseListingEgress("compressed")
+	require.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// priorityHeader is the request header clients can use to lower a request's priority,
+	// e.g., for background jobs.
+	priorityHeader = "x-textile-priority"
+	// defaultLowPriorityShare is the default fraction of the global request limit low-priority
+	// requests can use.
+	defaultLowPriorityShare = 0.8
+	// loadWindow is the window over which the global request limit is enforced.
+	loadWindow = time.Second
+)
+
+// errOverloaded is returned for requests shed by the global request limit.
+var errOverloaded = status.Error(codes.Unavailable, "server is overloaded, try again later")
+
+// requestPriority is the class used to decide which requests are shed first under load.
+type requestPriority int
+
+const (
+	priorityLow requestPriority = iota
+	priorityHigh
+)
+
+// requestPriority returns the priority of a request from an owner.
+// Billable owners and owners listed in Config.HighPriorityOwners are high priority unless
+// the request lowers its priority with the priority header. Requests can't raise their priority.
+func (t *Textile) requestPriority(
+	ctx context.Context,
+	account *mdb.AccountCtx,
+	cus *pb.GetCustomerResponse,
+) requestPriority {
+	if strings.ToLower(metautils.ExtractIncoming(ctx).Get(priorityHeader)) == "low" {
+		return priorityLow
+	}
+	if cus.Billable {
+		return priorityHigh
+	}
+	owner := t.ownerKey(ctx, account).String()
+	for _, k := range t.conf.HighPriorityOwners {
+		if k == owner {
+			return priorityHigh
+		}
+	}
+	return priorityLow
+}
+
+// loadShedder enforces a global request limit within a sliding window. Low-priority requests
+// are limited to a share of the limit so that they're shed before high-priority requests.
+type loadShedder struct {
+	limit    int
+	lowLimit int
+
+	lk     sync.Mutex
+	events []time.Time
+}
+
+func newLoadShedder(limit int, lowShare float64) *loadShedder {
+	if lowShare <= 0 || lowShare > 1 {
+		lowShare = defaultLowPriorityShare
+	}
+	return &loadShedder{
+		limit:    limit,
+		lowLimit: int(float64(limit) * lowShare),
+	}
+}
+
+// admit returns whether or not a request with priority at now is within the limit for its priority.
+// Admitted requests are recorded unless record is false, e.g., for dry runs.
+func (s *loadShedder) admit(priority requestPriority, now time.Time, record bool) bool {
+	limit := s.lowLimit
+	if priority == priorityHigh {
+		limit = s.limit
+	}
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	start := now.Add(-loadWindow)
+	i := 0
+	for i < len(s.events) && !s.events[i].After(start) {
+		i++
+	}
+	s.events = s.events[i:]
+	if len(s.events) >= limit {
+		return false
+	}
+	if record {
+		s.events = append(s.events, now)
+	}
+	return true
+}
+
+// checkLoad sheds a request if the global request limit for its priority has been reached.
+func (t *Textile) checkLoad(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	cus *pb.GetCustomerResponse,
+	now time.Time,
+) error {
+	if t.shedder == nil {
+		return nil
+	}
+	if !t.shedder.admit(t.requestPriority(ctx, account, cus), now, !isDryRun(ctx)) {
+		return t.deny(ctx, method, account, denialOverload, errOverloaded)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_LoadShedding(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{bc: bc, clock: clock, shedder: newLoadShedder(5, 0.6)}
+
+	free := newTestDev(t)
+	bc.setCustomer(newTestCustomer(free.Key))
+	billable := newTestDev(t)
+	cus := newTestCustomer(billable.Key)
+	cus.Billable = true
+	bc.setCustomer(cus)
+
+	// Low-priority requests are shed once they reach their share of the limit.
+	for i := 0; i < 3; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(free), findMethod)
+		require.NoError(t, err)
+	}
+	_, err := tx.preUsageFunc(newTestAccountContext(free), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// High-priority requests are still admitted up to the full limit.
+	for i := 0; i < 2; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(billable), findMethod)
+		require.NoError(t, err)
+	}
+	_, err = tx.preUsageFunc(newTestAccountContext(billable), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Load is measured over a sliding window.
+	clock.advance(loadWindow)
+	_, err = tx.preUsageFunc(newTestAccountContext(free), findMethod)
+	require.NoError(t, err)
+}
+
+func TestRequestPriority(t *testing.T) {
+	tx := &Textile{}
+	free := newTestDev(t)
+	listed := newTestDev(t)
+	tx.conf.HighPriorityOwners = []string{listed.Key.String()}
+
+	ctx := newTestAccountContext(free)
+	account, ok := mdb.AccountFromContext(ctx)
+	require.True(t, ok)
+	cus := newTestCustomer(free.Key)
+	assert.Equal(t, priorityLow, tx.requestPriority(ctx, account, cus))
+
+	// Billable owners are high priority unless the request lowers its priority.
+	cus.Billable = true
+	assert.Equal(t, priorityHigh, tx.requestPriority(ctx, account, cus))
+	lowered := metadata.NewIncomingContext(ctx, metadata.Pairs(priorityHeader, "low"))
+	assert.Equal(t, priorityLow, tx.requestPriority(lowered, account, cus))
+
+	// Requests can't raise their priority.
+	cus.Billable = false
+	raised := metadata.NewIncomingContext(ctx, metadata.Pairs(priorityHeader, "high"))
+	assert.Equal(t, priorityLow, tx.requestPriority(raised, account, cus))
+
+	// Listed owners are high priority.
+	ctx = newTestAccountContext(listed)
+	account, ok = mdb.AccountFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, priorityHigh, tx.requestPriority(ctx, account, newTestCustomer(listed.Key)))
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaintenanceMessage is returned to owners in a maintenance window that has no message.
+const defaultMaintenanceMessage = "account is undergoing maintenance"
+
+// MaintenanceWindow is a period during which an owner's requests are refused, e.g.,
+// while their data is migrated.
+type MaintenanceWindow struct {
+	// Start is when the window begins.
+	Start time.Time
+	// End is when the window ends and requests are accepted again.
+	End time.Time
+	// Message is returned to the owner with refused requests.
+	Message string
+}
+
+// contains returns whether or not now is within the window.
+func (w MaintenanceWindow) contains(now time.Time) bool {
+	return !now.Before(w.Start) && now.Before(w.End)
+}
+
+// checkMaintenance denies a request if the owner is in a scheduled maintenance window.
+func (t *Textile) checkMaintenance(ctx context.Context, method string, account *mdb.AccountCtx, now time.Time) error {
+	if len(t.conf.OwnerMaintenance) == 0 {
+		return nil
+	}
+	w, ok := t.conf.OwnerMaintenance[t.ownerKey(ctx, account).String()]
+	if !ok || !w.contains(now) {
+		return nil
+	}
+	msg := w.Message
+	if msg == "" {
+		msg = defaultMaintenanceMessage
+	}
+	err := fmt.Errorf("%s until %s", msg, w.End.UTC().Format(time.RFC3339))
+	return t.deny(ctx, method, account, denialMaintenance, status.Error(codes.Unavailable, err.Error()))
+}
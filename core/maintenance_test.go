@@ -0,0 +1,48 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_OwnerMaintenance(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := newTestClock(start.Add(-time.Minute))
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock}
+
+	migrating := newTestDev(t)
+	bc.setCustomer(newTestCustomer(migrating.Key))
+	other := newTestDev(t)
+	bc.setCustomer(newTestCustomer(other.Key))
+	tx.conf.OwnerMaintenance = map[string]MaintenanceWindow{
+		migrating.Key.String(): {
+			Start:   start,
+			End:     start.Add(time.Hour),
+			Message: "migrating data",
+		},
+	}
+
+	// Before the window.
+	_, err := tx.preUsageFunc(newTestAccountContext(migrating), pushPathMethod)
+	require.NoError(t, err)
+
+	// Inside the window, only the scheduled owner is refused.
+	clock.advance(2 * time.Minute)
+	_, err = tx.preUsageFunc(newTestAccountContext(migrating), pushPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "migrating data")
+	_, err = tx.preUsageFunc(newTestAccountContext(other), pushPathMethod)
+	require.NoError(t, err)
+
+	// Requests are accepted again once the window ends.
+	clock.advance(time.Hour)
+	_, err = tx.preUsageFunc(newTestAccountContext(migrating), pushPathMethod)
+	require.NoError(t, err)
+}
@@ -0,0 +1,36 @@
+package core
+
+// methodExemption is which of the auth and usage interceptors a method bypasses.
+type methodExemption int
+
+const (
+	// exemptNone methods are authenticated and metered.
+	exemptNone methodExemption = iota
+	// exemptUsage methods are authenticated but not metered, e.g., billing setup.
+	exemptUsage
+	// exemptAuth methods are neither authenticated nor metered, e.g., signup or admin methods.
+	exemptAuth
+)
+
+// methodExemption returns which interceptors method bypasses. A method in authIgnoredMethods skips
+// both auth and usage, even if it's also a usage ignored method, since usage can't be attributed
+// to a request without an account. Methods in usageIgnoredMethods or Config.UsageIgnoredMethods
+// are still authenticated.
+func (t *Textile) methodExemption(method string) methodExemption {
+	for _, ignored := range authIgnoredMethods {
+		if method == ignored {
+			return exemptAuth
+		}
+	}
+	for _, ignored := range usageIgnoredMethods {
+		if method == ignored {
+			return exemptUsage
+		}
+	}
+	for _, ignored := range t.conf.UsageIgnoredMethods {
+		if method == ignored {
+			return exemptUsage
+		}
+	}
+	return exemptNone
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTextile_MethodExemption(t *testing.T) {
+	tx := &Textile{conf: Config{UsageIgnoredMethods: []string{findMethod, "/api.hubd.pb.APIService/Signup"}}}
+	assert.Equal(t, exemptAuth, tx.methodExemption("/api.hubd.pb.APIService/Signup"))
+	assert.Equal(t, exemptUsage, tx.methodExemption("/api.hubd.pb.APIService/SetupBilling"))
+	assert.Equal(t, exemptUsage, tx.methodExemption(findMethod))
+	assert.Equal(t, exemptNone, tx.methodExemption(saveMethod))
+}
+
+func TestTextile_UsageIgnoredMethods(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{UsageIgnoredMethods: []string{findMethod}}}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	// The method still requires auth.
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &testMethodTransportStream{method: findMethod})
+	_, err := tx.authFunc(ctx)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// But authenticated requests aren't checked against quotas or metered.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	require.NoError(t, tx.postUsageFunc(ctx, findMethod))
+	assert.Empty(t, bc.getIncs())
+
+	// Other methods are still metered.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Has")
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// testMethodTransportStream is a grpc.ServerTransportStream for method.
+type testMethodTransportStream struct {
+	testTransportStream
+	method string
+}
+
+func (s *testMethodTransportStream) Method() string { return s.method }
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// methodValidation is how hardcoded method names that aren't registered with the server are handled.
+// Such names usually mean a proto package was renamed, which silently changes how its methods are
+// authenticated and metered.
+type methodValidation int
+
+const (
+	// methodValidationOff doesn't validate method names.
+	methodValidationOff methodValidation = iota
+	// methodValidationLog logs unknown method names.
+	methodValidationLog
+	// methodValidationFail fails startup if there are unknown method names.
+	methodValidationFail
+)
+
+func parseMethodValidation(mode string) (methodValidation, error) {
+	switch strings.ToLower(mode) {
+	case "off":
+		return methodValidationOff, nil
+	case "", "log":
+		return methodValidationLog, nil
+	case "fail":
+		return methodValidationFail, nil
+	default:
+		return 0, fmt.Errorf("invalid method validation: %s", mode)
+	}
+}
+
+// unknownMethods returns the methods in the ignore lists, usage policy, and promotions that
+// aren't registered with the server, keyed by where they're listed.
+func (t *Textile) unknownMethods() map[string][]string {
+	lists := map[string][]string{
+		"auth ignored methods":             authIgnoredMethods,
+		"usage ignored methods":            usageIgnoredMethods,
+		"egress stream methods":            egressStreamMethods,
+		"blocked methods":                  blockMethods,
+		"configured usage ignored methods": t.conf.UsageIgnoredMethods,
+	}
+	for m := range t.usagePolicy() {
+		lists["usage policy"] = append(lists["usage policy"], m)
+	}
+	for ownerType, methods := range t.conf.OwnerIgnoredMethods {
+		name := accountTypeLabel(ownerType) + " ignored methods"
+		lists[name] = append(lists[name], methods...)
+	}
+	for m := range t.conf.MethodPromotions {
+		lists["method promotions"] = append(lists["method promotions"], m)
+	}
+	unknown := make(map[string][]string)
+	for name, methods := range lists {
+		for _, m := range methods {
+			if !t.isKnownMethod(m) {
+				unknown[name] = append(unknown[name], m)
+			}
+		}
+		sort.Strings(unknown[name])
+	}
+	return unknown
+}
+
+// validateMethods checks that hardcoded method names are registered with the server.
+func (t *Textile) validateMethods(mode methodValidation) error {
+	if mode == methodValidationOff {
+		return nil
+	}
+	unknown := t.unknownMethods()
+	if len(unknown) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s: %s", name, strings.Join(unknown[name], ", "))
+	}
+	msg := fmt.Sprintf("unknown methods in %s", strings.Join(parts, "; "))
+	if mode == methodValidationFail {
+		return errors.New(msg)
+	}
+	log.Warn(msg)
+	return nil
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbpb "github.com/textileio/go-threads/api/pb"
+	netpb "github.com/textileio/go-threads/net/api/pb"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	hpb "github.com/textileio/textile/v2/api/hubd/pb"
+	upb "github.com/textileio/textile/v2/api/usersd/pb"
+	"google.golang.org/grpc"
+)
+
+func TestValidateMethods(t *testing.T) {
+	// Hardcoded methods match the services registered by a hub.
+	tx := newTestHubServer()
+	assert.Empty(t, tx.unknownMethods())
+	require.NoError(t, tx.validateMethods(methodValidationFail))
+
+	// A bogus method, e.g., from a renamed proto package, is detected.
+	tx.conf.MethodPromotions = map[string]Promotion{"/api.bucketsd.v2.APIService/PushPath": {}}
+	assert.Equal(t, map[string][]string{
+		"method promotions": {"/api.bucketsd.v2.APIService/PushPath"},
+	}, tx.unknownMethods())
+	err := tx.validateMethods(methodValidationFail)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/api.bucketsd.v2.APIService/PushPath")
+	require.NoError(t, tx.validateMethods(methodValidationLog))
+	require.NoError(t, tx.validateMethods(methodValidationOff))
+}
+
+func TestParseMethodValidation(t *testing.T) {
+	mode, err := parseMethodValidation("")
+	require.NoError(t, err)
+	assert.Equal(t, methodValidationLog, mode)
+	mode, err = parseMethodValidation("FAIL")
+	require.NoError(t, err)
+	assert.Equal(t, methodValidationFail, mode)
+	_, err = parseMethodValidation("bogus")
+	require.Error(t, err)
+}
+
+// newTestHubServer returns a Textile whose server has the same services as a hub.
+func newTestHubServer() *Textile {
+	tx := &Textile{server: grpc.NewServer()}
+	dbpb.RegisterAPIServer(tx.server, &dbpb.UnimplementedAPIServer{})
+	netpb.RegisterAPIServer(tx.server, &netpb.UnimplementedAPIServer{})
+	hpb.RegisterAPIServiceServer(tx.server, &hpb.UnimplementedAPIServiceServer{})
+	upb.RegisterAPIServiceServer(tx.server, &upb.UnimplementedAPIServiceServer{})
+	bpb.RegisterAPIServiceServer(tx.server, &bpb.UnimplementedAPIServiceServer{})
+	tx.setKnownMethods()
+	return tx
+}
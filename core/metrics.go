@@ -0,0 +1,225 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	keyMethod = tag.MustNewKey("method")
+	keyCode   = tag.MustNewKey("code")
+
+	mLatencySuccess = stats.Float64(
+		"hub/rpc/success_latency",
+		"Latency of successful requests",
+		stats.UnitMilliseconds,
+	)
+	mLatencyError = stats.Float64(
+		"hub/rpc/error_latency",
+		"Latency of failed requests",
+		stats.UnitMilliseconds,
+	)
+
+	mRequestSize = stats.Int64(
+		"hub/rpc/request_size",
+		"Size of request messages",
+		stats.UnitBytes,
+	)
+	mResponseSize = stats.Int64(
+		"hub/rpc/response_size",
+		"Size of response messages",
+		stats.UnitBytes,
+	)
+
+	latencyDistribution = view.Distribution(
+		1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000,
+	)
+	sizeDistribution = view.Distribution(
+		0, 256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216, 67108864,
+	)
+
+	// metricViews are registered when the hub starts.
+	metricViews = []*view.View{
+		{
+			Name:        mLatencySuccess.Name(),
+			Measure:     mLatencySuccess,
+			Description: mLatencySuccess.Description(),
+			TagKeys:     []tag.Key{keyMethod},
+			Aggregation: latencyDistribution,
+		},
+		{
+			Name:        mLatencyError.Name(),
+			Measure:     mLatencyError,
+			Description: mLatencyError.Description(),
+			TagKeys:     []tag.Key{keyMethod, keyCode},
+			Aggregation: latencyDistribution,
+		},
+		{
+			Name:        mRequestSize.Name(),
+			Measure:     mRequestSize,
+			Description: mRequestSize.Description(),
+			TagKeys:     []tag.Key{keyMethod},
+			Aggregation: sizeDistribution,
+		},
+		{
+			Name:        mResponseSize.Name(),
+			Measure:     mResponseSize,
+			Description: mResponseSize.Description(),
+			TagKeys:     []tag.Key{keyMethod},
+			Aggregation: sizeDistribution,
+		},
+		{
+			Name:        mInflightRequests.Name(),
+			Measure:     mInflightRequests,
+			Description: mInflightRequests.Description(),
+			TagKeys:     []tag.Key{keyOwnerType},
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mQuotaHeadroom.Name(),
+			Measure:     mQuotaHeadroom,
+			Description: mQuotaHeadroom.Description(),
+			TagKeys:     []tag.Key{keyUsageKey},
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mQuotaHeadroomOwners.Name(),
+			Measure:     mQuotaHeadroomOwners,
+			Description: mQuotaHeadroomOwners.Description(),
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mDecisionsDropped.Name(),
+			Measure:     mDecisionsDropped,
+			Description: mDecisionsDropped.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+)
+
+// knownMethodFunc reports whether or not a method is registered with the server.
+// Only known methods are recorded in order to bound metric cardinality.
+type knownMethodFunc func(method string) bool
+
+// metricsUnaryServerInterceptor records handler latency and message sizes for unary methods.
+func metricsUnaryServerInterceptor(known knownMethodFunc, clock Clock) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := clock.Now()
+		res, err := handler(ctx, req)
+		recordLatency(ctx, known, info.FullMethod, clock.Now().Sub(start), err)
+		if known(info.FullMethod) {
+			recordSize(ctx, info.FullMethod, mRequestSize, req)
+			if err == nil {
+				recordSize(ctx, info.FullMethod, mResponseSize, res)
+			}
+		}
+		return res, err
+	}
+}
+
+// metricsStreamServerInterceptor records handler latency and message sizes for streaming methods.
+// The size of each message received and sent on the stream is recorded.
+func metricsStreamServerInterceptor(known knownMethodFunc, clock Clock) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := clock.Now()
+		if known(info.FullMethod) {
+			stream = &sizedServerStream{ServerStream: stream, method: info.FullMethod}
+		}
+		err := handler(srv, stream)
+		recordLatency(stream.Context(), known, info.FullMethod, clock.Now().Sub(start), err)
+		return err
+	}
+}
+
+// sizedServerStream records the size of messages received and sent on a stream.
+type sizedServerStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (s *sizedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	recordSize(s.Context(), s.method, mRequestSize, m)
+	return nil
+}
+
+func (s *sizedServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	recordSize(s.Context(), s.method, mResponseSize, m)
+	return nil
+}
+
+// sizer is implemented by messages that expose their encoded size.
+type sizer interface {
+	Size() int
+}
+
+// recordSize records the encoded size of a message. Messages that don't expose their size aren't recorded.
+func recordSize(ctx context.Context, method string, m *stats.Int64Measure, msg interface{}) {
+	var size int
+	switch msg := msg.(type) {
+	case sizer:
+		size = msg.Size()
+	case proto.Message:
+		size = proto.Size(msg)
+	default:
+		return
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(keyMethod, method),
+	}, m.M(int64(size)))
+}
+
+func recordLatency(ctx context.Context, known knownMethodFunc, method string, d time.Duration, err error) {
+	if !known(method) {
+		return
+	}
+	ms := float64(d) / float64(time.Millisecond)
+	if err == nil {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{
+			tag.Upsert(keyMethod, method),
+		}, mLatencySuccess.M(ms))
+	} else {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{
+			tag.Upsert(keyMethod, method),
+			tag.Upsert(keyCode, status.Code(err).String()),
+		}, mLatencyError.M(ms))
+	}
+}
+
+// isKnownMethod returns whether or not a method is registered with the gRPC server.
+func (t *Textile) isKnownMethod(method string) bool {
+	_, ok := t.knownMethods[method]
+	return ok
+}
+
+// setKnownMethods caches the full names of all methods registered with the gRPC server.
+func (t *Textile) setKnownMethods() {
+	t.knownMethods = make(map[string]struct{})
+	for name, info := range t.server.GetServiceInfo() {
+		for _, m := range info.Methods {
+			t.knownMethods["/"+name+"/"+m.Name] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsUnaryServerInterceptor_Latency(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	known := func(method string) bool {
+		return method != "/unknown/Method"
+	}
+	interceptor := metricsUnaryServerInterceptor(known, realClock{})
+
+	slow := func(context.Context, interface{}) (interface{}, error) {
+		time.Sleep(50 * time.Millisecond)
+		return "ok", nil
+	}
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.API/Slow"}, slow)
+	require.NoError(t, err)
+
+	failing := func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.API/Fail"}, failing)
+	require.Error(t, err)
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/unknown/Method"}, failing)
+	require.Error(t, err)
+
+	rows, err := view.RetrieveData(mLatencySuccess.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []tag.Tag{{Key: keyMethod, Value: "/test.API/Slow"}}, rows[0].Tags)
+	dist := rows[0].Data.(*view.DistributionData)
+	assert.Equal(t, int64(1), dist.Count)
+	assert.GreaterOrEqual(t, dist.Min, float64(50))
+
+	rows, err = view.RetrieveData(mLatencyError.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.ElementsMatch(t, []tag.Tag{
+		{Key: keyMethod, Value: "/test.API/Fail"},
+		{Key: keyCode, Value: codes.NotFound.String()},
+	}, rows[0].Tags)
+	assert.Equal(t, int64(1), rows[0].Data.(*view.DistributionData).Count)
+}
+
+func TestMetricsStreamServerInterceptor_Latency(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	interceptor := metricsStreamServerInterceptor(func(string) bool { return true }, realClock{})
+	stream := &testServerStream{ctx: context.Background()}
+	failing := func(interface{}, grpc.ServerStream) error {
+		return status.Error(codes.ResourceExhausted, "exhausted")
+	}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: pullPathMethod}, failing)
+	require.Error(t, err)
+
+	rows, err := view.RetrieveData(mLatencyError.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.ElementsMatch(t, []tag.Tag{
+		{Key: keyMethod, Value: pullPathMethod},
+		{Key: keyCode, Value: codes.ResourceExhausted.String()},
+	}, rows[0].Tags)
+
+	rows, err = view.RetrieveData(mLatencySuccess.Name())
+	require.NoError(t, err)
+	assert.Len(t, rows, 0)
+}
+
+func TestMetricsStreamServerInterceptor_Size(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	interceptor := metricsStreamServerInterceptor(func(string) bool { return true }, realClock{})
+	stream := &testServerStream{ctx: context.Background()}
+	push := func(_ interface{}, stream grpc.ServerStream) error {
+		for _, req := range []*bpb.PushPathRequest{
+			{Payload: &bpb.PushPathRequest_Header_{Header: &bpb.PushPathRequest_Header{Key: "key", Path: "file"}}},
+			{Payload: &bpb.PushPathRequest_Chunk{Chunk: make([]byte, mib)}},
+		} {
+			if err := stream.RecvMsg(req); err != nil {
+				return err
+			}
+		}
+		return stream.SendMsg(&bpb.PushPathResponse{})
+	}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: pushPathMethod}, push)
+	require.NoError(t, err)
+
+	rows, err := view.RetrieveData(mRequestSize.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []tag.Tag{{Key: keyMethod, Value: pushPathMethod}}, rows[0].Tags)
+	dist := rows[0].Data.(*view.DistributionData)
+	assert.Equal(t, int64(2), dist.Count)
+	assert.GreaterOrEqual(t, dist.Max, float64(mib))
+	assert.Less(t, dist.Max, float64(mib+16))
+
+	rows, err = view.RetrieveData(mResponseSize.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(1), rows[0].Data.(*view.DistributionData).Count)
+}
+
+func TestMetricsUnaryServerInterceptor_Size(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	known := func(method string) bool {
+		return method != "/unknown/Method"
+	}
+	interceptor := metricsUnaryServerInterceptor(known, realClock{})
+	req := &bpb.SetPathRequest{Key: "key", Path: "file"}
+	ok := func(context.Context, interface{}) (interface{}, error) {
+		return &bpb.SetPathResponse{}, nil
+	}
+	_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/test.API/Set"}, ok)
+	require.NoError(t, err)
+	_, err = interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/unknown/Method"}, ok)
+	require.NoError(t, err)
+
+	rows, err := view.RetrieveData(mRequestSize.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []tag.Tag{{Key: keyMethod, Value: "/test.API/Set"}}, rows[0].Tags)
+	assert.Equal(t, float64(proto.Size(req)), rows[0].Data.(*view.DistributionData).Max)
+}
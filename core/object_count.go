@@ -0,0 +1,27 @@
+package core
+
+import "github.com/textileio/textile/v2/api/billingd/pb"
+
+// addsObjects returns whether or not a request to method may add objects to a bucket.
+// The change in the owner's object count is reported by the bucket handler with the
+// request's storage delta, see buckets.BucketOwner.
+func addsObjects(method string) bool {
+	switch method {
+	case "/api.bucketsd.pb.APIService/PushPath",
+		"/api.bucketsd.pb.APIService/PushPaths",
+		"/api.bucketsd.pb.APIService/SetPath":
+		return true
+	default:
+		return false
+	}
+}
+
+// objectCountExhausted returns whether or not a request to method would add an object
+// when a non-billable owner already has the max number of objects.
+func (t *Textile) objectCountExhausted(method string, cus *pb.GetCustomerResponse) bool {
+	limit := t.conf.MaxNumberObjectsPerOwner
+	if limit <= 0 || cus.Billable || !addsObjects(method) {
+		return false
+	}
+	return cus.DailyUsage["object_count"].GetTotal() >= limit
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_ObjectCountCap(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{MaxNumberObjectsPerOwner: 2}}
+
+	// The owner is at the object cap but well under the byte cap.
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["object_count"] = &pb.Usage{Total: 2}
+	cus.DailyUsage["stored_data"].Total = mib
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "object count exhausted")
+
+	// Objects can still be removed.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), removePathMethod)
+	require.NoError(t, err)
+
+	// Billable owners aren't capped.
+	cus.Billable = true
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+}
+
+func TestPostUsageFunc_ObjectCount(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{MaxNumberObjectsPerOwner: 2}}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	reqs := []struct {
+		method  string
+		stored  int64
+		objects int64
+	}{
+		{method: pushPathMethod, stored: mib, objects: 1},
+		{method: pushPathMethod, stored: mib}, // Overwrites an existing path
+		{method: pushPathsMethod, stored: 3 * mib, objects: 3},
+		{method: removeMethod, stored: -5 * mib, objects: -4},
+	}
+	for _, r := range reqs {
+		ctx, err := tx.preUsageFunc(newTestAccountContext(dev), r.method)
+		require.NoError(t, err)
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageDelta = r.stored
+		owner.ObjectDelta = r.objects
+		require.NoError(t, tx.postUsageFunc(ctx, r.method))
+	}
+
+	// Objects are counted with stored data as reported by the bucket handler.
+	incs := bc.getIncs()
+	require.Len(t, incs, len(reqs))
+	for i, r := range reqs {
+		objects, ok := incs[i].usage["object_count"]
+		assert.Equal(t, r.objects != 0, ok)
+		assert.Equal(t, r.objects, objects)
+	}
+}
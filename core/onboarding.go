@@ -0,0 +1,16 @@
+package core
+
+import (
+	"time"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// isNewAccount returns whether or not an owner's account is within the new account grace period,
+// during which usage is recorded but quotas are not enforced.
+func (t *Textile) isNewAccount(owner *mdb.Account, now time.Time) bool {
+	if t.conf.NewAccountGracePeriod <= 0 || owner == nil || owner.CreatedAt.IsZero() {
+		return false
+	}
+	return now.Before(owner.CreatedAt.Add(t.conf.NewAccountGracePeriod))
+}
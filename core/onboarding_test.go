@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_NewAccountGracePeriod(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := newTestClock(start)
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock, conf: Config{NewAccountGracePeriod: 24 * time.Hour}}
+
+	newDev := newTestDev(t)
+	newDev.CreatedAt = start.Add(-time.Hour)
+	oldDev := newTestDev(t)
+	oldDev.CreatedAt = start.Add(-48 * time.Hour)
+	newCus := newTestCustomer(newDev.Key)
+	newCus.DailyUsage["instance_reads"].Free = 0
+	newCus.DailyUsage["stored_data"].Free = 0
+	bc.setCustomer(newCus)
+	oldCus := newTestCustomer(oldDev.Key)
+	oldCus.DailyUsage["instance_reads"].Free = 0
+	oldCus.DailyUsage["stored_data"].Free = 0
+	bc.setCustomer(oldCus)
+
+	// The older account hits its quota.
+	_, err := tx.preUsageFunc(newTestAccountContext(oldDev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	ctx, err := tx.preUsageFunc(newTestAccountContext(oldDev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(0), owner.StorageAvailable)
+
+	// The new account bypasses the same quota, and its usage is still recorded.
+	_, err = tx.preUsageFunc(newTestAccountContext(newDev), findMethod)
+	require.NoError(t, err)
+	ctx, err = tx.preUsageFunc(newTestAccountContext(newDev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.True(t, owner.IsUnlimited())
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	assert.Equal(t, int64(mib), newCus.DailyUsage["stored_data"].Total)
+
+	// Once the account is older than the grace period, quotas apply.
+	clock.advance(23 * time.Hour)
+	_, err = tx.preUsageFunc(newTestAccountContext(newDev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -1,9 +1,13 @@
 package core
 
+import "go.opentelemetry.io/otel/trace"
+
 type Options struct {
 	ThreadsBadgerRepoPath string
 	ThreadsMongoUri       string
 	ThreadsMongoDB        string
+	Clock                 Clock
+	TracerProvider        trace.TracerProvider
 }
 
 type Option func(*Options)
@@ -20,3 +24,19 @@ func WithMongoThreadsPersistence(uri, db string) Option {
 		o.ThreadsMongoDB = db
 	}
 }
+
+// WithClock sets the clock used by time-based interceptor logic.
+// The system wall clock is used by default.
+func WithClock(clock Clock) Option {
+	return func(o *Options) {
+		o.Clock = clock
+	}
+}
+
+// WithTracerProvider enables request tracing with spans from the given provider.
+// Tracing is disabled by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *Options) {
+		o.TracerProvider = tp
+	}
+}
@@ -0,0 +1,16 @@
+package core
+
+import (
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// isOwnerIgnoredMethod returns whether or not method is not intercepted by the usage interceptor
+// for owners of ownerType.
+func (t *Textile) isOwnerIgnoredMethod(method string, ownerType mdb.AccountType) bool {
+	for _, ignored := range t.conf.OwnerIgnoredMethods[ownerType] {
+		if method == ignored {
+			return true
+		}
+	}
+	return false
+}
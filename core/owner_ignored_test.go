@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_OwnerIgnoredMethods(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{
+		OwnerIgnoredMethods: map[mdb.AccountType][]string{
+			mdb.Org: {findMethod},
+		},
+	}}
+
+	// Both owners have exhausted their reads.
+	user := newTestDev(t)
+	user.Type = mdb.User
+	org := newTestDev(t)
+	org.Type = mdb.Org
+	for _, a := range []*mdb.Account{user, org} {
+		cus := newTestCustomer(a.Key)
+		cus.DailyUsage["instance_reads"].Free = 0
+		bc.setCustomer(cus)
+	}
+
+	// The method is metered for users.
+	_, err := tx.preUsageFunc(newTestAccountContext(user), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// But ignored for orgs.
+	orgCtx := mdb.NewAccountContext(context.Background(), newTestDev(t), org)
+	_, err = tx.preUsageFunc(orgCtx, findMethod)
+	require.NoError(t, err)
+
+	// Other methods are still metered for orgs.
+	_, err = tx.preUsageFunc(orgCtx, "/threads.pb.API/Has")
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// KeyNormalizer maps an owner key to the form used for billing, so that a logical owner
+// is billed as a single customer regardless of how its key was encoded by the client.
+type KeyNormalizer func(key thread.PubKey) thread.PubKey
+
+// CanonicalPubKey re-decodes key from its binary form as a libp2p public key, whose string
+// form is base32. It's the default KeyNormalizer. key is returned as-is if it can't be re-decoded.
+func CanonicalPubKey(key thread.PubKey) thread.PubKey {
+	if key == nil {
+		return nil
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		return key
+	}
+	canonical := &thread.Libp2pPubKey{}
+	if err := canonical.UnmarshalBinary(raw); err != nil {
+		return key
+	}
+	return canonical
+}
+
+// normalizeKey returns key in the form used for billing.
+func (t *Textile) normalizeKey(key thread.PubKey) thread.PubKey {
+	normalize := t.conf.OwnerKeyNormalizer
+	if normalize == nil {
+		normalize = CanonicalPubKey
+	}
+	return normalize(key)
+}
+
+// OwnerResolver returns the key and type of the owner billed for a request made by account,
+// e.g., a project or workspace derived from request metadata. False is returned to bill the
+// account owner. It's called for each lookup of the billed owner, so it should be cheap.
+type OwnerResolver func(ctx context.Context, account *mdb.AccountCtx) (thread.PubKey, mdb.AccountType, bool)
+
+// billedOwner returns the normalized key and the type of the owner billed for a request made by
+// account, which is the account owner unless Config.OwnerResolver resolves another owner.
+func (t *Textile) billedOwner(ctx context.Context, account *mdb.AccountCtx) (thread.PubKey, mdb.AccountType) {
+	if t.conf.OwnerResolver != nil {
+		if key, typ, ok := t.conf.OwnerResolver(ctx, account); ok {
+			return t.normalizeKey(key), typ
+		}
+	}
+	return t.normalizeKey(account.Owner().Key), account.Owner().Type
+}
+
+// ownerKey returns the normalized key of the owner billed for a request made by account.
+func (t *Textile) ownerKey(ctx context.Context, account *mdb.AccountCtx) thread.PubKey {
+	key, _ := t.billedOwner(ctx, account)
+	return key
+}
+
+// ownerType returns the type of the owner billed for a request made by account.
+func (t *Textile) ownerType(ctx context.Context, account *mdb.AccountCtx) mdb.AccountType {
+	_, typ := t.billedOwner(ctx, account)
+	return typ
+}
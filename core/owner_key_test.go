@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/buckets"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/metadata"
+)
+
+// rawPubKey is a thread.PubKey whose string form is the hex-encoded raw key.
+type rawPubKey struct {
+	thread.PubKey
+}
+
+func (k rawPubKey) String() string {
+	raw, _ := k.MarshalBinary()
+	return hex.EncodeToString(raw)
+}
+
+func TestUsage_OwnerKeyNormalization(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	raw := rawPubKey{PubKey: dev.Key}
+	require.NotEqual(t, dev.Key.String(), raw.String())
+
+	// The same owner makes requests with differently-encoded keys.
+	for _, key := range []thread.PubKey{raw, dev.Key} {
+		account := *dev
+		account.Key = key
+		ctx, err := tx.preUsageFunc(newTestAccountContext(&account), pushPathMethod)
+		require.NoError(t, err)
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageDelta = mib
+		require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	}
+
+	require.Len(t, bc.customers, 1)
+	cus, ok := bc.customers[dev.Key.String()]
+	require.True(t, ok)
+	assert.Equal(t, int64(2*mib), cus.DailyUsage["stored_data"].Total)
+	for _, inc := range bc.getIncs() {
+		assert.Equal(t, dev.Key.String(), inc.key)
+	}
+}
+
+func TestUsage_CustomOwnerKeyNormalizer(t *testing.T) {
+	bc := newTestBillingClient()
+	billed := newTestDev(t)
+	tx := &Textile{bc: bc, conf: Config{
+		OwnerKeyNormalizer: func(thread.PubKey) thread.PubKey {
+			return billed.Key
+		},
+	}}
+	bc.setCustomer(newTestCustomer(billed.Key))
+
+	dev := newTestDev(t)
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, billed.Key.String(), incs[0].key)
+}
+
+func TestUsage_OwnerResolver(t *testing.T) {
+	bc := newTestBillingClient()
+	workspace := newTestDev(t)
+	// Requests are billed to the workspace named in their metadata.
+	tx := &Textile{bc: bc, conf: Config{
+		OwnerResolver: func(ctx context.Context, _ *mdb.AccountCtx) (thread.PubKey, mdb.AccountType, bool) {
+			if metautils.ExtractIncoming(ctx).Get("x-workspace") != "acme" {
+				return nil, 0, false
+			}
+			return workspace.Key, mdb.Org, true
+		},
+	}}
+
+	dev := newTestDev(t)
+	push := func(ctx context.Context) {
+		ctx, err := tx.preUsageFunc(ctx, pushPathMethod)
+		require.NoError(t, err)
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageDelta = mib
+		require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	}
+	push(metadata.NewIncomingContext(newTestAccountContext(dev), metadata.Pairs("x-workspace", "acme")))
+
+	// The workspace is created as a customer and billed instead of the account owner.
+	cus, ok := bc.customers[workspace.Key.String()]
+	require.True(t, ok)
+	assert.Equal(t, int32(mdb.Org), cus.AccountType)
+	assert.Equal(t, int64(mib), cus.DailyUsage["stored_data"].Total)
+	_, ok = bc.customers[dev.Key.String()]
+	assert.False(t, ok)
+
+	// Requests that aren't resolved are billed to the account owner.
+	push(newTestAccountContext(dev))
+	cus, ok = bc.customers[dev.Key.String()]
+	require.True(t, ok)
+	assert.Equal(t, int64(mib), cus.DailyUsage["stored_data"].Total)
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, workspace.Key.String(), incs[0].key)
+	assert.Equal(t, dev.Key.String(), incs[1].key)
+}
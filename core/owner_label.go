@@ -0,0 +1,39 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// ownerLabelSize is the number of bytes of the hash used as an owner label.
+const ownerLabelSize = 16
+
+// ownerLabeler returns the label of an owner key used in metrics, traces, and logs.
+type ownerLabeler func(key string) string
+
+// newOwnerLabeler returns a labeler that hashes owner keys with salt, so that an owner's requests can be
+// correlated without exposing their key. Keys are labeled as is if salt is empty.
+func newOwnerLabeler(salt string) ownerLabeler {
+	if salt == "" {
+		return func(key string) string {
+			return key
+		}
+	}
+	return func(key string) string {
+		mac := hmac.New(sha256.New, []byte(salt))
+		mac.Write([]byte(key))
+		return hex.EncodeToString(mac.Sum(nil)[:ownerLabelSize])
+	}
+}
+
+// ownerLabel returns the label of an owner key used in metrics, traces, and logs.
+// Billing calls always use the owner's key.
+func (t *Textile) ownerLabel(key thread.PubKey) string {
+	if t.labelOwner == nil {
+		return key.String()
+	}
+	return t.labelOwner(key.String())
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+)
+
+func TestOwnerLabeler(t *testing.T) {
+	dev := newTestDev(t)
+	other := newTestDev(t)
+	label := newOwnerLabeler("salt")
+
+	// The same owner maps to a stable label that doesn't expose their key.
+	assert.Equal(t, label(dev.Key.String()), label(dev.Key.String()))
+	assert.NotEqual(t, dev.Key.String(), label(dev.Key.String()))
+	assert.Len(t, label(dev.Key.String()), 2*ownerLabelSize)
+	assert.NotEqual(t, label(dev.Key.String()), label(other.Key.String()))
+	assert.NotEqual(t, label(dev.Key.String()), newOwnerLabeler("pepper")(dev.Key.String()))
+
+	// Keys aren't hashed without a salt.
+	assert.Equal(t, dev.Key.String(), newOwnerLabeler("")(dev.Key.String()))
+}
+
+func TestPreUsageFunc_HashedOwnerLabel(t *testing.T) {
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{bc: bc, logDenial: logger.log, labelOwner: newOwnerLabeler("salt")}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	// Denials are logged with the hashed label.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	require.Len(t, logger.entries, 1)
+	assert.Equal(t, tx.labelOwner(dev.Key.String()), logger.entries[0]["owner"])
+
+	// Usage is billed to the real key.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, dev.Key.String(), incs[0].key)
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"sort"
+
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// defaultOwnerMetadataMaxSize is the default max size in bytes of owner metadata attached to usage.
+const defaultOwnerMetadataMaxSize = 1024
+
+// ownerMetadata returns the configured metadata of owner that's attached to usage as labels.
+// Keys are added in order until the total size of keys and values would exceed the max size.
+func (t *Textile) ownerMetadata(owner *mdb.Account) map[string]string {
+	if owner == nil || len(owner.Metadata) == 0 || len(t.conf.OwnerMetadataKeys) == 0 {
+		return nil
+	}
+	max := t.conf.OwnerMetadataMaxSize
+	if max <= 0 {
+		max = defaultOwnerMetadataMaxSize
+	}
+	keys := make([]string, len(t.conf.OwnerMetadataKeys))
+	copy(keys, t.conf.OwnerMetadataKeys)
+	sort.Strings(keys)
+	var size int
+	metadata := make(map[string]string)
+	for _, k := range keys {
+		v, ok := owner.Metadata[k]
+		if !ok {
+			continue
+		}
+		if size+len(k)+len(v) > max {
+			log.Warnf("owner metadata for %s exceeds %d bytes, dropping %s", owner.Key, max, k)
+			continue
+		}
+		size += len(k) + len(v)
+		metadata[k] = v
+	}
+	return metadata
+}
+
+// ownerMetadataOptions returns billing options that attach owner metadata to usage.
+func (t *Textile) ownerMetadataOptions(owner *mdb.Account) []billing.UsageOption {
+	metadata := t.ownerMetadata(owner)
+	if len(metadata) == 0 {
+		return nil
+	}
+	return []billing.UsageOption{billing.WithLabels(metadata)}
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/stats"
+)
+
+func TestPostUsageFunc_OwnerMetadata(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{OwnerMetadataKeys: []string{"cost_center", "plan"}}}
+
+	dev := newTestDev(t)
+	dev.Metadata = map[string]string{"cost_center": "eng", "plan": "team", "internal": "secret"}
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+
+	// Only configured metadata is attached.
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, map[string]string{"cost_center": "eng", "plan": "team"}, incs[0].labels)
+}
+
+func TestOwnerMetadata_BatchedUsage(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, usage: newUsageBatcher(bc, time.Hour, 1), conf: Config{OwnerMetadataKeys: []string{"plan"}}}
+	h := &StatsHandler{t: tx}
+	dev := newTestDev(t)
+	dev.Metadata = map[string]string{"plan": "team"}
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+
+	// Threaddb usage recorded by the stats handler has metadata attached, too.
+	rs := &requestStats{key: dev.Key, reads: 1, metadata: tx.ownerMetadataOptions(dev)}
+	h.HandleRPC(context.WithValue(context.Background(), statsCtxKey("requestStats"), rs), &stats.End{})
+	require.Eventually(t, func() bool {
+		return tx.usage.pendingFor(dev.Key)["instance_reads"] == 1
+	}, time.Second, time.Millisecond*10)
+
+	// Usage is batched per set of metadata, which is sent with it.
+	dev.Metadata["plan"] = "pro"
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"instance_reads": 1},
+		tx.ownerMetadataOptions(dev)...))
+	tx.usage.flush(context.Background())
+	incs := bc.getIncs()
+	require.NotEmpty(t, incs)
+	plans := make(map[string]int64)
+	for _, inc := range incs {
+		plans[inc.labels["plan"]] += inc.usage["instance_reads"]
+	}
+	assert.Equal(t, map[string]int64{"team": 1, "pro": 1}, plans)
+}
+
+func TestOwnerMetadata_MaxSize(t *testing.T) {
+	tx := &Textile{conf: Config{OwnerMetadataKeys: []string{"a", "b", "c"}, OwnerMetadataMaxSize: 10}}
+	dev := newTestDev(t)
+	dev.Metadata = map[string]string{"a": "1234", "b": strings.Repeat("x", 10), "c": "1234"}
+
+	// Metadata that would exceed the max size is dropped.
+	assert.Equal(t, map[string]string{"a": "1234", "c": "1234"}, tx.ownerMetadata(dev))
+
+	// Owners without metadata have none attached.
+	assert.Nil(t, tx.ownerMetadataOptions(newTestDev(t)))
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// partialUsageError is returned when billingd applied some of the usage reported in a call, but
+// other usage failed to apply, even when it was retried on its own. Only the failed usage needs
+// to be sent again, under idempotencyKey, since billingd ignores the original key once the call
+// partially succeeds.
+type partialUsageError struct {
+	failed         map[string]int64
+	idempotencyKey string
+	causes         map[string]string
+}
+
+func (e *partialUsageError) Error() string {
+	keys := make([]string, 0, len(e.failed))
+	for k := range e.failed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	msg := "usage was partially applied"
+	for _, k := range keys {
+		msg += fmt.Sprintf(", %s failed: %s", k, e.causes[k])
+	}
+	return msg
+}
+
+// partialUsageKey returns the idempotency key under which usage that failed to apply as part of
+// the usage reported under key is retried. It's empty if the original usage had no key.
+func partialUsageKey(key, suffix string) string {
+	if key == "" {
+		return ""
+	}
+	return key + "/" + suffix
+}
+
+// partialUsageBillingClient retries usage that billingd reports as failed when it applied other usage
+// reported in the same call, e.g., a quota that was exhausted by one usage key of a multi-key delta.
+// Each failed key is retried on its own under an idempotency key derived from the original.
+type partialUsageBillingClient struct {
+	billingClient
+}
+
+func newPartialUsageBillingClient(bc billingClient) billingClient {
+	return &partialUsageBillingClient{billingClient: bc}
+}
+
+func (c *partialUsageBillingClient) IncCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	res, err := c.billingClient.IncCustomerUsage(ctx, key, productUsage, opts...)
+	if err != nil || len(res.FailedUsage) == 0 {
+		return res, err
+	}
+	args := &billing.UsageOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	perr := &partialUsageError{
+		failed:         make(map[string]int64),
+		idempotencyKey: partialUsageKey(args.IdempotencyKey, "partial"),
+		causes:         make(map[string]string),
+	}
+	for k, cause := range res.FailedUsage {
+		log.Warnf("%s usage for %s was not applied, retrying: %s", k, key, cause)
+		kopts := opts
+		if args.IdempotencyKey != "" {
+			kopts = append(append([]billing.UsageOption{}, opts...),
+				billing.WithIdempotencyKey(partialUsageKey(args.IdempotencyKey, k)))
+		}
+		kres, err := c.billingClient.IncCustomerUsage(ctx, key, map[string]int64{k: productUsage[k]}, kopts...)
+		if err == nil {
+			if cause, ok := kres.FailedUsage[k]; ok {
+				err = errors.New(cause)
+			}
+		}
+		if err != nil {
+			perr.failed[k] = productUsage[k]
+			perr.causes[k] = err.Error()
+			continue
+		}
+		if u, ok := kres.DailyUsage[k]; ok {
+			res.DailyUsage[k] = u
+		}
+	}
+	if len(perr.failed) > 0 {
+		return nil, perr
+	}
+	res.FailedUsage = nil
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+func TestPartialUsageBillingClient_RetriesFailedKey(t *testing.T) {
+	bc := newPartialBillingClient(map[string]int{"instance_reads": 1})
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	pbc := newPartialUsageBillingClient(bc)
+
+	usage := map[string]int64{"network_egress": 10, "instance_reads": 1}
+	res, err := pbc.IncCustomerUsage(context.Background(), dev.Key, usage, billing.WithIdempotencyKey("event"))
+	require.NoError(t, err)
+	assert.Empty(t, res.FailedUsage)
+	assert.Equal(t, int64(1), res.DailyUsage["instance_reads"].Total)
+
+	// Only the failed key is retried, under its own idempotency key.
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, map[string]int64{"network_egress": 10}, incs[0].usage)
+	assert.Equal(t, "event", incs[0].idempotencyKey)
+	assert.Equal(t, map[string]int64{"instance_reads": 1}, incs[1].usage)
+	assert.Equal(t, "event/instance_reads", incs[1].idempotencyKey)
+}
+
+func TestUsageBatcher_PartialUsage(t *testing.T) {
+	bc := newPartialBillingClient(map[string]int{"instance_reads": 2})
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	b := newUsageBatcher(newPartialUsageBillingClient(bc), time.Hour, 1)
+
+	// The failed key is still failing after it's retried, so it's left at the head of the queue.
+	b.add(dev.Key, map[string]int64{"network_egress": 10, "instance_reads": 1})
+	b.flush(context.Background())
+	queue := b.queues[dev.Key.String()]
+	require.Len(t, queue, 1)
+	assert.Equal(t, map[string]int64{"instance_reads": 1}, queue[0].total())
+	assert.Equal(t, 1, b.size)
+
+	// Only the failed key is sent again.
+	b.flush(context.Background())
+	assert.Empty(t, b.queues)
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, map[string]int64{"network_egress": 10}, incs[0].usage)
+	assert.Equal(t, map[string]int64{"instance_reads": 1}, incs[1].usage)
+	assert.Equal(t, queue[0].idempotencyKey, incs[1].idempotencyKey)
+}
+
+// partialBillingClient is a testBillingClient that fails to apply some usage keys, like billingd does
+// when, e.g., one key exceeds a quota. Keys fail the configured number of times.
+type partialBillingClient struct {
+	*testBillingClient
+
+	failLk sync.Mutex
+	fail   map[string]int
+}
+
+func newPartialBillingClient(fail map[string]int) *partialBillingClient {
+	return &partialBillingClient{testBillingClient: newTestBillingClient(), fail: fail}
+}
+
+func (c *partialBillingClient) IncCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	applied := make(map[string]int64)
+	failed := make(map[string]string)
+	c.failLk.Lock()
+	for k, v := range productUsage {
+		if c.fail[k] > 0 {
+			c.fail[k]--
+			failed[k] = "exceeds free quota"
+			continue
+		}
+		applied[k] = v
+	}
+	c.failLk.Unlock()
+	if len(applied) == 0 {
+		return nil, errors.New("exceeds free quota")
+	}
+	res, err := c.testBillingClient.IncCustomerUsage(ctx, key, applied, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res.FailedUsage = failed
+	return res, nil
+}
@@ -23,7 +23,7 @@ func powInterceptor(
 	serviceDesc *desc.ServiceDescriptor,
 	stub *grpcdynamic.Stub,
 	pc *powc.Client,
-	powAdminToken string,
+	powAdminToken func(ctx context.Context) string,
 	c *mdb.Collections,
 ) grpc.UnaryServerInterceptor {
 	return func(
@@ -66,7 +66,7 @@ func powInterceptor(
 		}
 
 		createNewUser := func() error {
-			ctxAdmin := context.WithValue(ctx, powc.AdminKey, powAdminToken)
+			ctxAdmin := context.WithValue(ctx, powc.AdminKey, powAdminToken(ctx))
 			res, err := pc.Admin.Users.Create(ctxAdmin)
 			if err != nil {
 				return fmt.Errorf("creating new powergate integration: %v", err)
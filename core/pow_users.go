@@ -0,0 +1,232 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	powc "github.com/textileio/powergate/v2/api/client"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+const (
+	// defaultPowUserRetryInterval is the default interval at which deferred Powergate users are retried.
+	defaultPowUserRetryInterval = time.Minute
+
+	// maxPowUserRetryBackoff caps the delay between attempts to create a deferred Powergate user.
+	maxPowUserRetryBackoff = time.Hour
+)
+
+// PowAdminTokenResolver returns the Powergate admin token used to provision Powergate users for the
+// user or account in ctx, e.g., a reseller's or region's token. False is returned to use the global
+// Powergate admin token.
+type PowAdminTokenResolver func(ctx context.Context) (string, bool)
+
+// powUserCreator creates Powergate users.
+type powUserCreator interface {
+	// CreateUser creates a Powergate user with the Powergate admin token.
+	CreateUser(ctx context.Context, adminToken string) (*mdb.PowInfo, error)
+}
+
+// adminPowUsers creates Powergate users with the Powergate admin API.
+type adminPowUsers struct {
+	pc *powc.Client
+}
+
+func (p *adminPowUsers) CreateUser(ctx context.Context, adminToken string) (*mdb.PowInfo, error) {
+	ctxAdmin := context.WithValue(ctx, powc.AdminKey, adminToken)
+	res, err := p.pc.Admin.Users.Create(ctxAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return &mdb.PowInfo{ID: res.User.Id, Token: res.User.Token}, nil
+}
+
+// userStore persists user accounts.
+type userStore interface {
+	Get(ctx context.Context, key thread.PubKey) (*mdb.Account, error)
+	CreateUser(ctx context.Context, key thread.PubKey, powInfo *mdb.PowInfo) (*mdb.Account, error)
+	UpdatePowInfo(ctx context.Context, key thread.PubKey, powInfo *mdb.PowInfo) (*mdb.Account, error)
+}
+
+var _ userStore = (*mdb.Accounts)(nil)
+
+// powAdminToken returns the Powergate admin token used to provision Powergate users for the user
+// or account in ctx. The configured resolver is used if present. Otherwise, users whose parent,
+// i.e., reseller, has a token use it. All others use the global token.
+func (t *Textile) powAdminToken(ctx context.Context) string {
+	if resolve := t.conf.PowergateAdminTokenResolver; resolve != nil {
+		if token, ok := resolve(ctx); ok {
+			return token
+		}
+		return t.conf.PowergateAdminToken
+	}
+	if len(t.conf.PowergateResellerAdminTokens) > 0 {
+		if parent, ok := t.userParentKey(ctx); ok {
+			if token, ok := t.conf.PowergateResellerAdminTokens[parent.String()]; ok {
+				return token
+			}
+		}
+	}
+	return t.conf.PowergateAdminToken
+}
+
+// collectUser creates an account for a new user, along with a Powergate user if Powergate is enabled.
+// If deferral is enabled, a failure to create the Powergate user doesn't fail the request. Instead,
+// the user is created without Powergate info and the Powergate user is retried in the background.
+func (t *Textile) collectUser(ctx context.Context, key thread.PubKey) (*mdb.Account, error) {
+	var powInfo *mdb.PowInfo
+	var deferred bool
+	var adminToken string
+	if t.powUsers != nil {
+		adminToken = t.powAdminToken(ctx)
+		var err error
+		powInfo, err = t.powUsers.CreateUser(ctx, adminToken)
+		if err != nil {
+			if t.powRetrier == nil {
+				return nil, err
+			}
+			log.Warnf("creating powergate user for %s, will retry: %v", key, err)
+			deferred = true
+		}
+	}
+	user, err := t.users.CreateUser(ctx, key, powInfo)
+	if err != nil {
+		return nil, err
+	}
+	if deferred {
+		t.powRetrier.add(key, adminToken)
+	}
+	return user, nil
+}
+
+// deferredPowUser is a user whose Powergate user has yet to be created.
+// The admin token resolved for the user's request is used to retry.
+type deferredPowUser struct {
+	key        thread.PubKey
+	adminToken string
+	attempts   int
+	next       time.Time
+}
+
+// powUserRetrier periodically retries creating Powergate users for users that were created
+// without one, backing off exponentially. Users are not retried across restarts. Those that
+// remain without a Powergate user are provisioned by the Powergate interceptor on first use.
+type powUserRetrier struct {
+	users    userStore
+	pow      powUserCreator
+	clock    Clock
+	interval time.Duration
+
+	lk      sync.Mutex
+	pending map[string]*deferredPowUser
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newPowUserRetrier(users userStore, pow powUserCreator, clock Clock, interval time.Duration) *powUserRetrier {
+	if interval <= 0 {
+		interval = defaultPowUserRetryInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &powUserRetrier{
+		users:    users,
+		pow:      pow,
+		clock:    clock,
+		interval: interval,
+		pending:  make(map[string]*deferredPowUser),
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// start begins retrying deferred users on the retrier's interval.
+func (r *powUserRetrier) start() {
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				r.retry(r.ctx)
+			}
+		}
+	}()
+}
+
+// add queues a user for a Powergate user to be created with adminToken on the next retry.
+func (r *powUserRetrier) add(key thread.PubKey, adminToken string) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	if _, ok := r.pending[key.String()]; ok {
+		return
+	}
+	r.pending[key.String()] = &deferredPowUser{key: key, adminToken: adminToken, next: r.clock.Now()}
+}
+
+// retry attempts to create a Powergate user for each deferred user that's due.
+func (r *powUserRetrier) retry(ctx context.Context) {
+	now := r.clock.Now()
+	r.lk.Lock()
+	var due []*deferredPowUser
+	for _, u := range r.pending {
+		if !u.next.After(now) {
+			due = append(due, u)
+		}
+	}
+	r.lk.Unlock()
+
+	for _, u := range due {
+		err := r.provision(ctx, u.key, u.adminToken)
+		r.lk.Lock()
+		if err != nil {
+			u.attempts++
+			u.next = now.Add(r.backoff(u.attempts))
+			log.Errorf("retrying powergate user for %s: %v", u.key, err)
+		} else {
+			delete(r.pending, u.key.String())
+		}
+		r.lk.Unlock()
+	}
+}
+
+func (r *powUserRetrier) provision(ctx context.Context, key thread.PubKey, adminToken string) error {
+	user, err := r.users.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if user.PowInfo != nil {
+		return nil // Provisioned elsewhere, e.g., by the Powergate interceptor
+	}
+	powInfo, err := r.pow.CreateUser(ctx, adminToken)
+	if err != nil {
+		return err
+	}
+	_, err = r.users.UpdatePowInfo(ctx, key, powInfo)
+	return err
+}
+
+// backoff returns the delay before a user that has failed attempts times is retried.
+func (r *powUserRetrier) backoff(attempts int) time.Duration {
+	d := r.interval
+	for i := 1; i < attempts && d < maxPowUserRetryBackoff; i++ {
+		d *= 2
+	}
+	if d > maxPowUserRetryBackoff {
+		d = maxPowUserRetryBackoff
+	}
+	return d
+}
+
+// close stops the retrier.
+func (r *powUserRetrier) close() {
+	r.cancel()
+	<-r.done
+}
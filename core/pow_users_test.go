@@ -0,0 +1,218 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestCollectUser_PowergateFailure(t *testing.T) {
+	users := newTestUserStore()
+	pow := &testPowUsers{err: errors.New("powergate unavailable")}
+	tx := &Textile{users: users, powUsers: pow}
+
+	// Without deferral, the request fails and no user is created.
+	key := newTestDev(t).Key
+	_, err := tx.collectUser(context.Background(), key)
+	require.Error(t, err)
+	_, err = users.Get(context.Background(), key)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
+
+func TestCollectUser_DeferPowergate(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	users := newTestUserStore()
+	pow := &testPowUsers{err: errors.New("powergate unavailable")}
+	tx := &Textile{
+		users:      users,
+		powUsers:   pow,
+		powRetrier: newPowUserRetrier(users, pow, clock, time.Minute),
+	}
+
+	// The user is created now without Powergate info.
+	key := newTestDev(t).Key
+	user, err := tx.collectUser(context.Background(), key)
+	require.NoError(t, err)
+	assert.Nil(t, user.PowInfo)
+	_, err = users.Get(context.Background(), key)
+	require.NoError(t, err)
+
+	// Retries back off while Powergate is failing.
+	tx.powRetrier.retry(context.Background())
+	assert.Equal(t, 2, pow.attempts())
+	clock.advance(30 * time.Second)
+	tx.powRetrier.retry(context.Background())
+	assert.Equal(t, 2, pow.attempts())
+
+	// The user record is updated once Powergate recovers.
+	pow.setErr(nil)
+	clock.advance(30 * time.Second)
+	tx.powRetrier.retry(context.Background())
+	assert.Equal(t, 3, pow.attempts())
+	user, err = users.Get(context.Background(), key)
+	require.NoError(t, err)
+	require.NotNil(t, user.PowInfo)
+	assert.Equal(t, "pow1", user.PowInfo.ID)
+	assert.Empty(t, tx.powRetrier.pending)
+}
+
+func TestPowUserRetrier_AlreadyProvisioned(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	users := newTestUserStore()
+	pow := &testPowUsers{}
+	r := newPowUserRetrier(users, pow, clock, time.Minute)
+
+	key := newTestDev(t).Key
+	_, err := users.CreateUser(context.Background(), key, &mdb.PowInfo{ID: "other"})
+	require.NoError(t, err)
+	r.add(key, "admin")
+	r.retry(context.Background())
+	assert.Equal(t, 0, pow.attempts())
+	assert.Empty(t, r.pending)
+}
+
+func TestCollectUser_ResellerPowAdminToken(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	users := newTestUserStore()
+	pow := &testPowUsers{}
+	reseller := newTestDev(t)
+	tx := &Textile{
+		users:      users,
+		powUsers:   pow,
+		powRetrier: newPowUserRetrier(users, pow, clock, time.Minute),
+		conf: Config{
+			PowergateAdminToken:          "global",
+			PowergateResellerAdminTokens: map[string]string{reseller.Key.String(): "reseller"},
+		},
+	}
+
+	// The reseller's users are provisioned with the reseller's admin token.
+	ctx := mdb.NewAPIKeyContext(context.Background(), &mdb.APIKey{Owner: reseller.Key, Type: mdb.UserKey})
+	_, err := tx.collectUser(ctx, newTestDev(t).Key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reseller"}, pow.adminTokens())
+
+	// Other users are provisioned with the global admin token.
+	other := newTestDev(t)
+	ctx = mdb.NewAPIKeyContext(context.Background(), &mdb.APIKey{Owner: other.Key, Type: mdb.UserKey})
+	_, err = tx.collectUser(ctx, newTestDev(t).Key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reseller", "global"}, pow.adminTokens())
+
+	// Deferred users are retried with the reseller's admin token.
+	pow.setErr(errors.New("powergate unavailable"))
+	ctx = mdb.NewAPIKeyContext(context.Background(), &mdb.APIKey{Owner: reseller.Key, Type: mdb.UserKey})
+	_, err = tx.collectUser(ctx, newTestDev(t).Key)
+	require.NoError(t, err)
+	pow.setErr(nil)
+	tx.powRetrier.retry(context.Background())
+	assert.Equal(t, []string{"reseller", "global", "reseller", "reseller"}, pow.adminTokens())
+	assert.Empty(t, tx.powRetrier.pending)
+}
+
+func TestPowAdminToken_Resolver(t *testing.T) {
+	reseller := newTestDev(t)
+	tx := &Textile{conf: Config{
+		PowergateAdminToken:          "global",
+		PowergateResellerAdminTokens: map[string]string{reseller.Key.String(): "reseller"},
+		PowergateAdminTokenResolver: func(ctx context.Context) (string, bool) {
+			region, ok := ctx.Value(testRegionKey{}).(string)
+			return "region-" + region, ok
+		},
+	}}
+
+	// The resolver replaces reseller tokens.
+	ctx := mdb.NewAPIKeyContext(context.Background(), &mdb.APIKey{Owner: reseller.Key, Type: mdb.UserKey})
+	assert.Equal(t, "global", tx.powAdminToken(ctx))
+	assert.Equal(t, "region-eu", tx.powAdminToken(context.WithValue(ctx, testRegionKey{}, "eu")))
+}
+
+type testRegionKey struct{}
+
+// testPowUsers is a powUserCreator that numbers the users it creates.
+type testPowUsers struct {
+	lk     sync.Mutex
+	err    error
+	calls  int
+	n      int
+	tokens []string
+}
+
+func (p *testPowUsers) setErr(err error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.err = err
+}
+
+func (p *testPowUsers) attempts() int {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	return p.calls
+}
+
+func (p *testPowUsers) adminTokens() []string {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	return append([]string(nil), p.tokens...)
+}
+
+func (p *testPowUsers) CreateUser(_ context.Context, adminToken string) (*mdb.PowInfo, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.calls++
+	p.tokens = append(p.tokens, adminToken)
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.n++
+	id := fmt.Sprintf("pow%d", p.n)
+	return &mdb.PowInfo{ID: id, Token: id + "-token"}, nil
+}
+
+// testUserStore is an in-memory userStore.
+type testUserStore struct {
+	lk    sync.Mutex
+	users map[string]*mdb.Account
+}
+
+func newTestUserStore() *testUserStore {
+	return &testUserStore{users: make(map[string]*mdb.Account)}
+}
+
+func (s *testUserStore) Get(_ context.Context, key thread.PubKey) (*mdb.Account, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	user, ok := s.users[key.String()]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return user, nil
+}
+
+func (s *testUserStore) CreateUser(_ context.Context, key thread.PubKey, powInfo *mdb.PowInfo) (*mdb.Account, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	user := &mdb.Account{Type: mdb.User, Key: key, PowInfo: powInfo, CreatedAt: time.Now()}
+	s.users[key.String()] = user
+	return user, nil
+}
+
+func (s *testUserStore) UpdatePowInfo(_ context.Context, key thread.PubKey, powInfo *mdb.PowInfo) (*mdb.Account, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	user, ok := s.users[key.String()]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	user.PowInfo = powInfo
+	return user, nil
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// Promotion is a window during which a method is free, i.e., its usage is not metered
+// and doesn't count against quotas.
+type Promotion struct {
+	// Start is when the promotion begins.
+	Start time.Time
+	// End is when the promotion ends and the method is metered again.
+	End time.Time
+}
+
+// contains returns whether or not now is within the promotion.
+func (p Promotion) contains(now time.Time) bool {
+	return !now.Before(p.Start) && now.Before(p.End)
+}
+
+// isPromoted returns whether or not method is free at now.
+func (t *Textile) isPromoted(method string, now time.Time) bool {
+	p, ok := t.conf.MethodPromotions[method]
+	return ok && p.contains(now)
+}
+
+// newPromotionContext marks the request in ctx as free, so that its usage isn't recorded
+// even if the promotion ends while it's being handled.
+func newPromotionContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, usageCtxKey("promotion"), true)
+}
+
+func isPromotedRequest(ctx context.Context) bool {
+	promoted, _ := ctx.Value(usageCtxKey("promotion")).(bool)
+	return promoted
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_MethodPromotion(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := newTestClock(start.Add(-time.Minute))
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock, conf: Config{
+		MethodPromotions: map[string]Promotion{
+			findMethod: {Start: start, End: start.Add(time.Hour)},
+		},
+	}}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	// Before the promotion, the exhausted quota applies.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// During the promotion, only the promoted method is free.
+	clock.advance(2 * time.Minute)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Has")
+	require.Error(t, err)
+
+	// Suspended owners are still denied.
+	cus.SubscriptionStatus = "canceled"
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	cus.SubscriptionStatus = "active"
+
+	// The method is metered again after the promotion.
+	clock.advance(time.Hour)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+}
+
+func TestPostUsageFunc_MethodPromotion(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := newTestClock(start)
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, clock: clock, conf: Config{
+		MethodPromotions: map[string]Promotion{
+			pushPathMethod: {Start: start, End: start.Add(time.Hour)},
+		},
+	}}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	push := func() {
+		intercept := unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)
+		_, err := intercept(newTestAccountContext(dev), nil, &grpc.UnaryServerInfo{FullMethod: pushPathMethod},
+			func(ctx context.Context, _ interface{}) (interface{}, error) {
+				if owner, ok := buckets.BucketOwnerFromContext(ctx); ok {
+					owner.StorageDelta = mib
+				}
+				// The promotion ends while the request is handled.
+				clock.advance(time.Hour)
+				return nil, nil
+			})
+		require.NoError(t, err)
+	}
+
+	// Storage added during the promotion is not metered.
+	push()
+	assert.Empty(t, bc.getIncs())
+
+	// Storage added after the promotion is metered.
+	push()
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+}
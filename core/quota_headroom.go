@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+)
+
+// defaultQuotaHeadroomSampleSize is the default number of owners sampled for quota headroom.
+const defaultQuotaHeadroomSampleSize = 100
+
+var (
+	keyUsageKey = tag.MustNewKey("usage_key")
+
+	mQuotaHeadroom = stats.Int64(
+		"hub/billing/quota_headroom",
+		"Sum of free allowance remaining across sampled non-billable owners",
+		stats.UnitDimensionless,
+	)
+	mQuotaHeadroomOwners = stats.Int64(
+		"hub/billing/quota_headroom_owners",
+		"Number of non-billable owners included in the quota headroom sample",
+		stats.UnitDimensionless,
+	)
+)
+
+// quotaHeadroom tracks owners that have made requests since the last sample so that their
+// remaining free allowance can be aggregated without fetching every customer.
+type quotaHeadroom struct {
+	lk     sync.Mutex
+	active map[string]thread.PubKey
+}
+
+func newQuotaHeadroom() *quotaHeadroom {
+	return &quotaHeadroom{active: make(map[string]thread.PubKey)}
+}
+
+// touch marks an owner as active.
+func (h *quotaHeadroom) touch(ownerKey thread.PubKey) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+	h.active[ownerKey.String()] = ownerKey
+}
+
+// take returns up to n active owners and resets the active set.
+func (h *quotaHeadroom) take(n int) []thread.PubKey {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+	owners := make([]thread.PubKey, 0, n)
+	for _, k := range h.active {
+		if len(owners) == n {
+			break
+		}
+		owners = append(owners, k)
+	}
+	h.active = make(map[string]thread.PubKey)
+	return owners
+}
+
+// sampleQuotaHeadroom records quota headroom on interval until ctx is done.
+func (t *Textile) sampleQuotaHeadroom(ctx context.Context, interval time.Duration, n int) {
+	if n <= 0 {
+		n = defaultQuotaHeadroomSampleSize
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.recordQuotaHeadroom(ctx, t.headroom.take(n))
+		}
+	}
+}
+
+// recordQuotaHeadroom fetches each owner and records the sum of free allowance remaining
+// per usage key across those that are non-billable. Owners that can't be fetched are skipped.
+func (t *Textile) recordQuotaHeadroom(ctx context.Context, owners []thread.PubKey) {
+	headroom := make(map[string]int64, len(quotaKeys))
+	for k := range quotaKeys {
+		headroom[k] = 0
+	}
+	var count int64
+	for _, ownerKey := range owners {
+		cus, err := t.bc.GetCustomer(ctx, ownerKey)
+		if err != nil {
+			log.Debugf("sampling quota headroom for %s: %v", ownerKey, err)
+			continue
+		}
+		if cus.Billable {
+			continue
+		}
+		count++
+		for k := range headroom {
+			if free := cus.DailyUsage[k].GetFree(); free > 0 {
+				headroom[k] += free
+			}
+		}
+	}
+	for k, v := range headroom {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{
+			tag.Upsert(keyUsageKey, k),
+		}, mQuotaHeadroom.M(v))
+	}
+	stats.Record(ctx, mQuotaHeadroomOwners.M(count))
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+)
+
+func TestRecordQuotaHeadroom(t *testing.T) {
+	require.NoError(t, view.Register(metricViews...))
+	t.Cleanup(func() { view.Unregister(metricViews...) })
+
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, headroom: newQuotaHeadroom()}
+
+	// Two non-billable owners, one of which has exhausted its reads.
+	a := newTestDev(t)
+	b := newTestDev(t)
+	cusB := newTestCustomer(b.Key)
+	cusB.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(newTestCustomer(a.Key))
+	bc.setCustomer(cusB)
+
+	// Billable owners and owners that can't be fetched aren't included.
+	c := newTestDev(t)
+	cusC := newTestCustomer(c.Key)
+	cusC.Billable = true
+	bc.setCustomer(cusC)
+	d := newTestDev(t)
+
+	tx.headroom.touch(a.Key)
+	tx.headroom.touch(b.Key)
+	tx.headroom.touch(c.Key)
+	tx.headroom.touch(d.Key)
+	tx.recordQuotaHeadroom(context.Background(), tx.headroom.take(10))
+
+	assert.Equal(t, float64(10*gib), quotaHeadroomGauge(t, "stored_data"))
+	assert.Equal(t, float64(20*gib), quotaHeadroomGauge(t, "network_egress"))
+	assert.Equal(t, float64(50000), quotaHeadroomGauge(t, "instance_reads"))
+	assert.Equal(t, float64(40000), quotaHeadroomGauge(t, "instance_writes"))
+
+	rows, err := view.RetrieveData(mQuotaHeadroomOwners.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(2), rows[0].Data.(*view.LastValueData).Value)
+}
+
+func TestQuotaHeadroom_Take(t *testing.T) {
+	h := newQuotaHeadroom()
+	a := newTestDev(t)
+	for i := 0; i < 3; i++ {
+		h.touch(a.Key)
+	}
+	h.touch(newTestDev(t).Key)
+	h.touch(newTestDev(t).Key)
+
+	// Samples are bounded and active owners are reset.
+	assert.Len(t, h.take(2), 2)
+	assert.Empty(t, h.take(2))
+}
+
+// quotaHeadroomGauge returns the quota headroom gauge value for a usage key.
+func quotaHeadroomGauge(t *testing.T, key string) float64 {
+	rows, err := view.RetrieveData(mQuotaHeadroom.Name())
+	require.NoError(t, err)
+	for _, row := range rows {
+		for _, tg := range row.Tags {
+			if tg.Key == keyUsageKey && tg.Value == key {
+				return row.Data.(*view.LastValueData).Value
+			}
+		}
+	}
+	t.Fatalf("no quota headroom recorded for %s", key)
+	return 0
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/common"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// quotaExhaustion is a quota or cap that a request would exceed.
+type quotaExhaustion struct {
+	// key is the usage key of the quota, e.g., instance_reads.
+	key string
+	err error
+	// cacheable indicates the denial can be reused for the owner's later requests to the method.
+	cacheable bool
+}
+
+// validateQuotaPriority returns an error if priority contains an unknown usage key.
+func validateQuotaPriority(priority []string) error {
+	for _, k := range priority {
+		if _, ok := quotaKeys[k]; !ok && k != "object_count" {
+			return fmt.Errorf("invalid quota priority: unknown usage key %s", k)
+		}
+	}
+	return nil
+}
+
+// quotaExhaustions returns every quota or cap that a request to method would exceed,
+// ordered by Config.QuotaPriority. Keys that aren't prioritized follow in check order.
+// Exempt owners are only held to billable storage caps.
+func (t *Textile) quotaExhaustions(
+	method string,
+	ownerKey thread.PubKey,
+	cus *pb.GetCustomerResponse,
+	policy MethodPolicy,
+	exempt bool,
+	now time.Time,
+) []quotaExhaustion {
+	var list []quotaExhaustion
+	if !exempt && t.usageExhausted(cus, "network_egress", now) {
+		list = append(list, quotaExhaustion{
+			key: "network_egress",
+			err: withResetHint(fmt.Errorf("network egress exhausted: %v", common.ErrExceedsFreeQuota),
+				cus, "network_egress"),
+			cacheable: true,
+		})
+	}
+	if !exempt && t.objectCountExhausted(method, cus) {
+		list = append(list, quotaExhaustion{
+			key: "object_count",
+			err: fmt.Errorf("object count exhausted: %v", common.ErrExceedsFreeQuota),
+		})
+	}
+	if policy.Storage && !policy.FreesStorage && t.storageCapExhausted(ownerKey, cus) {
+		list = append(list, quotaExhaustion{
+			key: "stored_data",
+			err: fmt.Errorf("stored data exhausted: %v", ErrExceedsStorageCap),
+		})
+	}
+	if !exempt && policy.Quota != "" && t.usageExhausted(cus, policy.Quota, now) {
+		list = append(list, quotaExhaustion{
+			key: policy.Quota,
+			err: withResetHint(fmt.Errorf("%s exhausted: %v", quotaKeys[policy.Quota], common.ErrExceedsFreeQuota),
+				cus, policy.Quota),
+			cacheable: true,
+		})
+	}
+	rank := make(map[string]int, len(t.conf.QuotaPriority))
+	for i, k := range t.conf.QuotaPriority {
+		if _, ok := rank[k]; !ok {
+			rank[k] = i
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return quotaRank(rank, list[i].key) < quotaRank(rank, list[j].key)
+	})
+	return list
+}
+
+func quotaRank(rank map[string]int, key string) int {
+	if r, ok := rank[key]; ok {
+		return r
+	}
+	return math.MaxInt32
+}
+
+// storageCapExhausted returns whether or not a billable owner has used up their storage cap.
+func (t *Textile) storageCapExhausted(ownerKey thread.PubKey, cus *pb.GetCustomerResponse) bool {
+	if !cus.Billable {
+		return false
+	}
+	limit, ok := t.conf.BillableStorageCaps[ownerKey.String()]
+	return ok && limit-cus.DailyUsage["stored_data"].Total <= 0
+}
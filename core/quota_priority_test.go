@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_QuotaPriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		priority []string
+		reported string
+	}{
+		{
+			name:     "check order",
+			method:   findMethod,
+			reported: "network egress exhausted",
+		},
+		{
+			name:     "reads first",
+			method:   findMethod,
+			priority: []string{"instance_reads", "network_egress"},
+			reported: "threaddb reads exhausted",
+		},
+		{
+			name:     "check order for objects",
+			method:   pushPathMethod,
+			reported: "network egress exhausted",
+		},
+		{
+			name:     "objects first",
+			method:   pushPathMethod,
+			priority: []string{"object_count"},
+			reported: "object count exhausted",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, conf: Config{MaxNumberObjectsPerOwner: 1, QuotaPriority: tc.priority}}
+			dev := newTestDev(t)
+			cus := newTestCustomer(dev.Key)
+			cus.DailyUsage["network_egress"].Free = 0
+			cus.DailyUsage["instance_reads"].Free = 0
+			cus.DailyUsage["object_count"] = &pb.Usage{Total: 1}
+			bc.setCustomer(cus)
+
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), tc.method)
+			require.Error(t, err)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tc.reported)
+		})
+	}
+}
+
+func TestValidateQuotaPriority(t *testing.T) {
+	require.NoError(t, validateQuotaPriority([]string{"stored_data", "object_count", "instance_reads"}))
+	require.Error(t, validateQuotaPriority([]string{"storage"}))
+}
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultReadWriteRatioWindow is the default window over which an owner's read:write ratio is enforced.
+	defaultReadWriteRatioWindow = time.Hour
+	// defaultReadWriteRatioMinReads is the default number of reads an owner can make within a window
+	// before their read:write ratio is enforced.
+	defaultReadWriteRatioMinReads = 1000
+)
+
+// readWriteCounts are an owner's threaddb reads and writes within the window starting at start.
+type readWriteCounts struct {
+	start  time.Time
+	reads  int64
+	writes int64
+}
+
+// readWriteRatio limits the ratio of threaddb reads to writes each owner makes within fixed windows,
+// e.g., to throttle owners scraping data they don't write.
+type readWriteRatio struct {
+	ratio    float64
+	window   time.Duration
+	minReads int64
+
+	lk     sync.Mutex
+	owners map[string]*readWriteCounts
+}
+
+func newReadWriteRatio(ratio float64, window time.Duration, minReads int64) *readWriteRatio {
+	if window <= 0 {
+		window = defaultReadWriteRatioWindow
+	}
+	if minReads <= 0 {
+		minReads = defaultReadWriteRatioMinReads
+	}
+	return &readWriteRatio{
+		ratio:    ratio,
+		window:   window,
+		minReads: minReads,
+		owners:   make(map[string]*readWriteCounts),
+	}
+}
+
+// allow records a read or write for owner at now, depending on key, if it's within the ratio.
+// Writes are always allowed. If a read isn't allowed, the time at which the window resets is returned.
+// The request isn't recorded if record is false.
+func (r *readWriteRatio) allow(owner, key string, now time.Time, record bool) (bool, time.Time) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	c, ok := r.owners[owner]
+	if !ok || !now.Before(c.start.Add(r.window)) {
+		c = &readWriteCounts{start: now}
+		if record {
+			r.owners[owner] = c
+		}
+	}
+	switch key {
+	case "instance_writes":
+		if record {
+			c.writes++
+		}
+		return true, time.Time{}
+	case "instance_reads":
+		reads := c.reads + 1
+		writes := c.writes
+		if writes < 1 {
+			writes = 1
+		}
+		if reads > r.minReads && float64(reads) > r.ratio*float64(writes) {
+			return false, c.start.Add(r.window)
+		}
+		if record {
+			c.reads = reads
+		}
+		return true, time.Time{}
+	default:
+		return true, time.Time{}
+	}
+}
+
+// checkReadWriteRatio denies a threaddb read if the owner's reads exceed the configured ratio
+// to their writes. Billable owners are exempt if Config.ReadWriteRatioExemptBillable is set.
+func (t *Textile) checkReadWriteRatio(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	key string,
+	billable bool,
+	now time.Time,
+) error {
+	if t.ratios == nil || (billable && t.conf.ReadWriteRatioExemptBillable) {
+		return nil
+	}
+	if ok, reset := t.ratios.allow(t.ownerKey(ctx, account).String(), key, now, !isDryRun(ctx)); !ok {
+		err := fmt.Errorf("read:write ratio exceeded, window resets at %s", reset.UTC().Format(time.RFC3339))
+		return t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, err.Error()))
+	}
+	return nil
+}
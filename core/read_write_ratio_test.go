@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const saveMethod = "/threads.pb.API/Save"
+
+func TestPreUsageFunc_ReadWriteRatio(t *testing.T) {
+	clock := newTestClock(time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:     bc,
+		clock:  clock,
+		ratios: newReadWriteRatio(2, time.Hour, 5),
+	}
+	scraper := newTestDev(t)
+	bc.setCustomer(newTestCustomer(scraper.Key))
+	balanced := newTestDev(t)
+	bc.setCustomer(newTestCustomer(balanced.Key))
+
+	// Reads are allowed before the min reads are made.
+	for i := 0; i < 5; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(scraper), findMethod)
+		require.NoError(t, err)
+	}
+	// An owner reading without writing is throttled.
+	_, err := tx.preUsageFunc(newTestAccountContext(scraper), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "resets at 2021-03-01T21:00:00Z")
+
+	// An owner that writes as it reads passes.
+	for i := 0; i < 10; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(balanced), saveMethod)
+		require.NoError(t, err)
+		_, err = tx.preUsageFunc(newTestAccountContext(balanced), findMethod)
+		require.NoError(t, err)
+	}
+
+	// Writes are always allowed, and reads are allowed again once they're within the ratio.
+	for i := 0; i < 3; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(scraper), saveMethod)
+		require.NoError(t, err)
+	}
+	_, err = tx.preUsageFunc(newTestAccountContext(scraper), findMethod)
+	require.NoError(t, err)
+
+	// The counts reset with the window.
+	clock.advance(time.Hour)
+	for i := 0; i < 5; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(scraper), findMethod)
+		require.NoError(t, err)
+	}
+}
+
+func TestPreUsageFunc_ReadWriteRatioExemptBillable(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:     bc,
+		conf:   Config{ReadWriteRatioExemptBillable: true},
+		ratios: newReadWriteRatio(1, time.Hour, 1),
+	}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.Billable = true
+	bc.setCustomer(cus)
+
+	for i := 0; i < 10; i++ {
+		_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,266 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apic "github.com/textileio/textile/v2/api/common"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc"
+)
+
+// dedupMethods are the mutations that can be deduped by a client-supplied idempotency key.
+// Only methods that send a single response are included, since a replay doesn't read the request.
+var dedupMethods = []string{
+	"/api.bucketsd.pb.APIService/Create",
+	"/api.bucketsd.pb.APIService/PushPath",
+	"/api.bucketsd.pb.APIService/SetPath",
+	"/api.bucketsd.pb.APIService/Remove",
+	"/api.bucketsd.pb.APIService/RemovePath",
+	"/api.bucketsd.pb.APIService/PushPathAccessRoles",
+	"/threads.pb.API/Create",
+	"/threads.pb.API/Save",
+	"/threads.pb.API/Delete",
+}
+
+func isDedupMethod(method string) bool {
+	for _, m := range dedupMethods {
+		if method == m {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupResult is the result of a mutation handled for an idempotency key.
+// done is closed when the mutation completes.
+type dedupResult struct {
+	done    chan struct{}
+	ok      bool
+	res     interface{}
+	msgs    []interface{}
+	expires time.Time
+}
+
+// requestDedup caches the results of completed mutations per owner and idempotency key
+// so that client retries are replayed instead of executed again.
+type requestDedup struct {
+	ttl time.Duration
+
+	lk     sync.Mutex
+	owners map[string]map[string]*dedupResult
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newRequestDedup(ttl time.Duration) *requestDedup {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &requestDedup{
+		ttl:    ttl,
+		owners: make(map[string]map[string]*dedupResult),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+}
+
+// start sweeps expired results on the cache's TTL, so that results of owners who
+// don't make another request are evicted.
+func (d *requestDedup) start(clock Clock) {
+	go func() {
+		defer close(d.done)
+		ticker := time.NewTicker(d.ttl)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case <-ticker.C:
+				d.sweep(clock.Now())
+			}
+		}
+	}()
+}
+
+// sweep evicts the expired results of all owners.
+func (d *requestDedup) sweep(now time.Time) {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	for owner, results := range d.owners {
+		pruneDedupResults(results, now)
+		if len(results) == 0 {
+			delete(d.owners, owner)
+		}
+	}
+}
+
+// pruneDedupResults deletes completed results that expired before now.
+func pruneDedupResults(results map[string]*dedupResult, now time.Time) {
+	for k, r := range results {
+		if isDone(r.done) && !now.Before(r.expires) {
+			delete(results, k)
+		}
+	}
+}
+
+// close stops sweeping expired results.
+func (d *requestDedup) close() {
+	d.cancel()
+	<-d.done
+}
+
+// begin returns the result for owner and key. True is returned if the result is new,
+// in which case the caller must execute the mutation and complete the result.
+func (d *requestDedup) begin(owner, key string, now time.Time) (*dedupResult, bool) {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	results, ok := d.owners[owner]
+	if !ok {
+		results = make(map[string]*dedupResult)
+		d.owners[owner] = results
+	}
+	pruneDedupResults(results, now)
+	if r, ok := results[key]; ok {
+		return r, false
+	}
+	r := &dedupResult{done: make(chan struct{})}
+	results[key] = r
+	return r, true
+}
+
+// complete records the outcome of a mutation started with begin.
+// Failed mutations are not cached so that they can be retried.
+func (d *requestDedup) complete(owner, key string, r *dedupResult, ok bool, now time.Time) {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	r.ok = ok
+	r.expires = now.Add(d.ttl)
+	if !ok {
+		delete(d.owners[owner], key)
+	}
+	if len(d.owners[owner]) == 0 {
+		delete(d.owners, owner)
+	}
+	close(r.done)
+}
+
+func isDone(done chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+// dedupKey returns the owner and scoped idempotency key of a request, or false if the request
+// can't be deduped.
+func (t *Textile) dedupKey(ctx context.Context, method string) (string, string, bool) {
+	if t.dedup == nil || !isDedupMethod(method) {
+		return "", "", false
+	}
+	key, ok := apic.IdempotencyKeyFromMD(ctx)
+	if !ok {
+		return "", "", false
+	}
+	account, ok := mdb.AccountFromContext(ctx)
+	if !ok || account.Owner() == nil {
+		return "", "", false
+	}
+	return t.ownerKey(ctx, account).String(), method + "\n" + key, true
+}
+
+// awaitDedup waits for a mutation started by another request with the same key.
+// False is returned if the mutation failed and the request should be executed.
+func awaitDedup(ctx context.Context, r *dedupResult) (bool, error) {
+	select {
+	case <-r.done:
+		return r.ok, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+// dedupUnaryServerInterceptor returns the cached result of a replayed unary mutation.
+func (t *Textile) dedupUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		owner, key, ok := t.dedupKey(ctx, info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+		r, first := t.dedup.begin(owner, key, t.now())
+		if !first {
+			replay, err := awaitDedup(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+			if replay {
+				return r.res, nil
+			}
+			return handler(ctx, req)
+		}
+		res, err := handler(ctx, req)
+		r.res = res
+		t.dedup.complete(owner, key, r, err == nil, t.now())
+		return res, err
+	}
+}
+
+// dedupServerStream records the messages sent by a mutation so they can be replayed.
+type dedupServerStream struct {
+	grpc.ServerStream
+	msgs []interface{}
+}
+
+func (s *dedupServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.msgs = append(s.msgs, m)
+	return nil
+}
+
+// dedupStreamServerInterceptor resends the cached messages of a replayed stream mutation.
+// Headers and trailers are not replayed.
+func (t *Textile) dedupStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		owner, key, ok := t.dedupKey(stream.Context(), info.FullMethod)
+		if !ok {
+			return handler(srv, stream)
+		}
+		r, first := t.dedup.begin(owner, key, t.now())
+		if !first {
+			replay, err := awaitDedup(stream.Context(), r)
+			if err != nil {
+				return err
+			}
+			if !replay {
+				return handler(srv, stream)
+			}
+			for _, m := range r.msgs {
+				if err := stream.SendMsg(m); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		wrapped := &dedupServerStream{ServerStream: stream}
+		err := handler(srv, wrapped)
+		r.msgs = wrapped.msgs
+		t.dedup.complete(owner, key, r, err == nil, t.now())
+		return err
+	}
+}
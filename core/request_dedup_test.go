@@ -0,0 +1,133 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestIdempotentContext(ctx context.Context, key string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("x-textile-idempotency-key", key))
+}
+
+func TestDedupStreamServerInterceptor_PushPath(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, dedup: newRequestDedup(time.Minute)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	var handled int
+	res := &bpb.PushPathResponse{Event: &bpb.PushPathResponse_Event{Path: "/ipfs/foo"}}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		handled++
+		owner, ok := buckets.BucketOwnerFromContext(ss.Context())
+		require.True(t, ok)
+		owner.StorageDelta = mib
+		return ss.SendMsg(res)
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pushPathMethod, IsClientStream: true}
+	usage := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)
+	push := func(ctx context.Context) *testServerStream {
+		stream := &testServerStream{ctx: ctx}
+		err := tx.dedupStreamServerInterceptor()(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return usage(srv, ss, info, handler)
+		})
+		require.NoError(t, err)
+		return stream
+	}
+
+	ctx := newTestIdempotentContext(newTestAccountContext(dev), "push-1")
+	assert.Equal(t, 1, push(ctx).sent)
+
+	// The replay receives the cached response without executing the handler or billing again.
+	assert.Equal(t, 1, push(ctx).sent)
+	assert.Equal(t, 1, handled)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+
+	// Requests with another key are executed.
+	push(newTestIdempotentContext(newTestAccountContext(dev), "push-2"))
+	assert.Equal(t, 2, handled)
+	assert.Len(t, bc.getIncs(), 2)
+}
+
+func TestDedupUnaryServerInterceptor(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{clock: clock, dedup: newRequestDedup(time.Minute)}
+	dev := newTestDev(t)
+	other := newTestDev(t)
+
+	var handled int
+	fail := true
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		handled++
+		if fail {
+			return nil, errors.New("failed")
+		}
+		return handled, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.bucketsd.pb.APIService/Create"}
+	create := func(ctx context.Context) (interface{}, error) {
+		return tx.dedupUnaryServerInterceptor()(newTestIdempotentContext(ctx, "create"), nil, info, handler)
+	}
+
+	// Failed mutations are not cached.
+	_, err := create(newTestAccountContext(dev))
+	require.Error(t, err)
+	fail = false
+	res, err := create(newTestAccountContext(dev))
+	require.NoError(t, err)
+	assert.Equal(t, 2, res)
+
+	// Completed mutations are replayed.
+	res, err = create(newTestAccountContext(dev))
+	require.NoError(t, err)
+	assert.Equal(t, 2, res)
+
+	// The cache is scoped per owner.
+	res, err = create(newTestAccountContext(other))
+	require.NoError(t, err)
+	assert.Equal(t, 3, res)
+
+	// Cached results expire.
+	clock.advance(time.Minute)
+	res, err = create(newTestAccountContext(dev))
+	require.NoError(t, err)
+	assert.Equal(t, 4, res)
+}
+
+func TestRequestDedup_SweepIdleOwner(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := newRequestDedup(time.Millisecond * 10)
+	d.start(clock)
+	defer d.close()
+
+	numOwners := func() int {
+		d.lk.Lock()
+		defer d.lk.Unlock()
+		return len(d.owners)
+	}
+
+	r, first := d.begin("idle", "create", clock.Now())
+	require.True(t, first)
+	d.complete("idle", "create", r, true, clock.Now())
+
+	// Unexpired results are kept.
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, 1, numOwners())
+
+	// The idle owner's result is evicted once it expires, without the owner making another request.
+	clock.advance(time.Second)
+	require.Eventually(t, func() bool {
+		return numOwners() == 0
+	}, time.Second, time.Millisecond*10)
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"github.com/textileio/textile/v2/api/billingd/service"
+)
+
+// freeQuotaSizes maps product keys to the free quota that billingd derives Free from.
+var freeQuotaSizes = func() map[string]int64 {
+	sizes := make(map[string]int64)
+	for _, p := range service.Products {
+		sizes[p.Key] = p.FreeQuotaSize
+	}
+	return sizes
+}()
+
+// applyResellerMultiplier returns a copy of a reseller's customer whose free allowances are scaled
+// by the reseller's multiplier, i.e., the multiplier of the customer's parent key. The scaled
+// allowance is what remains of the scaled free quota after the customer's usage, so usage beyond
+// the unscaled quota is free again. Other customers are returned as is.
+func (t *Textile) applyResellerMultiplier(cus *pb.GetCustomerResponse) *pb.GetCustomerResponse {
+	if cus.ParentKey == "" {
+		return cus
+	}
+	m, ok := t.conf.ResellerFreeMultipliers[cus.ParentKey]
+	if !ok || m < 0 || m == 1 {
+		return cus
+	}
+	cus = proto.Clone(cus).(*pb.GetCustomerResponse)
+	for k, u := range cus.DailyUsage {
+		quota, ok := freeQuotaSizes[k]
+		if !ok || quota == math.MaxInt64 {
+			continue
+		}
+		scaled := float64(quota) * m
+		if scaled >= math.MaxInt64 {
+			u.Free = math.MaxInt64
+			continue
+		}
+		free := int64(scaled) - u.Total
+		if free < 0 {
+			free = 0
+		}
+		u.Free = free
+	}
+	return cus
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_ResellerFreeMultiplier(t *testing.T) {
+	bc := newTestBillingClient()
+	reseller := newTestDev(t)
+	tx := &Textile{bc: bc, conf: Config{ResellerFreeMultipliers: map[string]float64{reseller.Key.String(): 2}}}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Total = 60000
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	// The customer is beyond the free quota without a reseller.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The reseller's multiplier doubles the effective free quota.
+	cus.ParentKey = reseller.Key.String()
+	bc.setCustomer(cus)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	scaled := tx.applyResellerMultiplier(cus)
+	assert.Equal(t, int64(40000), scaled.DailyUsage["instance_reads"].Free)
+	assert.Equal(t, int64(10*gib), scaled.DailyUsage["stored_data"].Free)
+	assert.Equal(t, int64(0), cus.DailyUsage["instance_reads"].Free)
+
+	// Usage beyond the scaled quota is still denied.
+	cus.DailyUsage["instance_reads"].Total = 100000
+	bc.setCustomer(cus)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+
+	// Customers of other resellers aren't affected.
+	cus.DailyUsage["instance_reads"].Total = 60000
+	cus.ParentKey = newTestDev(t).Key.String()
+	bc.setCustomer(cus)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+}
@@ -6,6 +6,8 @@ import (
 
 	tpb "github.com/textileio/go-threads/api/pb"
 	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
 	hpb "github.com/textileio/textile/v2/api/hubd/pb"
 	mdb "github.com/textileio/textile/v2/mongodb"
 	"google.golang.org/grpc/stats"
@@ -32,7 +34,10 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 
 		// Handle payload types.
 		egress := int64(st.WireLength)
-		var reads, writes int64
+		if getStats(ctx).skipEgress {
+			egress = 0 // Measured by the stream interceptor
+		}
+		var reads, writeReads, writes, verifies, has int64
 		var pl interface{}
 		switch spl := st.Payload.(type) {
 		case *tpb.ReadTransactionReply:
@@ -48,6 +53,12 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 		case *hpb.GetBillingSessionResponse:
 			egress = 0
 
+		// Listings are metered according to Config.ListingEgress.
+		case *bpb.ListResponse, *bpb.ListPathResponse, *bpb.ListIpfsPathResponse:
+			if !getStats(ctx).skipEgress {
+				egress = h.t.listingEgress(pl, egress)
+			}
+
 		// Account for threaddb reads and writes
 		case *tpb.CreateReply:
 			if pl.InstanceIDs != nil {
@@ -59,11 +70,11 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 			}
 		case *tpb.VerifyReply:
 			if pl.TransactionError == "" {
-				reads = 1
+				verifies = 1
 			}
 		case *tpb.WriteTransactionReply_VerifyReply:
 			if pl.VerifyReply.TransactionError == "" {
-				reads = 1
+				verifies = 1
 			}
 		case *tpb.SaveReply:
 			if pl.TransactionError == "" {
@@ -83,15 +94,15 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 			}
 		case *tpb.HasReply:
 			if pl.TransactionError == "" {
-				reads = 1
+				has = 1
 			}
 		case *tpb.ReadTransactionReply_HasReply:
 			if pl.HasReply.TransactionError == "" {
-				reads = 1
+				has = 1
 			}
 		case *tpb.WriteTransactionReply_HasReply:
 			if pl.HasReply.TransactionError == "" {
-				reads = 1
+				has = 1
 			}
 		case *tpb.FindReply:
 			if pl.Instances != nil {
@@ -103,7 +114,7 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 			}
 		case *tpb.WriteTransactionReply_FindReply:
 			if pl.FindReply.Instances != nil {
-				reads = int64(len(pl.FindReply.Instances))
+				writeReads = int64(len(pl.FindReply.Instances))
 			}
 		case *tpb.FindByIDReply:
 			if pl.TransactionError == "" {
@@ -115,14 +126,10 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 			}
 		case *tpb.WriteTransactionReply_FindByIDReply:
 			if pl.FindByIDReply.TransactionError == "" {
-				reads = 1
-			}
-		case *tpb.ListenReply:
-			if pl.Instance != nil {
-				reads = 1
+				writeReads = 1
 			}
 		}
-		ctx = handleStats(ctx, egress, reads, writes)
+		ctx = handleStats(ctx, egress, reads, writeReads, writes, verifies, has)
 
 	case *stats.End:
 		// Record usage
@@ -130,19 +137,12 @@ func (h *StatsHandler) HandleRPC(ctx context.Context, st stats.RPCStats) {
 		if rs == nil {
 			return
 		}
+		opts := append(append([]billing.UsageOption{}, rs.usageKey...), rs.metadata...)
 		go func() {
 			ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
 			defer cancel()
-			if rs.egress > 0 || rs.reads > 0 || rs.writes > 0 {
-				if _, err := h.t.bc.IncCustomerUsage(
-					ctx,
-					rs.key,
-					map[string]int64{
-						"network_egress":  rs.egress,
-						"instance_reads":  rs.reads,
-						"instance_writes": rs.writes,
-					},
-				); err != nil {
+			if rs.egress > 0 || rs.reads > 0 || rs.writeReads > 0 || rs.writes > 0 || rs.verifies > 0 || rs.has > 0 {
+				if err := h.t.recordUsage(ctx, rs.key, h.t.threadsUsage(rs), opts...); err != nil {
 					log.Errorf("stats: inc customer usage: %v", err)
 				}
 			}
@@ -157,16 +157,30 @@ type requestStats struct {
 	egress int64
 	reads  int64
 	writes int64
+	// writeReads are reads within a WriteTransaction, which are metered unless
+	// Config.FreeWriteTransactionReads is set.
+	writeReads int64
+	// verifies are metered according to Config.VerifyMetering.
+	verifies int64
+	// has are metered according to Config.HasMetering.
+	has int64
+	// usageKey keys the usage with the request ID so that it's applied once if the request is retried.
+	usageKey []billing.UsageOption
+	// metadata attaches the owner's metadata to the usage, see Config.OwnerMetadataKeys.
+	metadata []billing.UsageOption
+
+	skipEgress bool
 }
 
 func (h *StatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
 	if h.t.bc == nil {
 		return ctx
 	}
-	for _, ignored := range authIgnoredMethods {
-		if info.FullMethodName == ignored {
-			return ctx
-		}
+	if h.t.methodExemption(info.FullMethodName) != exemptNone {
+		return ctx
+	}
+	if h.t.isPromoted(info.FullMethodName, h.t.now()) {
+		return ctx
 	}
 	token, err := thread.NewTokenFromMD(ctx)
 	if err != nil {
@@ -182,19 +196,37 @@ func (h *StatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) conte
 	if !ok {
 		return ctx
 	}
-	return context.WithValue(ctx, statsCtxKey("requestStats"), &requestStats{
-		key: account.Owner().Key,
-	})
+	if _, ok := h.t.supportActor(ctx, account); ok {
+		return ctx
+	}
+	// The request ID is shared with the usage interceptor, which reports the request's other usage.
+	ctx = newRequestIDContext(ctx)
+	ownerKey := h.t.ownerKey(ctx, account)
+	rs := &requestStats{
+		key:      ownerKey,
+		usageKey: usageKeyOptions(ctx, info.FullMethodName, ownerKey, "stats"),
+		metadata: h.t.ownerMetadataOptions(account.Owner()),
+	}
+	for _, m := range egressStreamMethods {
+		if info.FullMethodName == m {
+			rs.skipEgress = true
+			break
+		}
+	}
+	return context.WithValue(ctx, statsCtxKey("requestStats"), rs)
 }
 
-func handleStats(ctx context.Context, egress, reads, writes int64) context.Context {
+func handleStats(ctx context.Context, egress, reads, writeReads, writes, verifies, has int64) context.Context {
 	rs := getStats(ctx)
 	if rs == nil {
 		return ctx
 	}
 	rs.egress += egress
 	rs.reads += reads
+	rs.writeReads += writeReads
 	rs.writes += writes
+	rs.verifies += verifies
+	rs.has += has
 	return context.WithValue(ctx, statsCtxKey("requestStats"), rs)
 }
 
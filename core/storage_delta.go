@@ -0,0 +1,83 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/textileio/go-threads/core/thread"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errStorageDeltaSign indicates a request's storage delta has the wrong sign for its method,
+// which likely means there's a bug in how the delta was computed.
+var errStorageDeltaSign = errors.New("storage delta has unexpected sign")
+
+// storageDeltaCheck is how incorrectly-signed storage deltas are handled.
+type storageDeltaCheck int
+
+const (
+	// storageDeltaCheckOff doesn't check storage deltas.
+	storageDeltaCheckOff storageDeltaCheck = iota
+	// storageDeltaCheckLog logs incorrectly-signed deltas, which are still recorded.
+	storageDeltaCheckLog
+	// storageDeltaCheckReject fails requests with incorrectly-signed deltas, which are not recorded.
+	storageDeltaCheckReject
+)
+
+func parseStorageDeltaCheck(mode string) (storageDeltaCheck, error) {
+	switch strings.ToLower(mode) {
+	case "", "off":
+		return storageDeltaCheckOff, nil
+	case "log":
+		return storageDeltaCheckLog, nil
+	case "reject":
+		return storageDeltaCheckReject, nil
+	default:
+		return 0, fmt.Errorf("invalid storage delta check: %s", mode)
+	}
+}
+
+// storageDeltaSign returns the sign that a storage delta for method is expected to have,
+// i.e., 1 for methods that add storage, -1 for methods that remove storage, and 0 if
+// the delta may have either sign. SetPath may overwrite a path with smaller data.
+func storageDeltaSign(method string) int {
+	switch method {
+	case "/api.bucketsd.pb.APIService/Create",
+		"/api.bucketsd.pb.APIService/PushPath":
+		return 1
+	case "/api.bucketsd.pb.APIService/Remove",
+		"/api.bucketsd.pb.APIService/RemovePath":
+		return -1
+	default:
+		return 0
+	}
+}
+
+// checkStorageDelta returns errStorageDeltaSign if delta has the wrong sign for method.
+func checkStorageDelta(method string, delta int64) error {
+	switch sign := storageDeltaSign(method); {
+	case sign > 0 && delta < 0, sign < 0 && delta > 0:
+		return fmt.Errorf("%v: %s delta is %d", errStorageDeltaSign, method, delta)
+	default:
+		return nil
+	}
+}
+
+// verifyStorageDelta logs storage deltas that have the wrong sign for method.
+// An error is returned if anomalies are rejected.
+func (t *Textile) verifyStorageDelta(method string, key thread.PubKey, delta int64) error {
+	if t.deltaCheck == storageDeltaCheckOff {
+		return nil
+	}
+	err := checkStorageDelta(method, delta)
+	if err == nil {
+		return nil
+	}
+	log.Errorf("owner %s: %v", key, err)
+	if t.deltaCheck == storageDeltaCheckReject {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
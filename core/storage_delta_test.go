@@ -0,0 +1,141 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckStorageDelta(t *testing.T) {
+	assert.NoError(t, checkStorageDelta(pushPathMethod, mib))
+	assert.NoError(t, checkStorageDelta(pushPathMethod, 0))
+	assert.Error(t, checkStorageDelta(pushPathMethod, -mib))
+	assert.Error(t, checkStorageDelta(createMethod, -mib))
+	assert.NoError(t, checkStorageDelta(removePathMethod, -mib))
+	assert.Error(t, checkStorageDelta(removePathMethod, mib))
+	// PushPaths and SetPath may replace existing data, so their deltas aren't checked.
+	assert.NoError(t, checkStorageDelta("/api.bucketsd.pb.APIService/PushPaths", -mib))
+	assert.NoError(t, checkStorageDelta(setPathMethod, -mib))
+}
+
+func TestPostUsageFunc_SetPathOverwrite(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, deltaCheck: storageDeltaCheckReject}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Overwriting a 10 MiB file with a 1 MiB file reports the signed net delta.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), setPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = -10*mib + mib
+	require.NoError(t, tx.postUsageFunc(ctx, setPathMethod))
+
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(-9*mib), incs[0].usage["stored_data"])
+}
+
+func TestPostUsageFunc_StorageDeltaCheck(t *testing.T) {
+	tests := []struct {
+		mode     string
+		rejected bool
+	}{
+		{mode: "off"},
+		{mode: "log"},
+		{mode: "reject", rejected: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.mode, func(t *testing.T) {
+			check, err := parseStorageDeltaCheck(tc.mode)
+			require.NoError(t, err)
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, deltaCheck: check}
+
+			dev := newTestDev(t)
+			bc.setCustomer(newTestCustomer(dev.Key))
+
+			// Removing a path should never add storage.
+			ctx, err := tx.preUsageFunc(newTestAccountContext(dev), removePathMethod)
+			require.NoError(t, err)
+			owner, ok := buckets.BucketOwnerFromContext(ctx)
+			require.True(t, ok)
+			owner.StorageDelta = mib
+			err = tx.postUsageFunc(ctx, removePathMethod)
+			if tc.rejected {
+				require.Error(t, err)
+				assert.Equal(t, codes.Internal, status.Code(err))
+				assert.Contains(t, err.Error(), errStorageDeltaSign.Error())
+				assert.Empty(t, bc.getIncs())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, bc.getIncs(), 1)
+			}
+		})
+	}
+}
+
+func TestPostUsageFunc_StorageBucketKey(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	owner.StorageScope = "bucket/dir"
+	owner.BucketKey = "bucket"
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+
+	// The bucket key accompanies the stored_data delta.
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+	assert.Equal(t, "bucket", incs[0].bucketKey)
+
+	// Requests without a bucket aren't attributed to one.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, _ = buckets.BucketOwnerFromContext(ctx)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	incs = bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Empty(t, incs[1].bucketKey)
+}
+
+func TestPostUsageFunc_StorageUnchanged(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// A no-op removal, e.g., of a path that doesn't exist, isn't billed.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), removePathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.Unchanged = true
+	require.NoError(t, tx.postUsageFunc(ctx, removePathMethod))
+	assert.Empty(t, bc.getIncs())
+
+	// A real removal reports a negative delta.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), removePathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = -mib
+	require.NoError(t, tx.postUsageFunc(ctx, removePathMethod))
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(-mib), incs[0].usage["stored_data"])
+}
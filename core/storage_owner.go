@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errMissingStorageOwner indicates a storage method completed without a bucket owner in its context,
+// which means its stored data wasn't recorded and there's likely a bug in how the owner is attached.
+var errMissingStorageOwner = errors.New("storage method completed without a bucket owner")
+
+func parseStorageOwnerCheck(mode string) (storageDeltaCheck, error) {
+	check, err := parseStorageDeltaCheck(mode)
+	if err != nil {
+		return 0, fmt.Errorf("invalid storage owner check: %s", mode)
+	}
+	return check, nil
+}
+
+// isStorageDeltaMethod returns whether or not method reports a storage delta for its owner.
+func isStorageDeltaMethod(method string) bool {
+	switch method {
+	case "/api.bucketsd.pb.APIService/Create",
+		"/api.bucketsd.pb.APIService/PushPath",
+		"/api.bucketsd.pb.APIService/PushPaths",
+		"/api.bucketsd.pb.APIService/SetPath",
+		"/api.bucketsd.pb.APIService/Remove",
+		"/api.bucketsd.pb.APIService/RemovePath",
+		"/api.bucketsd.pb.APIService/PushPathAccessRoles":
+		return true
+	default:
+		return false
+	}
+}
+
+// verifyStorageOwner logs storage methods that completed without a bucket owner, though their
+// usage policy attaches one. An error is returned if anomalies are rejected.
+func (t *Textile) verifyStorageOwner(ctx context.Context, method string, account *mdb.AccountCtx) error {
+	if t.ownerCheck == storageDeltaCheckOff || !isStorageDeltaMethod(method) {
+		return nil
+	}
+	if policy, ok := t.methodPolicy(method); !ok || !policy.Storage {
+		return nil
+	}
+	if t.isOwnerIgnoredMethod(method, t.ownerType(ctx, account)) {
+		return nil
+	}
+	err := fmt.Errorf("%v: %s", errMissingStorageOwner, method)
+	log.Errorf("owner %s: %v", t.ownerKey(ctx, account), err)
+	if t.ownerCheck == storageDeltaCheckReject {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPostUsageFunc_StorageOwnerCheck(t *testing.T) {
+	tests := []struct {
+		mode     string
+		rejected bool
+	}{
+		{mode: "off"},
+		{mode: "log"},
+		{mode: "reject", rejected: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.mode, func(t *testing.T) {
+			check, err := parseStorageOwnerCheck(tc.mode)
+			require.NoError(t, err)
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, ownerCheck: check}
+			dev := newTestDev(t)
+			bc.setCustomer(newTestCustomer(dev.Key))
+
+			// The pre handler didn't attach a bucket owner to the storage method.
+			ctx := newTestAccountContext(dev)
+			err = tx.postUsageFunc(ctx, pushPathMethod)
+			if tc.rejected {
+				require.Error(t, err)
+				assert.Equal(t, codes.Internal, status.Code(err))
+				assert.Contains(t, err.Error(), errMissingStorageOwner.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Empty(t, bc.getIncs())
+
+			// Methods that don't store data aren't expected to have an owner.
+			require.NoError(t, tx.postUsageFunc(ctx, findMethod))
+		})
+	}
+}
+
+func TestParseStorageOwnerCheck(t *testing.T) {
+	check, err := parseStorageOwnerCheck("")
+	require.NoError(t, err)
+	assert.Equal(t, storageDeltaCheckOff, check)
+	_, err = parseStorageOwnerCheck("strict")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "storage owner check")
+}
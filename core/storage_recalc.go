@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	threads "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+	"github.com/textileio/textile/v2/buckets"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	tdb "github.com/textileio/textile/v2/threaddb"
+)
+
+// storageCounter measures the storage used by an owner's buckets.
+type storageCounter interface {
+	// OwnerStorage returns the total size in bytes of the owner's buckets.
+	OwnerStorage(ctx context.Context, owner thread.PubKey) (int64, error)
+}
+
+// threadStorageCounter sums the dag sizes of the buckets in each of an owner's threads.
+type threadStorageCounter struct {
+	collections *mdb.Collections
+	threads     *threads.Client
+	ipfs        iface.CoreAPI
+	session     string
+}
+
+func (c *threadStorageCounter) OwnerStorage(ctx context.Context, owner thread.PubKey) (int64, error) {
+	list, err := c.ownerBuckets(ctx, owner)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, b := range list {
+		stat, err := c.ipfs.Object().Stat(ctx, path.New(b.Path))
+		if err != nil {
+			return 0, fmt.Errorf("getting size of bucket %s: %v", b.Key, err)
+		}
+		total += int64(stat.CumulativeSize)
+	}
+	return total, nil
+}
+
+func (c *threadStorageCounter) OwnerBucketCount(ctx context.Context, owner thread.PubKey) (int, error) {
+	list, err := c.ownerBuckets(ctx, owner)
+	if err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+// ownerBuckets returns the buckets in each of an owner's threads.
+func (c *threadStorageCounter) ownerBuckets(ctx context.Context, owner thread.PubKey) ([]*tdb.Bucket, error) {
+	list, err := c.collections.Threads.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	ictx, cancel := newInternalContext(ctx, c.session)
+	defer cancel()
+	var bucks []*tdb.Bucket
+	for _, th := range list {
+		if !th.IsDB {
+			continue
+		}
+		res, err := c.threads.Find(ictx, th.ID, buckets.CollectionName, &db.Query{}, &tdb.Bucket{})
+		if err != nil {
+			if strings.Contains(err.Error(), "collection not found") {
+				continue // Not a bucket thread
+			}
+			return nil, fmt.Errorf("listing buckets in %s: %v", th.ID, err)
+		}
+		bucks = append(bucks, res.([]*tdb.Bucket)...)
+	}
+	return bucks, nil
+}
+
+// RecalculateStorage overwrites an owner's billed stored data with the total size of their buckets,
+// e.g., to correct a total that drifted due to data corruption. Pending usage is flushed first so
+// that it isn't applied on top of the recalculated total.
+// The previous and recalculated totals are returned.
+func (t *Textile) RecalculateStorage(ctx context.Context, key thread.PubKey) (previous, total int64, err error) {
+	if t.bc == nil || t.storage == nil {
+		return 0, 0, fmt.Errorf("billing is not enabled")
+	}
+	if t.usage != nil {
+		t.usage.flush(ctx)
+	}
+	cus, err := t.bc.GetCustomer(ctx, key)
+	if err != nil {
+		return 0, 0, err
+	}
+	previous = cus.DailyUsage["stored_data"].Total
+	total, err = t.storage.OwnerStorage(ctx, key)
+	if err != nil {
+		return 0, 0, fmt.Errorf("calculating storage for %s: %v", key, err)
+	}
+	if _, err := t.bc.SetCustomerUsage(ctx, key, map[string]int64{"stored_data": total}); err != nil {
+		return 0, 0, err
+	}
+	log.Infof("recalculated stored data for %s: previous=%d total=%d", key, previous, total)
+	return previous, total, nil
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+func TestRecalculateStorage(t *testing.T) {
+	bc := newTestBillingClient()
+	counter := &testStorageCounter{sizes: make(map[string]int64)}
+	tx := &Textile{bc: bc, storage: counter}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["stored_data"].Total = 3 * gib
+	cus.DailyUsage["stored_data"].Free = 2 * gib
+	bc.setCustomer(cus)
+
+	// The billed total was corrupted; the buckets actually hold 1 GiB.
+	counter.sizes[dev.Key.String()] = gib
+	previous, total, err := tx.RecalculateStorage(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3*gib), previous)
+	assert.Equal(t, int64(gib), total)
+	assert.Equal(t, int64(gib), cus.DailyUsage["stored_data"].Total)
+	assert.Equal(t, int64(4*gib), cus.DailyUsage["stored_data"].Free)
+
+	// Recalculating again is a no-op.
+	previous, total, err = tx.RecalculateStorage(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(gib), previous)
+	assert.Equal(t, int64(gib), total)
+	assert.Equal(t, int64(gib), cus.DailyUsage["stored_data"].Total)
+}
+
+func TestRecalculateStorage_PendingUsage(t *testing.T) {
+	bc := newTestBillingClient()
+	counter := &testStorageCounter{sizes: make(map[string]int64)}
+	tx := &Textile{bc: bc, storage: counter, usage: newUsageBatcher(bc, time.Hour, 1)}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	counter.sizes[dev.Key.String()] = 2 * gib
+
+	// Pending usage is flushed before the total is overwritten, so it's not counted twice.
+	tx.usage.add(dev.Key, map[string]int64{"stored_data": gib})
+	_, total, err := tx.RecalculateStorage(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*gib), total)
+	assert.Empty(t, tx.usage.pendingFor(dev.Key))
+	cus, err := bc.GetCustomer(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*gib), cus.DailyUsage["stored_data"].Total)
+}
+
+func TestRecalculateStorage_CounterError(t *testing.T) {
+	bc := newTestBillingClient()
+	counter := &testStorageCounter{err: errors.New("ipfs unavailable")}
+	tx := &Textile{bc: bc, storage: counter}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["stored_data"].Total = gib
+	bc.setCustomer(cus)
+
+	_, _, err := tx.RecalculateStorage(context.Background(), dev.Key)
+	require.Error(t, err)
+	assert.Equal(t, int64(gib), cus.DailyUsage["stored_data"].Total)
+	assert.Empty(t, bc.getIncs())
+}
+
+type testStorageCounter struct {
+	sizes map[string]int64
+	err   error
+}
+
+func (c *testStorageCounter) OwnerStorage(_ context.Context, owner thread.PubKey) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.sizes[owner.String()], nil
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// defaultStorageRoundingUnit is the default unit that stored data is rounded to, which matches
+// the unit size billingd uses for stored data.
+const defaultStorageRoundingUnit = 8 * 1024 * 1024
+
+// storageRoundingMode is how usage deltas, e.g., storage deltas, are rounded to a billing unit.
+type storageRoundingMode int
+
+const (
+	// storageRoundingOff reports storage deltas as-is.
+	storageRoundingOff storageRoundingMode = iota
+	// storageRoundingCeil rounds deltas up, which never under-bills.
+	storageRoundingCeil
+	// storageRoundingFloor rounds deltas down, carrying the remainder to the owner's next delta.
+	storageRoundingFloor
+	// storageRoundingNearest rounds deltas to the nearest unit, carrying the remainder to the owner's next delta.
+	storageRoundingNearest
+)
+
+func parseStorageRounding(mode string) (storageRoundingMode, error) {
+	switch strings.ToLower(mode) {
+	case "", "off":
+		return storageRoundingOff, nil
+	case "ceil":
+		return storageRoundingCeil, nil
+	case "floor":
+		return storageRoundingFloor, nil
+	case "nearest":
+		return storageRoundingNearest, nil
+	default:
+		return 0, fmt.Errorf("invalid storage rounding: %s", mode)
+	}
+}
+
+// usageRounder rounds the usage deltas reported for owners to a multiple of each usage key's unit,
+// e.g., stored_data to MiB. Keys without a unit, e.g., instance_reads, are reported in raw counts.
+type usageRounder struct {
+	mode  storageRoundingMode
+	units map[string]int64
+
+	lk    sync.Mutex
+	carry map[string]int64
+}
+
+// newUsageRounder returns a rounder for units, which maps usage keys to their unit.
+// Stored data is rounded to the default storage unit unless it has a unit.
+func newUsageRounder(mode storageRoundingMode, units map[string]int64) *usageRounder {
+	r := &usageRounder{
+		mode:  mode,
+		units: map[string]int64{"stored_data": defaultStorageRoundingUnit},
+		carry: make(map[string]int64),
+	}
+	for k, unit := range units {
+		if unit > 0 {
+			r.units[k] = unit
+		}
+	}
+	return r
+}
+
+// round returns delta rounded to a multiple of the unit of key.
+// Ceil rounding isn't carried, so that every delta is billed at least what was used,
+// e.g., removing less than a unit doesn't reduce the billed amount.
+// Remainders are carried separately for each owner and key.
+func (r *usageRounder) round(owner, key string, delta int64) int64 {
+	unit, ok := r.units[key]
+	if !ok || unit == 1 {
+		return delta
+	}
+	if r.mode == storageRoundingCeil {
+		return ceilDiv(delta, unit) * unit
+	}
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	k := owner + "/" + key
+	v := delta + r.carry[k]
+	var units int64
+	switch r.mode {
+	case storageRoundingFloor:
+		units = floorDiv(v, unit)
+	case storageRoundingNearest:
+		units = floorDiv(2*v+unit, 2*unit)
+	default:
+		return delta
+	}
+	rounded := units * unit
+	if rem := v - rounded; rem != 0 {
+		r.carry[k] = rem
+	} else {
+		delete(r.carry, k)
+	}
+	return rounded
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func ceilDiv(a, b int64) int64 {
+	return -floorDiv(-a, b)
+}
+
+// roundUsage rounds an owner's usage deltas in place to the unit of each key with the configured policy.
+func (t *Textile) roundUsage(key thread.PubKey, usage map[string]int64) {
+	if t.rounder == nil {
+		return
+	}
+	for k, v := range usage {
+		usage[k] = t.rounder.round(key.String(), k, v)
+	}
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+)
+
+func TestStorageRounder_Round(t *testing.T) {
+	const unit = 8 * mib
+	tests := []struct {
+		name   string
+		mode   storageRoundingMode
+		deltas []int64
+		billed []int64
+	}{
+		{
+			name:   "ceil",
+			mode:   storageRoundingCeil,
+			deltas: []int64{mib, 9 * mib, unit, -mib, -9 * mib},
+			billed: []int64{unit, 2 * unit, unit, 0, -unit},
+		},
+		{
+			name:   "floor",
+			mode:   storageRoundingFloor,
+			deltas: []int64{3 * mib, 3 * mib, 3 * mib, -2 * mib},
+			billed: []int64{0, 0, unit, -unit},
+		},
+		{
+			name:   "nearest",
+			mode:   storageRoundingNearest,
+			deltas: []int64{3 * mib, 3 * mib, 4 * mib, 11 * mib},
+			billed: []int64{0, unit, 0, 2 * unit},
+		},
+		{
+			name:   "off",
+			mode:   storageRoundingOff,
+			deltas: []int64{3 * mib, -mib},
+			billed: []int64{3 * mib, -mib},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newUsageRounder(tc.mode, map[string]int64{"stored_data": unit})
+			var total, billed int64
+			for i, d := range tc.deltas {
+				assert.Equal(t, tc.billed[i], r.round("owner", "stored_data", d), "delta %d", i)
+				total += d
+				billed += tc.billed[i]
+			}
+			if tc.mode == storageRoundingFloor || tc.mode == storageRoundingNearest {
+				// The remainder is carried so nothing is lost across deltas.
+				assert.Equal(t, total, billed+r.carry["owner/stored_data"])
+			}
+		})
+	}
+}
+
+func TestParseStorageRounding(t *testing.T) {
+	mode, err := parseStorageRounding("")
+	require.NoError(t, err)
+	assert.Equal(t, storageRoundingOff, mode)
+	mode, err = parseStorageRounding("Ceil")
+	require.NoError(t, err)
+	assert.Equal(t, storageRoundingCeil, mode)
+	_, err = parseStorageRounding("up")
+	require.Error(t, err)
+}
+
+func TestPostUsageFunc_StorageRounding(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, rounder: newUsageRounder(storageRoundingCeil, nil)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = 1
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+
+	// Stored data is billed in whole units.
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(defaultStorageRoundingUnit), incs[0].usage["stored_data"])
+}
+
+func TestRecordUsage_RoundingUnits(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, rounder: newUsageRounder(storageRoundingFloor, map[string]int64{"stored_data": mib})}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Stored data is reported in MiB, carrying the remainder.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = 3*mib + 100
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+
+	// Reads are reported in raw counts.
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"instance_reads": 7}))
+
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, int64(3*mib), incs[0].usage["stored_data"])
+	assert.Equal(t, int64(7), incs[1].usage["instance_reads"])
+	assert.Equal(t, int64(100), tx.rounder.carry[dev.Key.String()+"/stored_data"])
+}
+
+func TestUsageRounder_Units(t *testing.T) {
+	r := newUsageRounder(storageRoundingCeil, map[string]int64{"network_egress": mib})
+	assert.Equal(t, int64(defaultStorageRoundingUnit), r.round("owner", "stored_data", 1))
+	assert.Equal(t, int64(mib), r.round("owner", "network_egress", 1))
+	assert.Equal(t, int64(3), r.round("owner", "instance_reads", 3))
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/buckets"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// defaultStorageSoftCapWindow is the default time after which an owner can be notified again.
+const defaultStorageSoftCapWindow = time.Hour * 24 * 30
+
+// storageNotifier notifies owners about their stored data.
+type storageNotifier interface {
+	// StorageSoftCap prompts an owner whose stored data crossed a soft cap to upgrade.
+	StorageSoftCap(ctx context.Context, id, username, email string, used, softCap int64) error
+}
+
+// storageSoftCaps records when owners were last notified about crossing the storage soft cap.
+type storageSoftCaps struct {
+	lk       sync.Mutex
+	notified map[string]time.Time
+}
+
+func newStorageSoftCaps() *storageSoftCaps {
+	return &storageSoftCaps{notified: make(map[string]time.Time)}
+}
+
+// mark returns true if owner has not been notified within window of now,
+// in which case now is recorded as their last notification.
+func (s *storageSoftCaps) mark(owner string, now time.Time, window time.Duration) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	if last, ok := s.notified[owner]; ok && now.Sub(last) < window {
+		return false
+	}
+	s.notified[owner] = now
+	return true
+}
+
+// unmark forgets an owner's last notification, e.g., if it failed to send.
+func (s *storageSoftCaps) unmark(owner string) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	delete(s.notified, owner)
+}
+
+func newStorageSoftCapContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, usageCtxKey("storageSoftCap"), true)
+}
+
+func isStorageSoftCapRequest(ctx context.Context) bool {
+	ok, _ := ctx.Value(usageCtxKey("storageSoftCap")).(bool)
+	return ok
+}
+
+// notifyStorageSoftCap emails a non-billable owner whose stored data was pushed across the soft cap
+// by a request. Owners are notified at most once per window. Failures are logged, since the request
+// has already succeeded.
+func (t *Textile) notifyStorageSoftCap(
+	ctx context.Context,
+	account *mdb.AccountCtx,
+	ownerKey thread.PubKey,
+	owner *buckets.BucketOwner,
+) {
+	softCap := t.conf.StorageSoftCap
+	if t.softCaps == nil || !isStorageSoftCapRequest(ctx) {
+		return
+	}
+	used := owner.StorageUsed + owner.StorageDelta
+	if owner.StorageUsed >= softCap || used < softCap {
+		return
+	}
+	window := t.conf.StorageSoftCapWindow
+	if window == 0 {
+		window = defaultStorageSoftCapWindow
+	}
+	key := ownerKey.String()
+	if !t.softCaps.mark(key, t.now(), window) {
+		return
+	}
+	email, err := t.getAccountCtxEmail(ctx, account)
+	if err != nil {
+		log.Errorf("getting email for storage soft cap notice to %s: %v", key, err)
+		t.softCaps.unmark(key)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+	defer cancel()
+	if err := t.notifier.StorageSoftCap(ctx, key, account.Owner().Username, email, used, softCap); err != nil {
+		log.Errorf("sending storage soft cap notice to %s: %v", key, err)
+		t.softCaps.unmark(key)
+	}
+}
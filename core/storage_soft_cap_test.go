@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+)
+
+func TestPostUsageFunc_StorageSoftCap(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	notifier := &testStorageNotifier{}
+	tx := &Textile{
+		bc:       bc,
+		clock:    clock,
+		softCaps: newStorageSoftCaps(),
+		notifier: notifier,
+		conf:     Config{StorageSoftCap: 4 * gib, StorageSoftCapWindow: time.Hour},
+	}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+
+	push := func(used, delta int64) {
+		cus.DailyUsage["stored_data"].Total = used
+		ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+		require.NoError(t, err)
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageDelta = delta
+		require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	}
+
+	// Below the soft cap.
+	push(gib, gib)
+	assert.Empty(t, notifier.get())
+
+	// Crossing the soft cap sends one notice.
+	push(3*gib, 2*gib)
+	notices := notifier.get()
+	require.Len(t, notices, 1)
+	assert.Equal(t, dev.Key.String(), notices[0].id)
+	assert.Equal(t, dev.Email, notices[0].email)
+	assert.Equal(t, int64(5*gib), notices[0].used)
+
+	// Pushes above the soft cap and repeat crossings within the window don't.
+	push(5*gib, mib)
+	push(3*gib, 2*gib)
+	assert.Len(t, notifier.get(), 1)
+
+	// Crossing again after the window sends another notice.
+	clock.advance(time.Hour)
+	push(3*gib, 2*gib)
+	assert.Len(t, notifier.get(), 2)
+
+	// Billable owners aren't notified.
+	cus.Billable = true
+	clock.advance(time.Hour)
+	push(3*gib, 2*gib)
+	assert.Len(t, notifier.get(), 2)
+}
+
+type testStorageNotice struct {
+	id, username, email string
+	used, softCap       int64
+}
+
+type testStorageNotifier struct {
+	lk      sync.Mutex
+	notices []testStorageNotice
+}
+
+func (n *testStorageNotifier) StorageSoftCap(
+	_ context.Context,
+	id, username, email string,
+	used, softCap int64,
+) error {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	n.notices = append(n.notices, testStorageNotice{id, username, email, used, softCap})
+	return nil
+}
+
+func (n *testStorageNotifier) get() []testStorageNotice {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	return append([]testStorageNotice(nil), n.notices...)
+}
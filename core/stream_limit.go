@@ -0,0 +1,63 @@
+package core
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errTooManyStreams is returned for new streams while the server is at its stream limit.
+var errTooManyStreams = status.Error(codes.Unavailable, "too many concurrent streams")
+
+// streamLimiter bounds the number of concurrent streams across all owners.
+type streamLimiter struct {
+	max int
+
+	lk   sync.Mutex
+	open int
+}
+
+func newStreamLimiter(max int) *streamLimiter {
+	return &streamLimiter{max: max}
+}
+
+// acquire records a new stream.
+// False is returned if the limiter is at capacity and the stream should be rejected.
+func (l *streamLimiter) acquire() bool {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	if l.open >= l.max {
+		return false
+	}
+	l.open++
+	return true
+}
+
+// release records the end of a stream.
+func (l *streamLimiter) release() {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	l.open--
+}
+
+// streamLimitStreamServerInterceptor rejects new streams while l is at capacity.
+// Streams are not limited if l is nil.
+func streamLimitStreamServerInterceptor(l *streamLimiter) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if l == nil {
+			return handler(srv, stream)
+		}
+		if !l.acquire() {
+			return errTooManyStreams
+		}
+		defer l.release()
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/textileio/textile/v2/api/billingd/common"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamRevalidation periodically rechecks that the owner of a long-lived stream is still
+// allowed to use it, e.g., that their subscription wasn't canceled after the stream was opened.
+type streamRevalidation struct {
+	checks []revalidationCheck
+
+	lk   sync.Mutex
+	err  error
+	done sync.WaitGroup
+}
+
+// revalidationCheck is a check that's run on its own interval while a stream is open.
+type revalidationCheck struct {
+	interval time.Duration
+	check    func(ctx context.Context) error
+}
+
+func newStreamRevalidationContext(ctx context.Context, r *streamRevalidation) context.Context {
+	return context.WithValue(ctx, usageCtxKey("streamRevalidation"), r)
+}
+
+func streamRevalidationFromContext(ctx context.Context) (*streamRevalidation, bool) {
+	r, ok := ctx.Value(usageCtxKey("streamRevalidation")).(*streamRevalidation)
+	return r, ok
+}
+
+// withStreamRevalidation adds check to the stream's revalidation, which is attached to ctx if
+// it doesn't have one yet.
+func withStreamRevalidation(
+	ctx context.Context,
+	interval time.Duration,
+	check func(ctx context.Context) error,
+) context.Context {
+	r, ok := streamRevalidationFromContext(ctx)
+	if !ok {
+		r = &streamRevalidation{}
+		ctx = newStreamRevalidationContext(ctx, r)
+	}
+	r.checks = append(r.checks, revalidationCheck{interval: interval, check: check})
+	return ctx
+}
+
+// start runs each check on its interval until ctx is done.
+// cancel is called with the first failed check, which is then returned by stop.
+func (r *streamRevalidation) start(ctx context.Context, cancel context.CancelFunc) {
+	for _, c := range r.checks {
+		r.done.Add(1)
+		go func(c revalidationCheck) {
+			defer r.done.Done()
+			ticker := time.NewTicker(c.interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := c.check(ctx); err != nil {
+						r.lk.Lock()
+						if r.err == nil {
+							r.err = err
+						}
+						r.lk.Unlock()
+						cancel()
+						return
+					}
+				}
+			}
+		}(c)
+	}
+}
+
+// stop waits for revalidation to exit, which requires the stream's context to be done,
+// returning the error that terminated the stream, if any.
+func (r *streamRevalidation) stop() error {
+	r.done.Wait()
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	return r.err
+}
+
+// revalidateListen returns an error if the owner of a Listen stream is no longer allowed to read,
+// i.e., they entered a maintenance window, their subscription is no longer active, or their
+// instance reads are exhausted. Failing to fetch the customer doesn't terminate the stream.
+func (t *Textile) revalidateListen(ctx context.Context, method string, account *mdb.AccountCtx) error {
+	now := t.now()
+	if err := t.checkMaintenance(ctx, method, account, now); err != nil {
+		return err
+	}
+	ownerKey := t.ownerKey(ctx, account)
+	cus, err := t.bc.GetCustomer(ctx, ownerKey)
+	if err != nil {
+		log.Warnf("revalidating stream for %s: %v", ownerKey, err)
+		return nil
+	}
+	if err := t.checkSubscription(ownerKey, method, cus, now); err != nil {
+		return t.deny(ctx, method, account, denialSuspension,
+			status.Error(codes.FailedPrecondition, err.Error()))
+	}
+	cus = t.applyPendingUsage(ownerKey, cus)
+	cus = t.applyResellerMultiplier(cus)
+	cus = t.applyTrialPolicy(cus)
+	if !t.isNewAccount(account.Owner(), now) && t.usageExhausted(cus, "instance_reads", now) {
+		err = withResetHint(fmt.Errorf("threaddb reads exhausted: %v", common.ErrExceedsFreeQuota),
+			cus, "instance_reads")
+		return t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, err.Error()))
+	}
+	return nil
+}
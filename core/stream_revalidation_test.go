@@ -0,0 +1,95 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tpb "github.com/textileio/go-threads/api/pb"
+	pb "github.com/textileio/textile/v2/api/billingd/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamServerInterceptor_ListenRevalidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		revoke func(cus *pb.GetCustomerResponse)
+		code   codes.Code
+	}{
+		{
+			name:   "canceled",
+			revoke: func(cus *pb.GetCustomerResponse) { cus.SubscriptionStatus = "canceled" },
+			code:   codes.FailedPrecondition,
+		},
+		{
+			name:   "exhausted",
+			revoke: func(cus *pb.GetCustomerResponse) { cus.DailyUsage["instance_reads"].Free = 0 },
+			code:   codes.ResourceExhausted,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, conf: Config{ListenRevalidateInterval: 10 * time.Millisecond}}
+			dev := newTestDev(t)
+			bc.setCustomer(newTestCustomer(dev.Key))
+
+			opened := make(chan struct{})
+			handler := func(_ interface{}, ss grpc.ServerStream) error {
+				require.NoError(t, ss.SendMsg(&tpb.ListenReply{Instance: []byte("{}")}))
+				close(opened)
+				<-ss.Context().Done()
+				return status.Error(codes.Canceled, ss.Context().Err().Error())
+			}
+			done := make(chan error)
+			go func() {
+				stream := &testServerStream{ctx: newTestAccountContext(dev)}
+				info := &grpc.StreamServerInfo{FullMethod: listenMethod, IsServerStream: true}
+				done <- streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+			}()
+
+			// The owner becomes ineligible after the stream is opened.
+			<-opened
+			cus := newTestCustomer(dev.Key)
+			tc.revoke(cus)
+			bc.setCustomer(cus)
+
+			select {
+			case err := <-done:
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err))
+			case <-time.After(5 * time.Second):
+				t.Fatal("stream was not terminated")
+			}
+
+			// Reads consumed before the stream was terminated are still recorded.
+			incs := bc.getIncs()
+			require.Len(t, incs, 1)
+			assert.Equal(t, int64(1), incs[0].usage["instance_reads"])
+		})
+	}
+}
+
+func TestStreamServerInterceptor_ListenRevalidationEligible(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{ListenRevalidateInterval: 10 * time.Millisecond}}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Eligible owners are rechecked without terminating the stream.
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		select {
+		case <-ss.Context().Done():
+			return ss.Context().Err()
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	}
+	stream := &testServerStream{ctx: newTestAccountContext(dev)}
+	info := &grpc.StreamServerInfo{FullMethod: listenMethod, IsServerStream: true}
+	err := streamServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)(nil, stream, info, handler)
+	require.NoError(t, err)
+}
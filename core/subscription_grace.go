@@ -0,0 +1,78 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/common"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// lapsedSubscriptions records when each owner's subscription was first observed in a bad status.
+type lapsedSubscriptions struct {
+	lk     sync.Mutex
+	lapsed map[string]time.Time
+}
+
+func newLapsedSubscriptions() *lapsedSubscriptions {
+	return &lapsedSubscriptions{lapsed: make(map[string]time.Time)}
+}
+
+// observe records that owner's subscription is in a bad status at now, returning when the
+// bad status was first observed.
+func (l *lapsedSubscriptions) observe(owner string, now time.Time) time.Time {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	since, ok := l.lapsed[owner]
+	if !ok {
+		since = now
+		l.lapsed[owner] = since
+	}
+	return since
+}
+
+// clear forgets that owner's subscription was in a bad status, e.g., after a payment succeeds.
+func (l *lapsedSubscriptions) clear(owner string) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	delete(l.lapsed, owner)
+}
+
+// isSubscriptionReadMethod returns whether or not method is a read that's allowed during the
+// subscription grace period, i.e., it's metered as threaddb reads or it reads buckets.
+func (t *Textile) isSubscriptionReadMethod(method string) bool {
+	if policy, ok := t.methodPolicy(method); ok && policy.Quota == "instance_reads" {
+		return true
+	}
+	for _, m := range subscriptionReadMethods {
+		if method == m {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSubscription returns an error if a customer's subscription status doesn't allow method.
+// Reads are allowed until Config.SubscriptionGracePeriod after the bad status was first observed,
+// and writes are denied immediately.
+func (t *Textile) checkSubscription(
+	ownerKey thread.PubKey,
+	method string,
+	cus *pb.GetCustomerResponse,
+	now time.Time,
+) error {
+	err := common.StatusCheck(cus.SubscriptionStatus)
+	if t.lapses == nil {
+		return err
+	}
+	if err == nil {
+		t.lapses.clear(ownerKey.String())
+		return nil
+	}
+	since := t.lapses.observe(ownerKey.String(), now)
+	if t.isSubscriptionReadMethod(method) && now.Before(since.Add(t.conf.SubscriptionGracePeriod)) {
+		return nil
+	}
+	return err
+}
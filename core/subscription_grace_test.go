@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_SubscriptionGracePeriod(t *testing.T) {
+	clock := newTestClock(time.Date(2021, 3, 1, 20, 0, 0, 0, time.UTC))
+	bc := newTestBillingClient()
+	tx := &Textile{
+		bc:     bc,
+		clock:  clock,
+		conf:   Config{SubscriptionGracePeriod: time.Hour},
+		lapses: newLapsedSubscriptions(),
+	}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.SubscriptionStatus = "past_due"
+	bc.setCustomer(cus)
+
+	// Reads are allowed within the grace period of a just-lapsed owner.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.NoError(t, err)
+
+	// Writes are denied immediately.
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Reads are denied after the grace period, which starts when the lapse was first observed.
+	clock.advance(59 * time.Minute)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	clock.advance(time.Minute)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The grace period starts over after the subscription recovers.
+	cus.SubscriptionStatus = "active"
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	cus.SubscriptionStatus = "unpaid"
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+}
+
+func TestPreUsageFunc_SubscriptionGracePeriodDisabled(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.SubscriptionStatus = "past_due"
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
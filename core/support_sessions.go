@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	apic "github.com/textileio/textile/v2/api/common"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+// defaultSupportSessionMaxLifetime is the default max time before a support-session token expires.
+const defaultSupportSessionMaxLifetime = time.Hour * 24
+
+// auditLogger logs a message with structured key-value pairs, e.g., log.Infow.
+type auditLogger func(msg string, keysAndValues ...interface{})
+
+// supportActor returns the actor of a valid support-session token in ctx's metadata.
+// False is returned if support sessions are not enabled, the token is invalid, or the token
+// was not issued for the owner billed for a request made by account.
+func (t *Textile) supportActor(ctx context.Context, account *mdb.AccountCtx) (string, bool) {
+	if t.conf.SupportSessionKey == "" || account.Owner() == nil {
+		return "", false
+	}
+	token, ok := apic.SupportSessionFromMD(ctx)
+	if !ok {
+		return "", false
+	}
+	maxLifetime := t.conf.SupportSessionMaxLifetime
+	if maxLifetime <= 0 {
+		maxLifetime = defaultSupportSessionMaxLifetime
+	}
+	actor, owner, ok := apic.ValidateSupportSession(token, t.conf.SupportSessionKey, t.now(), maxLifetime)
+	if !ok || owner != t.ownerKey(ctx, account).String() {
+		return "", false
+	}
+	return actor, true
+}
+
+// auditSupportSession logs a request made by a support actor on behalf of an owner.
+func (t *Textile) auditSupportSession(ctx context.Context, method string, account *mdb.AccountCtx, actor string) {
+	logSupport := t.logSupport
+	if logSupport == nil {
+		logSupport = log.Infow
+	}
+	kvs := []interface{}{
+		"actor", actor,
+		"method", method,
+	}
+	if account.Owner() != nil {
+		kvs = append(kvs, "owner", t.ownerLabel(t.ownerKey(ctx, account)))
+	}
+	logSupport("support session request", kvs...)
+}
+
+// newSupportSessionContext marks the request in ctx as made by a support actor,
+// so that its usage isn't recorded.
+func newSupportSessionContext(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, usageCtxKey("supportActor"), actor)
+}
+
+func isSupportSessionRequest(ctx context.Context) bool {
+	_, ok := ctx.Value(usageCtxKey("supportActor")).(string)
+	return ok
+}
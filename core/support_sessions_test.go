@@ -0,0 +1,123 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	mbase "github.com/multiformats/go-multibase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apic "github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestSupportKey(t *testing.T) string {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	key, err := mbase.Encode(mbase.Base32, b)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestSupportContext(ctx context.Context, token string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("x-textile-support-session", token))
+}
+
+func TestPreUsageFunc_SupportSession(t *testing.T) {
+	now := time.Now()
+	key := newTestSupportKey(t)
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{bc: bc, clock: newTestClock(now), logSupport: logger.log, conf: Config{SupportSessionKey: key}}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Free = 0
+	cus.DailyUsage["stored_data"].Free = 0
+	bc.setCustomer(cus)
+
+	// The owner's quota is exhausted.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Support bypasses the quota without recording usage.
+	token, err := apic.CreateSupportSession("alice@support", dev.Key.String(), now.Add(time.Hour), key)
+	require.NoError(t, err)
+	ctx, err := tx.preUsageFunc(newTestSupportContext(newTestAccountContext(dev), token), findMethod)
+	require.NoError(t, err)
+	require.NoError(t, tx.postUsageFunc(ctx, findMethod))
+
+	ctx, err = tx.preUsageFunc(newTestSupportContext(newTestAccountContext(dev), token), pushPathMethod)
+	require.NoError(t, err)
+	_, ok := buckets.BucketOwnerFromContext(ctx)
+	assert.False(t, ok)
+	ctx = buckets.NewBucketOwnerContext(ctx, &buckets.BucketOwner{StorageDelta: mib})
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	assert.Empty(t, bc.getIncs())
+
+	// Requests are audit-logged with the support actor.
+	require.Len(t, logger.entries, 2)
+	assert.Equal(t, "alice@support", logger.entries[0]["actor"])
+	assert.Equal(t, findMethod, logger.entries[0]["method"])
+	assert.Equal(t, dev.Key.String(), logger.entries[0]["owner"])
+	assert.Equal(t, pushPathMethod, logger.entries[1]["method"])
+}
+
+func TestPreUsageFunc_SupportSessionInvalid(t *testing.T) {
+	now := time.Now()
+	key := newTestSupportKey(t)
+	dev := newTestDev(t)
+	owner := dev.Key.String()
+	expired, err := apic.CreateSupportSession("alice@support", owner, now.Add(-time.Minute), key)
+	require.NoError(t, err)
+	forged, err := apic.CreateSupportSession("alice@support", owner, now.Add(time.Hour), newTestSupportKey(t))
+	require.NoError(t, err)
+	otherOwner, err := apic.CreateSupportSession("alice@support", newTestDev(t).Key.String(), now.Add(time.Hour), key)
+	require.NoError(t, err)
+	longLived, err := apic.CreateSupportSession("alice@support", owner, now.Add(defaultSupportSessionMaxLifetime+time.Minute), key)
+	require.NoError(t, err)
+	hourLong, err := apic.CreateSupportSession("alice@support", owner, now.Add(time.Hour), key)
+	require.NoError(t, err)
+	_, err = apic.CreateSupportSession("alice@support", "", now.Add(time.Hour), key)
+	require.Error(t, err)
+
+	tests := []struct {
+		name  string
+		conf  Config
+		token string
+	}{
+		{name: "expired", conf: Config{SupportSessionKey: key}, token: expired},
+		{name: "forged", conf: Config{SupportSessionKey: key}, token: forged},
+		{name: "malformed", conf: Config{SupportSessionKey: key}, token: "alice@support"},
+		{name: "other owner", conf: Config{SupportSessionKey: key}, token: otherOwner},
+		{name: "default max lifetime", conf: Config{SupportSessionKey: key}, token: longLived},
+		{
+			name:  "max lifetime",
+			conf:  Config{SupportSessionKey: key, SupportSessionMaxLifetime: time.Minute},
+			token: hourLong,
+		},
+		{name: "disabled", token: forged},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			logger := &testDenialLogger{}
+			tx := &Textile{bc: bc, clock: newTestClock(now), logSupport: logger.log, conf: tc.conf}
+			cus := newTestCustomer(dev.Key)
+			cus.DailyUsage["instance_reads"].Free = 0
+			bc.setCustomer(cus)
+
+			// Invalid tokens don't bypass quotas.
+			_, err := tx.preUsageFunc(newTestSupportContext(newTestAccountContext(dev), tc.token), findMethod)
+			require.Error(t, err)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			assert.Empty(t, logger.entries)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"context"
+	"strings"
+
+	grpcm "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/textileio/textile/v2/core"
+
+var (
+	keyOwner      = label.Key("textile.owner")
+	keyStatusCode = label.Key("rpc.grpc.status_code")
+
+	// tracePropagator carries W3C trace context in request metadata.
+	tracePropagator = propagation.TraceContext{}
+)
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	vals := metadata.MD(c).Get(key)
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// startServerSpan starts a server span for method that continues any trace propagated by the caller.
+func startServerSpan(ctx context.Context, tracer trace.Tracer, method string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = tracePropagator.Extract(ctx, metadataCarrier(md))
+	}
+	attrs := []label.KeyValue{semconv.RPCSystemGRPC}
+	if parts := strings.Split(strings.TrimPrefix(method, "/"), "/"); len(parts) == 2 {
+		attrs = append(attrs, semconv.RPCServiceKey.String(parts[0]), semconv.RPCMethodKey.String(parts[1]))
+	}
+	return tracer.Start(
+		ctx,
+		method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endSpan records the request outcome and ends span.
+func endSpan(span trace.Span, err error) {
+	s := status.Convert(err)
+	span.SetAttributes(keyStatusCode.Int(int(s.Code())))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, s.Message())
+	}
+	span.End()
+}
+
+// setSpanOwner attaches the label of the request owner to the current span.
+func setSpanOwner(ctx context.Context, owner string) {
+	trace.SpanFromContext(ctx).SetAttributes(keyOwner.String(owner))
+}
+
+// injectTraceContext propagates the current span to outgoing requests.
+func injectTraceContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	tracePropagator.Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// tracingUnaryServerInterceptor wraps unary handlers in a server span.
+// Tracing is disabled if tracer is nil.
+func tracingUnaryServerInterceptor(tracer trace.Tracer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if tracer == nil {
+			return handler(ctx, req)
+		}
+		ctx, span := startServerSpan(ctx, tracer, info.FullMethod)
+		res, err := handler(ctx, req)
+		endSpan(span, err)
+		return res, err
+	}
+}
+
+// tracingStreamServerInterceptor wraps stream handlers in a server span.
+// Tracing is disabled if tracer is nil.
+func tracingStreamServerInterceptor(tracer trace.Tracer) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if tracer == nil {
+			return handler(srv, stream)
+		}
+		ctx, span := startServerSpan(stream.Context(), tracer, info.FullMethod)
+		wrapped := grpcm.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		err := handler(srv, wrapped)
+		endSpan(span, err)
+		return err
+	}
+}
+
+// tracedBillingClient records billing calls made while handling a request as child spans.
+// Owners are labeled by label, the real key is sent to billingd.
+type tracedBillingClient struct {
+	billingClient
+	tracer trace.Tracer
+	label  ownerLabeler
+}
+
+func newTracedBillingClient(bc billingClient, tracer trace.Tracer, label ownerLabeler) billingClient {
+	if label == nil {
+		label = newOwnerLabeler("")
+	}
+	return &tracedBillingClient{billingClient: bc, tracer: tracer, label: label}
+}
+
+func (c *tracedBillingClient) GetCustomer(ctx context.Context, key thread.PubKey) (*pb.GetCustomerResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "billing.GetCustomer", trace.WithAttributes(keyOwner.String(c.label(key.String()))))
+	res, err := c.billingClient.GetCustomer(injectTraceContext(ctx), key)
+	endSpan(span, err)
+	return res, err
+}
+
+func (c *tracedBillingClient) IncCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "billing.IncCustomerUsage", trace.WithAttributes(keyOwner.String(c.label(key.String()))))
+	res, err := c.billingClient.IncCustomerUsage(injectTraceContext(ctx), key, productUsage, opts...)
+	endSpan(span, err)
+	return res, err
+}
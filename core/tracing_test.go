@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	grpcm "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTracing_BillingChildSpans(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	tracer := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr)).Tracer(tracerName)
+	bc := newTestBillingClient()
+	tx := &Textile{bc: newTracedBillingClient(bc, tracer, nil)}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Continue a trace started by the caller.
+	remoteTraceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.NewIncomingContext(newTestAccountContext(dev), metadata.Pairs(
+		"traceparent", "00-"+remoteTraceID+"-00f067aa0ba902b7-01",
+	))
+	intercept := grpcm.ChainUnaryServer(
+		tracingUnaryServerInterceptor(tracer),
+		unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc),
+	)
+	_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pushPathMethod},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			owner, ok := buckets.BucketOwnerFromContext(ctx)
+			require.True(t, ok)
+			owner.StorageDelta = mib
+			return nil, nil
+		})
+	require.NoError(t, err)
+
+	spans := sr.Completed()
+	require.Len(t, spans, 3)
+	spansByName := make(map[string]*oteltest.Span)
+	for _, s := range spans {
+		spansByName[s.Name()] = s
+	}
+	req, ok := spansByName[pushPathMethod]
+	require.True(t, ok)
+	assert.Equal(t, trace.SpanKindServer, req.SpanKind())
+	assert.Equal(t, remoteTraceID, req.SpanContext().TraceID.String())
+	assert.Equal(t, dev.Key.String(), req.Attributes()[keyOwner].AsString())
+	assert.Equal(t, "PushPath", req.Attributes()["rpc.method"].AsString())
+
+	for _, name := range []string{"billing.GetCustomer", "billing.IncCustomerUsage"} {
+		child, ok := spansByName[name]
+		require.True(t, ok, name)
+		assert.Equal(t, req.SpanContext().SpanID, child.ParentSpanID(), name)
+		assert.Equal(t, remoteTraceID, child.SpanContext().TraceID.String(), name)
+		assert.Equal(t, dev.Key.String(), child.Attributes()[keyOwner].AsString(), name)
+	}
+}
+
+func TestTracing_Disabled(t *testing.T) {
+	intercept := tracingUnaryServerInterceptor(nil)
+	_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: findMethod},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			assert.False(t, trace.SpanFromContext(ctx).SpanContext().IsValid())
+			return nil, nil
+		})
+	require.NoError(t, err)
+}
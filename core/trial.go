@@ -0,0 +1,20 @@
+package core
+
+import (
+	"github.com/golang/protobuf/proto"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// applyTrialPolicy returns a copy of a trialing customer that is held to free-tier quotas,
+// unless trialing customers are allowed billable quotas. Other customers are returned as is.
+// The subscription status is read for each request, so converted trials become billable immediately.
+func (t *Textile) applyTrialPolicy(cus *pb.GetCustomerResponse) *pb.GetCustomerResponse {
+	if t.conf.TrialBillable || !cus.Billable ||
+		stripe.SubscriptionStatus(cus.SubscriptionStatus) != stripe.SubscriptionStatusTrialing {
+		return cus
+	}
+	cus = proto.Clone(cus).(*pb.GetCustomerResponse)
+	cus.Billable = false
+	return cus
+}
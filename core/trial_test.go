@@ -0,0 +1,61 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_Trialing(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.SubscriptionStatus = "trialing"
+	cus.Billable = true
+	cus.DailyUsage["instance_reads"].Free = 0
+	cus.DailyUsage["stored_data"].Free = gib
+	bc.setCustomer(cus)
+
+	// Trialing customers are held to free quotas despite the billable flag.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.False(t, owner.IsUnlimited())
+	assert.Equal(t, int64(gib), owner.StorageAvailable)
+	assert.True(t, cus.Billable)
+
+	// Once the trial converts, the customer is billable.
+	cus.SubscriptionStatus = "active"
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+}
+
+func TestPreUsageFunc_TrialBillable(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{TrialBillable: true}}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.SubscriptionStatus = "trialing"
+	cus.Billable = true
+	cus.DailyUsage["instance_reads"].Free = 0
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// Non-billable trialing customers are held to free quotas either way.
+	cus.Billable = false
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package core
+
+import (
+	"context"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errUnknownMethod indicates a request to a method that isn't known to be metered or ignored.
+var errUnknownMethod = status.Error(codes.PermissionDenied, "Method is not allowed")
+
+// isMeteredMethod returns whether or not requests to method are metered, i.e., the method has a
+// usage policy or its egress is measured by the stream interceptor.
+func (t *Textile) isMeteredMethod(method string) bool {
+	if _, ok := t.usagePolicy()[method]; ok {
+		return true
+	}
+	return isEgressStreamMethod(method)
+}
+
+// checkUnknownMethod denies requests to methods that are neither auth-ignored, usage-ignored,
+// ignored for the owner, nor metered when FailClosedUnknownMethods is set.
+// Unmetered methods can be allowed with an empty method policy.
+func (t *Textile) checkUnknownMethod(ctx context.Context, method string, account *mdb.AccountCtx) error {
+	if !t.conf.FailClosedUnknownMethods || t.isMeteredMethod(method) {
+		return nil
+	}
+	if account != nil && account.Owner() != nil && t.isOwnerIgnoredMethod(method, t.ownerType(ctx, account)) {
+		return nil
+	}
+	return t.deny(ctx, method, account, denialPermission, errUnknownMethod)
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_FailClosedUnknownMethods(t *testing.T) {
+	const unknownMethod = "/api.bucketsd.pb.APIService/Unknown"
+	tests := []struct {
+		name    string
+		conf    Config
+		method  string
+		allowed bool
+	}{
+		{
+			name:    "unknown allowed without flag",
+			method:  unknownMethod,
+			allowed: true,
+		},
+		{
+			name:   "unknown denied with flag",
+			conf:   Config{FailClosedUnknownMethods: true},
+			method: unknownMethod,
+		},
+		{
+			name:    "metered allowed with flag",
+			conf:    Config{FailClosedUnknownMethods: true},
+			method:  pushPathMethod,
+			allowed: true,
+		},
+		{
+			name:    "usage ignored allowed with flag",
+			conf:    Config{FailClosedUnknownMethods: true},
+			method:  "/api.usersd.pb.APIService/GetLimits",
+			allowed: true,
+		},
+		{
+			name: "empty policy allowed with flag",
+			conf: Config{
+				FailClosedUnknownMethods: true,
+				UsagePolicy:              UsagePolicy{unknownMethod: {}},
+			},
+			method:  unknownMethod,
+			allowed: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, conf: tc.conf}
+			dev := newTestDev(t)
+			bc.setCustomer(newTestCustomer(dev.Key))
+
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), tc.method)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
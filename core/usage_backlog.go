@@ -0,0 +1,142 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errUsageBacklogFull is returned for writes rejected because batched usage can't be sent to billingd.
+var errUsageBacklogFull = status.Error(codes.Unavailable, "usage backlog is full, try again later")
+
+// errUsageBacklogSpilled is recorded with usage that was dead-lettered to relieve the usage backlog.
+var errUsageBacklogSpilled = errors.New("usage backlog is full")
+
+// usageOverflow is what the usage batcher does when its backlog exceeds Config.UsageBacklogMax.
+type usageOverflow int
+
+const (
+	// usageOverflowFlush sends pending usage synchronously.
+	usageOverflowFlush usageOverflow = iota
+	// usageOverflowDeadLetter moves pending usage to the dead-letter store.
+	usageOverflowDeadLetter
+	// usageOverflowReject denies writes until the backlog drains.
+	usageOverflowReject
+)
+
+func parseUsageOverflow(mode string) (usageOverflow, error) {
+	switch strings.ToLower(mode) {
+	case "", "flush":
+		return usageOverflowFlush, nil
+	case "dead_letter":
+		return usageOverflowDeadLetter, nil
+	case "reject":
+		return usageOverflowReject, nil
+	default:
+		return 0, fmt.Errorf("invalid usage backlog overflow: %s", mode)
+	}
+}
+
+// size returns the number of usage deltas held by p.
+func (p *pendingUsage) size() int {
+	n := len(p.usage)
+	for _, usage := range p.scoped {
+		n += len(usage)
+	}
+	return n
+}
+
+// full returns whether or not the backlog has reached its cap.
+func (b *usageBatcher) full() bool {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	return b.maxPending > 0 && b.size >= b.maxPending
+}
+
+// relieve applies the overflow behavior if the backlog has exceeded its cap.
+func (b *usageBatcher) relieve() {
+	b.lk.Lock()
+	over := b.maxPending > 0 && b.size > b.maxPending
+	b.lk.Unlock()
+	if !over {
+		return
+	}
+	switch b.overflow {
+	case usageOverflowFlush:
+		b.flush(context.Background())
+	case usageOverflowDeadLetter:
+		b.spill(context.Background())
+	}
+}
+
+// spill moves usage that isn't being sent to the dead-letter store, from which it's replayed.
+// Usage that fails to be dead-lettered is queued to be sent ahead of newer usage.
+func (b *usageBatcher) spill(ctx context.Context) {
+	if b.deadLetters == nil {
+		return
+	}
+	b.lk.Lock()
+	var spilled []*pendingUsage
+	for k, queue := range b.queues {
+		if b.sending[k] {
+			continue
+		}
+		spilled = append(spilled, queue...)
+		delete(b.queues, k)
+	}
+	for k, batches := range b.pending {
+		for _, p := range batches {
+			p.idempotencyKey = util.MakeToken(32)
+			spilled = append(spilled, p)
+		}
+		delete(b.pending, k)
+	}
+	for _, p := range spilled {
+		b.size -= p.size()
+	}
+	b.lk.Unlock()
+
+	failed := make(map[string][]*pendingUsage)
+	for _, p := range spilled {
+		usage := p.total()
+		if len(usage) == 0 {
+			continue
+		}
+		if err := deadLetterUsage(ctx, b.deadLetters, p.idempotencyKey, p.key, usage, errUsageBacklogSpilled); err != nil {
+			log.Errorf("usage batcher: spilling usage for %s: %v", p.key, err)
+			failed[p.key.String()] = append(failed[p.key.String()], p)
+		}
+	}
+	if len(failed) == 0 {
+		return
+	}
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	for k, list := range failed {
+		b.queues[k] = append(list, b.queues[k]...)
+		for _, p := range list {
+			b.size += p.size()
+		}
+	}
+}
+
+// checkUsageBacklog denies writes while the usage backlog is full if Config.UsageBacklogOverflow is reject.
+func (t *Textile) checkUsageBacklog(ctx context.Context, method string, account *mdb.AccountCtx) error {
+	if t.usage == nil || t.usage.overflow != usageOverflowReject {
+		return nil
+	}
+	policy, ok := t.methodPolicy(method)
+	if !ok || (policy.Quota != "instance_writes" && (!policy.Storage || policy.FreesStorage)) {
+		return nil
+	}
+	if t.usage.full() {
+		return t.deny(ctx, method, account, denialOverload, errUsageBacklogFull)
+	}
+	return nil
+}
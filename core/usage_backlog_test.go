@@ -0,0 +1,108 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUsageBatcher_BacklogFlush(t *testing.T) {
+	bc := newTestBillingClient()
+	b := newUsageBatcher(bc, time.Hour, 2)
+	b.maxPending = 2
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	b.add(dev.Key, map[string]int64{"network_egress": 10, "instance_reads": 1})
+	assert.Empty(t, bc.getIncs())
+
+	// Exceeding the cap sends pending usage without waiting for the flush interval.
+	b.add(dev.Key, map[string]int64{"instance_writes": 1})
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, map[string]int64{"network_egress": 10, "instance_reads": 1, "instance_writes": 1}, incs[0].usage)
+	assert.Equal(t, 0, b.size)
+}
+
+func TestUsageBatcher_BacklogDeadLetter(t *testing.T) {
+	store := newTestDeadLetterStore(newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+	dev := newTestDev(t)
+	bc := &blockingBillingClient{
+		testBillingClient: newTestBillingClient(),
+		fail:              map[string]bool{dev.Key.String(): true},
+	}
+	bc.setCustomer(newTestCustomer(dev.Key))
+	b := newUsageBatcher(bc, time.Hour, 1)
+	b.deadLetters = store
+	b.maxPending = 2
+	b.overflow = usageOverflowDeadLetter
+
+	// Usage that failed to send and newer usage are both spilled.
+	b.add(dev.Key, map[string]int64{"network_egress": 10})
+	b.flush(context.Background())
+	require.Len(t, b.queues[dev.Key.String()], 1)
+	b.add(dev.Key, map[string]int64{"network_egress": 5})
+	assert.Empty(t, store.list())
+	b.add(dev.Key, map[string]int64{"instance_reads": 1})
+
+	letters := store.list()
+	require.Len(t, letters, 2)
+	var egress, reads int64
+	for _, l := range letters {
+		egress += l.Usage["network_egress"]
+		reads += l.Usage["instance_reads"]
+	}
+	assert.Equal(t, int64(15), egress)
+	assert.Equal(t, int64(1), reads)
+	assert.Empty(t, b.queues)
+	assert.Empty(t, b.pending)
+	assert.Equal(t, 0, b.size)
+}
+
+func TestPreUsageFunc_BacklogReject(t *testing.T) {
+	bc := &blockingBillingClient{testBillingClient: newTestBillingClient(), fail: map[string]bool{}}
+	b := newUsageBatcher(bc, time.Hour, 1)
+	b.maxPending = 2
+	b.overflow = usageOverflowReject
+	tx := &Textile{bc: bc, usage: b}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	b.add(dev.Key, map[string]int64{"network_egress": 10})
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+
+	// Writes are denied while the backlog is full, but reads and removals are allowed.
+	b.add(dev.Key, map[string]int64{"instance_writes": 1})
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), saveMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), removePathMethod)
+	require.NoError(t, err)
+
+	// Writes are allowed again once the backlog drains.
+	b.flush(context.Background())
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+}
+
+func TestParseUsageOverflow(t *testing.T) {
+	overflow, err := parseUsageOverflow("")
+	require.NoError(t, err)
+	assert.Equal(t, usageOverflowFlush, overflow)
+	overflow, err = parseUsageOverflow("Dead_Letter")
+	require.NoError(t, err)
+	assert.Equal(t, usageOverflowDeadLetter, overflow)
+	_, err = parseUsageOverflow("drop")
+	require.Error(t, err)
+}
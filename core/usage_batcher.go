@@ -0,0 +1,479 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	"github.com/textileio/textile/v2/util"
+)
+
+const (
+	// defaultUsageFlushConcurrency is the default number of owners flushed in parallel.
+	defaultUsageFlushConcurrency = 10
+
+	// maxUsageFlushAttempts is the number of times an owner's usage is sent to billingd before being
+	// dead-lettered, or dropped if there's no dead-letter store.
+	maxUsageFlushAttempts = 5
+)
+
+// pendingUsage is usage that has not yet been sent to billingd for an owner.
+type pendingUsage struct {
+	key   thread.PubKey
+	usage map[string]int64
+	// bucketKey is the bucket the usage is attributed to, if any.
+	bucketKey string
+	// labels are sent with the usage, e.g., owner metadata.
+	labels map[string]string
+	// scoped holds net deltas keyed by scope, e.g., a bucket path, so that repeated
+	// updates to the same scope compact into a single delta.
+	scoped   map[string]map[string]int64
+	attempts int
+	// idempotencyKey is assigned when the usage is first sent, and reused when it's retried.
+	idempotencyKey string
+}
+
+func (p *pendingUsage) merge(usage map[string]int64) {
+	for k, v := range usage {
+		p.usage[k] += v
+	}
+}
+
+func (p *pendingUsage) mergeScoped(scope string, usage map[string]int64) {
+	if p.scoped == nil {
+		p.scoped = make(map[string]map[string]int64)
+	}
+	cur, ok := p.scoped[scope]
+	if !ok {
+		cur = make(map[string]int64)
+		p.scoped[scope] = cur
+	}
+	for k, v := range usage {
+		cur[k] += v
+		if cur[k] == 0 {
+			delete(cur, k)
+		}
+	}
+	if len(cur) == 0 {
+		delete(p.scoped, scope)
+	}
+}
+
+// total returns the owner's net usage across all scopes. Zero deltas are omitted.
+func (p *pendingUsage) total() map[string]int64 {
+	total := make(map[string]int64)
+	for k, v := range p.usage {
+		total[k] += v
+	}
+	for _, usage := range p.scoped {
+		for k, v := range usage {
+			total[k] += v
+		}
+	}
+	for k, v := range total {
+		if v == 0 {
+			delete(total, k)
+		}
+	}
+	return total
+}
+
+// usageBatcher accumulates usage deltas per owner and periodically flushes them to billingd.
+// Deltas for different owners are flushed in parallel by a bounded pool of workers, while each
+// owner's deltas are sent one at a time in the order they were flushed.
+type usageBatcher struct {
+	bc          billingClient
+	interval    time.Duration
+	concurrency int
+
+	lk sync.Mutex
+	// pending holds each owner's usage that has not been flushed, keyed by batch, see usageBatchKey.
+	pending map[string]map[string]*pendingUsage
+	// queues holds each owner's flushed usage in order, including usage that failed to send.
+	// Failed usage stays at the head of the queue and is retried under its original idempotency key,
+	// separately from newer usage, so that billingd can ignore it if it was actually applied.
+	queues map[string][]*pendingUsage
+	// sending holds the owners whose queue is being sent by a worker.
+	sending map[string]bool
+	// size is the number of usage deltas held in pending and queues.
+	size int
+	// maxPending caps size, after which overflow is applied. There's no cap when zero.
+	maxPending int
+	overflow   usageOverflow
+
+	// deadLetters stores usage that fails to send after maxUsageFlushAttempts.
+	deadLetters usageDeadLetterStore
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newUsageBatcher(bc billingClient, interval time.Duration, concurrency int) *usageBatcher {
+	if concurrency <= 0 {
+		concurrency = defaultUsageFlushConcurrency
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &usageBatcher{
+		bc:          bc,
+		interval:    interval,
+		concurrency: concurrency,
+		pending:     make(map[string]map[string]*pendingUsage),
+		queues:      make(map[string][]*pendingUsage),
+		sending:     make(map[string]bool),
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+}
+
+// start begins flushing usage on the batcher's interval.
+func (b *usageBatcher) start() {
+	go func() {
+		defer close(b.done)
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.ctx.Done():
+				return
+			case <-ticker.C:
+				b.flush(b.ctx)
+			}
+		}
+	}()
+}
+
+// usageBatchKey returns the key of the batch that usage reported with args is accumulated in.
+// Usage is batched separately per bucket and set of labels so that they're sent with it.
+func usageBatchKey(args *billing.UsageOptions) string {
+	parts := make([]string, 0, len(args.Labels))
+	for k, v := range args.Labels {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+	return strings.Join(append([]string{args.BucketKey}, parts...), "\x00")
+}
+
+// newPendingUsage returns empty pending usage for an owner with the options that are sent with it.
+// Idempotency keys are ignored, since batched usage is keyed when it's flushed.
+func newPendingUsage(key thread.PubKey, opts ...billing.UsageOption) *pendingUsage {
+	args := &billing.UsageOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return &pendingUsage{key: key, usage: make(map[string]int64), bucketKey: args.BucketKey, labels: args.Labels}
+}
+
+// options returns the options that usage is sent with, other than its idempotency key.
+func (p *pendingUsage) options() []billing.UsageOption {
+	var opts []billing.UsageOption
+	if p.bucketKey != "" {
+		opts = append(opts, billing.WithBucketKey(p.bucketKey))
+	}
+	if len(p.labels) > 0 {
+		opts = append(opts, billing.WithLabels(p.labels))
+	}
+	return opts
+}
+
+// batchKey returns the key of the batch that p is accumulated in, see usageBatchKey.
+func (p *pendingUsage) batchKey() string {
+	return usageBatchKey(&billing.UsageOptions{BucketKey: p.bucketKey, Labels: p.labels})
+}
+
+// add queues usage for an owner. Usage is batched with usage that has the same options, e.g., a bucket key.
+func (b *usageBatcher) add(key thread.PubKey, usage map[string]int64, opts ...billing.UsageOption) {
+	b.lk.Lock()
+	p := newPendingUsage(key, opts...)
+	p.merge(usage)
+	b.addLocked(p)
+	b.lk.Unlock()
+	b.relieve()
+}
+
+// addScoped queues usage for an owner that's tied to scope, e.g., a bucket path.
+// Successive deltas for the same scope are compacted into a net delta.
+func (b *usageBatcher) addScoped(key thread.PubKey, scope string, usage map[string]int64, opts ...billing.UsageOption) {
+	b.lk.Lock()
+	p := newPendingUsage(key, opts...)
+	p.mergeScoped(scope, usage)
+	b.addLocked(p)
+	b.lk.Unlock()
+	b.relieve()
+}
+
+func (b *usageBatcher) addLocked(p *pendingUsage) {
+	k := p.key.String()
+	batches, ok := b.pending[k]
+	if !ok {
+		batches = make(map[string]*pendingUsage)
+		b.pending[k] = batches
+	}
+	bk := p.batchKey()
+	cur, ok := batches[bk]
+	if !ok {
+		cur = &pendingUsage{key: p.key, usage: make(map[string]int64), bucketKey: p.bucketKey, labels: p.labels}
+		batches[bk] = cur
+	}
+	before := cur.size()
+	cur.merge(p.usage)
+	for scope, usage := range p.scoped {
+		cur.mergeScoped(scope, usage)
+	}
+	b.size += cur.size() - before
+}
+
+// pendingFor returns usage for an owner that has not yet been applied by billingd,
+// including usage that is currently being flushed.
+func (b *usageBatcher) pendingFor(key thread.PubKey) map[string]int64 {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	k := key.String()
+	var list []*pendingUsage
+	for _, p := range b.pending[k] {
+		list = append(list, p)
+	}
+	list = append(list, b.queues[k]...)
+	var usage map[string]int64
+	for _, p := range list {
+		if usage == nil {
+			usage = make(map[string]int64)
+		}
+		for pk, v := range p.total() {
+			usage[pk] += v
+		}
+	}
+	return usage
+}
+
+// flush sends all pending usage to billingd. Owners whose usage fails to send
+// are retried on the next flush without blocking other owners. An owner that's already
+// being sent by a concurrent flush is skipped, and its new usage is sent by that flush.
+func (b *usageBatcher) flush(ctx context.Context) {
+	b.lk.Lock()
+	for k, batches := range b.pending {
+		for _, p := range batches {
+			p.idempotencyKey = util.MakeToken(32)
+			b.queues[k] = append(b.queues[k], p)
+		}
+	}
+	b.pending = make(map[string]map[string]*pendingUsage)
+	var owners []string
+	for k := range b.queues {
+		if !b.sending[k] {
+			b.sending[k] = true
+			owners = append(owners, k)
+		}
+	}
+	b.lk.Unlock()
+	if len(owners) == 0 {
+		return
+	}
+
+	sem := make(chan struct{}, b.concurrency)
+	var wg sync.WaitGroup
+	for _, k := range owners {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(k string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			b.flushOwner(ctx, k)
+		}(k)
+	}
+	wg.Wait()
+}
+
+// flushOwner sends an owner's queued usage in order until the queue is empty.
+// It stops at usage that fails to send, which is retried ahead of newer usage on the next flush.
+func (b *usageBatcher) flushOwner(ctx context.Context, k string) {
+	for {
+		b.lk.Lock()
+		if len(b.queues[k]) == 0 {
+			delete(b.queues, k)
+			delete(b.sending, k)
+			b.lk.Unlock()
+			return
+		}
+		p := b.queues[k][0]
+		b.lk.Unlock()
+
+		err := b.send(ctx, p)
+
+		b.lk.Lock()
+		var perr *partialUsageError
+		if errors.As(err, &perr) {
+			// The rest of the usage was applied, so only the failed usage is retried.
+			size := p.size()
+			p.usage = perr.failed
+			p.scoped = nil
+			p.idempotencyKey = perr.idempotencyKey
+			b.size -= size - p.size()
+		}
+		if err != nil {
+			p.attempts++
+			if p.attempts < maxUsageFlushAttempts {
+				log.Errorf("usage batcher: inc customer usage for %s: %v", p.key, err)
+				delete(b.sending, k)
+				b.lk.Unlock()
+				return
+			}
+			log.Errorf("usage batcher: dropping usage for %s after %d attempts: %v", p.key, p.attempts, err)
+		}
+		b.queues[k] = b.queues[k][1:]
+		b.size -= p.size()
+		b.lk.Unlock()
+	}
+}
+
+// send sends usage to billingd under its idempotency key. Usage that fails to send
+// on its last attempt is dead-lettered, if there's a dead-letter store.
+func (b *usageBatcher) send(ctx context.Context, p *pendingUsage) error {
+	// Usage that compacted to nothing doesn't need to be sent.
+	var err error
+	usage := p.total()
+	if len(usage) > 0 {
+		ctx, cancel := context.WithTimeout(ctx, statsTimeout)
+		defer cancel()
+		opts := append(p.options(), billing.WithIdempotencyKey(p.idempotencyKey))
+		_, err = b.bc.IncCustomerUsage(ctx, p.key, usage, opts...)
+	}
+	if err != nil && p.attempts+1 >= maxUsageFlushAttempts && b.deadLetters != nil {
+		id := p.idempotencyKey
+		var perr *partialUsageError
+		if errors.As(err, &perr) {
+			id, usage = perr.idempotencyKey, perr.failed
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+		defer cancel()
+		if derr := deadLetterUsage(ctx, b.deadLetters, id, p.key, usage, err); derr != nil {
+			log.Errorf("usage batcher: dead-lettering usage for %s: %v", p.key, derr)
+		} else {
+			err = nil
+		}
+	}
+	return err
+}
+
+// close stops the batcher and flushes any pending usage.
+func (b *usageBatcher) close() {
+	b.cancel()
+	<-b.done
+	b.flush(context.Background())
+}
+
+// applyPendingUsage returns a copy of cus that includes usage not yet applied by billingd.
+// cus is returned as-is if usage is not batched or there's no pending usage for the customer.
+func (t *Textile) applyPendingUsage(key thread.PubKey, cus *pb.GetCustomerResponse) *pb.GetCustomerResponse {
+	if t.usage == nil {
+		return cus
+	}
+	pending := t.usage.pendingFor(key)
+	if len(pending) == 0 {
+		return cus
+	}
+	cus = proto.Clone(cus).(*pb.GetCustomerResponse)
+	for k, inc := range pending {
+		u, ok := cus.DailyUsage[k]
+		if !ok {
+			continue
+		}
+		u.Total += inc
+		u.Free -= inc
+		if u.Free < 0 {
+			u.Free = 0
+		}
+		u.Grace -= inc
+		if u.Grace < 0 {
+			u.Grace = 0
+		}
+	}
+	return cus
+}
+
+// recordUsage sends usage for an owner to billingd, or queues it if usage is batched.
+// Usage is first rounded in place to the unit of each key, see Config.UsageRoundingUnits.
+// Batched usage is keyed when it's flushed, so idempotency keys in opts only apply to usage sent directly.
+func (t *Textile) recordUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	usage map[string]int64,
+	opts ...billing.UsageOption,
+) error {
+	t.roundUsage(key, usage)
+	if t.holdUsage(key, usage) {
+		return nil
+	}
+	t.consumeDecisions(key, usage)
+	defer t.watchers.notify(key.String())
+	if t.usage != nil {
+		t.usage.add(key, usage, opts...)
+		return nil
+	}
+	return t.incUsage(ctx, key, usage, opts...)
+}
+
+// recordScopedUsage is like recordUsage, but batched usage is compacted per scope, e.g., a bucket path.
+func (t *Textile) recordScopedUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	scope string,
+	usage map[string]int64,
+	opts ...billing.UsageOption,
+) error {
+	t.roundUsage(key, usage)
+	if t.holdUsage(key, usage) {
+		return nil
+	}
+	t.consumeDecisions(key, usage)
+	defer t.watchers.notify(key.String())
+	if t.usage != nil {
+		t.usage.addScoped(key, scope, usage, opts...)
+		return nil
+	}
+	return t.incUsage(ctx, key, usage, opts...)
+}
+
+// incUsage sends usage for an owner to billingd.
+// Usage that fails to send is dead-lettered under its idempotency key, if any, to be replayed later.
+func (t *Textile) incUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	usage map[string]int64,
+	opts ...billing.UsageOption,
+) error {
+	var err error
+	if t.sampleUsageVerification() {
+		err = t.incVerifiedUsage(ctx, key, usage, opts...)
+	} else {
+		_, err = t.bc.IncCustomerUsage(ctx, key, usage, opts...)
+	}
+	if err == nil || t.deadLetters == nil {
+		return err
+	}
+	args := &billing.UsageOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	id := args.IdempotencyKey
+	var perr *partialUsageError
+	if errors.As(err, &perr) {
+		// The rest of the usage was applied, so only the failed usage is dead-lettered.
+		id, usage = perr.idempotencyKey, perr.failed
+	}
+	if derr := deadLetterUsage(ctx, t.deadLetters, id, key, usage, err); derr != nil {
+		log.Errorf("dead-lettering usage for %s: %v", key, derr)
+		return err
+	}
+	return nil
+}
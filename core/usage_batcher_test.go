@@ -0,0 +1,309 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+func TestUsageBatcher_FlushConcurrency(t *testing.T) {
+	bc := &blockingBillingClient{testBillingClient: newTestBillingClient(), delay: 20 * time.Millisecond}
+	b := newUsageBatcher(bc, time.Hour, 3)
+
+	var keys []thread.PubKey
+	for i := 0; i < 12; i++ {
+		dev := newTestDev(t)
+		bc.setCustomer(newTestCustomer(dev.Key))
+		keys = append(keys, dev.Key)
+		b.add(dev.Key, map[string]int64{"network_egress": 100})
+		b.add(dev.Key, map[string]int64{"network_egress": 50, "instance_reads": 1})
+	}
+	b.flush(context.Background())
+
+	assert.LessOrEqual(t, atomic.LoadInt64(&bc.maxInFlight), int64(3))
+	assert.Greater(t, atomic.LoadInt64(&bc.maxInFlight), int64(1))
+	incs := bc.getIncs()
+	require.Len(t, incs, len(keys))
+	for _, inc := range incs {
+		assert.Equal(t, int64(150), inc.usage["network_egress"])
+		assert.Equal(t, int64(1), inc.usage["instance_reads"])
+	}
+	assert.Empty(t, b.pending)
+}
+
+func TestUsageBatcher_FailureIsolation(t *testing.T) {
+	failing := newTestDev(t)
+	bc := &blockingBillingClient{
+		testBillingClient: newTestBillingClient(),
+		fail:              map[string]bool{failing.Key.String(): true},
+	}
+	b := newUsageBatcher(bc, time.Hour, 2)
+
+	bc.setCustomer(newTestCustomer(failing.Key))
+	b.add(failing.Key, map[string]int64{"network_egress": 10})
+	var others []thread.PubKey
+	for i := 0; i < 4; i++ {
+		dev := newTestDev(t)
+		bc.setCustomer(newTestCustomer(dev.Key))
+		b.add(dev.Key, map[string]int64{"network_egress": 10})
+		others = append(others, dev.Key)
+	}
+	b.flush(context.Background())
+
+	incs := bc.getIncs()
+	require.Len(t, incs, len(others))
+	for _, inc := range incs {
+		assert.NotEqual(t, failing.Key.String(), inc.key)
+	}
+
+	// The failed owner is retried on the next flush under its original key,
+	// separately from any new usage.
+	require.Len(t, b.queues[failing.Key.String()], 1)
+	retryKey := b.queues[failing.Key.String()][0].idempotencyKey
+	assert.NotEmpty(t, retryKey)
+	b.add(failing.Key, map[string]int64{"network_egress": 5})
+	assert.Equal(t, int64(15), b.pendingFor(failing.Key)["network_egress"])
+	bc.setFail(failing.Key, false)
+	b.flush(context.Background())
+
+	incs = bc.getIncs()
+	require.Len(t, incs, len(others)+2)
+	sent := make(map[string]int64)
+	for _, inc := range incs[len(others):] {
+		assert.Equal(t, failing.Key.String(), inc.key)
+		sent[inc.idempotencyKey] = inc.usage["network_egress"]
+	}
+	assert.Equal(t, int64(10), sent[retryKey])
+	assert.Len(t, sent, 2)
+	assert.Empty(t, b.pending)
+	assert.Empty(t, b.queues)
+}
+
+func TestUsageBatcher_MaxAttempts(t *testing.T) {
+	failing := newTestDev(t)
+	bc := &blockingBillingClient{
+		testBillingClient: newTestBillingClient(),
+		fail:              map[string]bool{failing.Key.String(): true},
+	}
+	b := newUsageBatcher(bc, time.Hour, 1)
+	b.add(failing.Key, map[string]int64{"network_egress": 10})
+	for i := 0; i < maxUsageFlushAttempts; i++ {
+		b.flush(context.Background())
+	}
+	assert.Empty(t, b.pending)
+	assert.Empty(t, b.queues)
+}
+
+func TestUsageBatcher_OwnerOrdering(t *testing.T) {
+	bc := &blockingBillingClient{testBillingClient: newTestBillingClient(), delay: time.Millisecond}
+	b := newUsageBatcher(bc, time.Hour, 4)
+
+	var keys []thread.PubKey
+	for i := 0; i < 4; i++ {
+		dev := newTestDev(t)
+		bc.setCustomer(newTestCustomer(dev.Key))
+		keys = append(keys, dev.Key)
+	}
+
+	// Each delta is submitted under its own usage key so that the order it was applied in can be
+	// recovered from the increments, while several flushes run concurrently.
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for _, key := range keys {
+			b.add(key, map[string]int64{fmt.Sprintf("delta_%03d", i): 1})
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.flush(context.Background())
+		}()
+	}
+	wg.Wait()
+	b.flush(context.Background())
+
+	last := make(map[string]string)
+	applied := make(map[string]int)
+	for _, inc := range bc.getIncs() {
+		var keys []string
+		for k := range inc.usage {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		assert.Less(t, last[inc.key], keys[0], "deltas for %s were applied out of order", inc.key)
+		last[inc.key] = keys[len(keys)-1]
+		applied[inc.key] += len(keys)
+	}
+	for _, key := range keys {
+		assert.Equal(t, 50, applied[key.String()])
+	}
+	assert.Empty(t, b.queues)
+	assert.Empty(t, b.sending)
+}
+
+func TestUsageBatcher_RetryOrdering(t *testing.T) {
+	failing := newTestDev(t)
+	bc := &blockingBillingClient{
+		testBillingClient: newTestBillingClient(),
+		fail:              map[string]bool{failing.Key.String(): true},
+	}
+	bc.setCustomer(newTestCustomer(failing.Key))
+	b := newUsageBatcher(bc, time.Hour, 2)
+
+	// Newer usage waits behind usage that failed to send.
+	b.add(failing.Key, map[string]int64{"stored_data": 10})
+	b.flush(context.Background())
+	b.add(failing.Key, map[string]int64{"stored_data": -10})
+	b.flush(context.Background())
+	assert.Empty(t, bc.getIncs())
+	require.Len(t, b.queues[failing.Key.String()], 2)
+
+	bc.setFail(failing.Key, false)
+	b.flush(context.Background())
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, int64(10), incs[0].usage["stored_data"])
+	assert.Equal(t, int64(-10), incs[1].usage["stored_data"])
+	assert.Empty(t, b.queues)
+}
+
+// blockingBillingClient is a testBillingClient that delays and optionally fails usage increments.
+type blockingBillingClient struct {
+	*testBillingClient
+	delay time.Duration
+
+	failLk sync.Mutex
+	fail   map[string]bool
+
+	inFlight    int64
+	maxInFlight int64
+}
+
+func (c *blockingBillingClient) setFail(key thread.PubKey, fail bool) {
+	c.failLk.Lock()
+	defer c.failLk.Unlock()
+	c.fail[key.String()] = fail
+}
+
+func (c *blockingBillingClient) IncCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	n := atomic.AddInt64(&c.inFlight, 1)
+	defer atomic.AddInt64(&c.inFlight, -1)
+	for {
+		max := atomic.LoadInt64(&c.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt64(&c.maxInFlight, max, n) {
+			break
+		}
+	}
+	time.Sleep(c.delay)
+
+	c.failLk.Lock()
+	fail := c.fail[key.String()]
+	c.failLk.Unlock()
+	if fail {
+		return nil, errors.New("billing unavailable")
+	}
+	return c.testBillingClient.IncCustomerUsage(ctx, key, productUsage, opts...)
+}
+
+func TestUsageBatcher_CompactScopedDeltas(t *testing.T) {
+	bc := newTestBillingClient()
+	b := newUsageBatcher(bc, time.Hour, 1)
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Overwrite the same path several times.
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": 100})
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": -100})
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": 120})
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": -120})
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": 90})
+	p := b.pending[dev.Key.String()][""]
+	require.NotNil(t, p)
+	require.Len(t, p.scoped, 1)
+	assert.Equal(t, int64(90), p.scoped["bucket/file.txt"]["stored_data"])
+	assert.Equal(t, map[string]int64{"stored_data": 90}, b.pendingFor(dev.Key))
+
+	b.flush(context.Background())
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, map[string]int64{"stored_data": 90}, incs[0].usage)
+
+	// Updates that cancel out are not sent at all.
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": 50})
+	b.addScoped(dev.Key, "bucket/file.txt", map[string]int64{"stored_data": -50})
+	b.flush(context.Background())
+	assert.Len(t, bc.getIncs(), 1)
+	assert.Empty(t, b.pending)
+}
+
+func TestUsageBatcher_RetryIsIdempotent(t *testing.T) {
+	bc := newTestBillingClient()
+	b := newUsageBatcher(bc, time.Hour, 1)
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+	b.add(dev.Key, map[string]int64{"network_egress": 10})
+	b.flush(context.Background())
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+
+	// Billingd applied the usage, but the response was lost and the batch is retried.
+	b.queues[dev.Key.String()] = append(b.queues[dev.Key.String()], &pendingUsage{
+		key:            dev.Key,
+		usage:          map[string]int64{"network_egress": 10},
+		idempotencyKey: incs[0].idempotencyKey,
+	})
+	b.flush(context.Background())
+	assert.Len(t, bc.getIncs(), 1)
+	assert.Equal(t, int64(10), cus.DailyUsage["network_egress"].Total)
+}
+
+func TestUsageBatcher_BucketKeys(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, usage: newUsageBatcher(bc, time.Hour, 1)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	ctx := context.Background()
+
+	// Usage is batched per bucket so that it's still attributed to its bucket.
+	for _, r := range []struct {
+		bucket string
+		stored int64
+	}{{"one", 100}, {"two", 200}, {"one", 50}} {
+		err := tx.recordScopedUsage(ctx, dev.Key, r.bucket+"/file.txt", map[string]int64{"stored_data": r.stored},
+			billing.WithBucketKey(r.bucket))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tx.recordUsage(ctx, dev.Key, map[string]int64{"network_egress": 10}))
+	assert.Equal(t, int64(350), tx.usage.pendingFor(dev.Key)["stored_data"])
+	tx.usage.flush(ctx)
+
+	incs := bc.getIncs()
+	require.Len(t, incs, 3)
+	sent := make(map[string]map[string]int64)
+	for _, inc := range incs {
+		sent[inc.bucketKey] = inc.usage
+	}
+	assert.Equal(t, map[string]map[string]int64{
+		"one": {"stored_data": 150},
+		"two": {"stored_data": 200},
+		"":    {"network_egress": 10},
+	}, sent)
+}
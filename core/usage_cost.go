@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	apic "github.com/textileio/textile/v2/api/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UsagePrice is the price of a usage key, e.g., stored_data.
+type UsagePrice struct {
+	// Unit is the amount of usage that Price applies to, e.g., one GiB of stored_data.
+	Unit int64
+	// Price is the price in USD of a Unit of usage.
+	Price float64
+}
+
+// usageCost tallies the usage recorded for a request so that its cost can be estimated.
+type usageCost struct {
+	billable bool
+
+	lk    sync.Mutex
+	usage map[string]int64
+}
+
+func newUsageCostContext(ctx context.Context, billable bool) context.Context {
+	return context.WithValue(ctx, usageCtxKey("usageCost"), &usageCost{
+		billable: billable,
+		usage:    make(map[string]int64),
+	})
+}
+
+func usageCostFromContext(ctx context.Context) (*usageCost, bool) {
+	cost, ok := ctx.Value(usageCtxKey("usageCost")).(*usageCost)
+	return cost, ok
+}
+
+// add tallies usage recorded for the request. It's a no-op if c is nil.
+func (c *usageCost) add(usage map[string]int64) {
+	if c == nil {
+		return
+	}
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	for k, v := range usage {
+		c.usage[k] += v
+	}
+}
+
+// estimateCost returns the cost in USD of usage per the configured prices.
+// Usage that reduces an owner's total, e.g., removing stored data, doesn't reduce the cost.
+func (t *Textile) estimateCost(usage map[string]int64) float64 {
+	var cost float64
+	for k, v := range usage {
+		price, ok := t.conf.UsagePrices[k]
+		if !ok || price.Unit <= 0 || v <= 0 {
+			continue
+		}
+		cost += float64(v) / float64(price.Unit) * price.Price
+	}
+	return cost
+}
+
+// setCostTrailer attaches the estimated cost of the request's usage to the response trailer.
+// Free customers are not charged, so their estimate is zero.
+func (t *Textile) setCostTrailer(ctx context.Context) {
+	c, ok := usageCostFromContext(ctx)
+	if !ok {
+		return
+	}
+	var cost float64
+	if c.billable {
+		c.lk.Lock()
+		cost = t.estimateCost(c.usage)
+		c.lk.Unlock()
+	}
+	md := metadata.Pairs(apic.EstimatedCostKey, strconv.FormatFloat(cost, 'f', -1, 64))
+	if err := grpc.SetTrailer(ctx, md); err != nil {
+		log.Debugf("setting estimated cost trailer: %v", err)
+	}
+}
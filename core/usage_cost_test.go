@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apic "github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryServerInterceptor_EstimatedCost(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{
+		UsagePrices: map[string]UsagePrice{
+			"stored_data":    {Unit: gib, Price: 0.02},
+			"network_egress": {Unit: gib, Price: 0.1},
+		},
+	}}
+
+	push := func(delta int64, billable bool) (float64, bool) {
+		dev := newTestDev(t)
+		cus := newTestCustomer(dev.Key)
+		cus.Billable = billable
+		bc.setCustomer(cus)
+
+		ts := &testTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(newTestAccountContext(dev), ts)
+		intercept := unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pushPathMethod},
+			func(ctx context.Context, _ interface{}) (interface{}, error) {
+				owner, ok := buckets.BucketOwnerFromContext(ctx)
+				require.True(t, ok)
+				owner.StorageDelta = delta
+				return nil, nil
+			})
+		require.NoError(t, err)
+		return apic.EstimatedCostFromTrailer(ts.trailer)
+	}
+
+	// Billable customers see the cost from the price table.
+	cost, ok := push(2*gib, true)
+	require.True(t, ok)
+	assert.InDelta(t, 0.04, cost, 1e-9)
+
+	// Free customers see zero.
+	cost, ok = push(2*gib, false)
+	require.True(t, ok)
+	assert.Equal(t, float64(0), cost)
+
+	// Freeing storage doesn't have a negative cost.
+	cost, ok = push(-gib, true)
+	require.True(t, ok)
+	assert.Equal(t, float64(0), cost)
+}
+
+func TestUnaryServerInterceptor_EstimatedCostDisabled(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	ts := &testTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(newTestAccountContext(dev), ts)
+	intercept := unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)
+	_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pushPathMethod},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	require.NoError(t, err)
+	_, ok := apic.EstimatedCostFromTrailer(ts.trailer)
+	assert.False(t, ok)
+}
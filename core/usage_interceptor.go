@@ -6,14 +6,16 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	grpcm "github.com/grpc-ecosystem/go-grpc-middleware"
-	powc "github.com/textileio/powergate/v2/api/client"
+	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/textile/v2/api/billingd/analytics"
 	billing "github.com/textileio/textile/v2/api/billingd/client"
-	"github.com/textileio/textile/v2/api/billingd/common"
 	"github.com/textileio/textile/v2/api/billingd/pb"
+	apic "github.com/textileio/textile/v2/api/common"
 	"github.com/textileio/textile/v2/buckets"
 	mdb "github.com/textileio/textile/v2/mongodb"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -22,6 +24,42 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// billingClient is the subset of the billing API used by the usage interceptor.
+type billingClient interface {
+	CreateCustomer(
+		ctx context.Context,
+		key thread.PubKey,
+		email string,
+		username string,
+		accountType mdb.AccountType,
+		opts ...billing.Option,
+	) (string, error)
+	GetCustomer(ctx context.Context, key thread.PubKey) (*pb.GetCustomerResponse, error)
+	DeleteCustomer(ctx context.Context, key thread.PubKey) error
+	IncCustomerUsage(
+		ctx context.Context,
+		key thread.PubKey,
+		productUsage map[string]int64,
+		opts ...billing.UsageOption,
+	) (*pb.IncCustomerUsageResponse, error)
+	SetCustomerUsage(
+		ctx context.Context,
+		key thread.PubKey,
+		productUsage map[string]int64,
+	) (*pb.SetCustomerUsageResponse, error)
+	TrackEvent(
+		ctx context.Context,
+		key thread.PubKey,
+		accountType mdb.AccountType,
+		active bool,
+		event analytics.Event,
+		properties map[string]string,
+	) error
+	Close() error
+}
+
+var _ billingClient = (*billing.Client)(nil)
+
 type preFunc func(ctx context.Context, method string) (context.Context, error)
 type postFunc func(ctx context.Context, method string) error
 
@@ -33,11 +71,14 @@ func unaryServerInterceptor(pre preFunc, post postFunc) grpc.UnaryServerIntercep
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 		newCtx, err := pre(ctx, info.FullMethod)
+		// A pending bucket is counted until its Create request completes.
+		defer releaseBucketReservation(newCtx)
 		if err != nil {
 			return nil, err
 		}
 		res, err := handler(newCtx, req)
 		if err != nil {
+			finishDecision(newCtx, err)
 			return nil, err
 		}
 		if err = post(newCtx, info.FullMethod); err != nil {
@@ -54,158 +95,401 @@ func streamServerInterceptor(pre preFunc, post postFunc) grpc.StreamServerInterc
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		newCtx, err := pre(stream.Context(), info.FullMethod)
+		newCtx, err := pre(newStreamRequestContext(stream.Context()), info.FullMethod)
 		if err != nil {
 			return err
 		}
+		var egress *streamEgress
+		if isEgressStreamMethod(info.FullMethod) {
+			source := buckets.NewReadSource(egressPrecounterFromContext(newCtx), egressAdmissionFromContext(newCtx))
+			egress = &streamEgress{source: source}
+			newCtx = context.WithValue(newCtx, usageCtxKey("streamEgress"), egress)
+			newCtx = buckets.NewReadSourceContext(newCtx, egress.source)
+		}
 		wrapped := grpcm.WrapServerStream(stream)
 		wrapped.WrappedContext = newCtx
-		err = handler(srv, wrapped)
+		revalidation, _ := streamRevalidationFromContext(newCtx)
+		stopRevalidation := func() error { return nil }
+		if revalidation != nil {
+			// The handler sees a stream context that's canceled if the owner becomes ineligible.
+			// Usage is still posted with newCtx.
+			ctx, cancel := context.WithCancel(newCtx)
+			defer cancel()
+			wrapped.WrappedContext = ctx
+			revalidation.start(ctx, cancel)
+			stopRevalidation = func() error {
+				cancel()
+				return revalidation.stop()
+			}
+		}
+		var ss grpc.ServerStream = wrapped
+		meter, _ := listenMeterFromContext(newCtx)
+		if meter != nil && meter.mode == listenMeteringMessage {
+			ss = &listenServerStream{ServerStream: wrapped, meter: meter}
+		}
+		if egress != nil {
+			allowance, _ := egressAllowanceFromContext(newCtx)
+			ss = &meteredServerStream{ServerStream: wrapped, egress: egress, allowance: allowance}
+			if allowance != nil {
+				// Bytes are billed by post, after which they no longer need to be held in flight.
+				defer func() {
+					allowance.tracker.release(allowance.owner, atomic.LoadInt64(&egress.bytes))
+				}()
+			}
+		}
+		err = handler(srv, ss)
+		if rerr := stopRevalidation(); rerr != nil {
+			err = rerr
+		}
 		if err != nil {
+			finishDecision(newCtx, err)
+			if egress != nil || meter != nil {
+				// Bill the usage incurred before the failure, e.g., on client disconnect.
+				if err := post(newCtx, info.FullMethod); err != nil {
+					log.Errorf("stream interceptor: post usage: %v", err)
+				}
+			}
 			return err
 		}
 		return post(newCtx, info.FullMethod)
 	}
 }
 
-func (t *Textile) preUsageFunc(ctx context.Context, method string) (context.Context, error) {
-	if t.bc == nil {
-		return ctx, nil
+// msgHeaderLen is the length of the gRPC length-prefixed message header.
+const msgHeaderLen = 5
+
+type usageCtxKey string
+
+// streamEgress tallies the bytes sent over a stream.
+type streamEgress struct {
+	bytes  int64
+	source *buckets.ReadSource
+}
+
+func streamEgressFromContext(ctx context.Context) (*streamEgress, bool) {
+	egress, ok := ctx.Value(usageCtxKey("streamEgress")).(*streamEgress)
+	return egress, ok
+}
+
+// meteredServerStream wraps a server stream, measuring the serialized size of
+// each message that is successfully sent. If an allowance is set, messages that would
+// push the owner's in-flight egress past it are not sent, and sends are throttled as
+// the allowance runs out if the allowance has a throttle.
+type meteredServerStream struct {
+	grpc.ServerStream
+	egress    *streamEgress
+	allowance *egressAllowance
+}
+
+func (s *meteredServerStream) SendMsg(m interface{}) error {
+	var size int64
+	if msg, ok := m.(proto.Message); ok {
+		size = int64(proto.Size(msg) + msgHeaderLen)
 	}
-	for _, ignored := range authIgnoredMethods {
-		if method == ignored {
-			return ctx, nil
+	if s.allowance != nil && s.allowance.throttle != nil {
+		remaining := s.allowance.limit - s.allowance.tracker.get(s.allowance.owner)
+		if err := s.allowance.throttle.wait(s.Context(), size, remaining); err != nil {
+			return err
 		}
 	}
-	for _, ignored := range usageIgnoredMethods {
-		if method == ignored {
-			return ctx, nil
+	if s.allowance != nil && !s.allowance.tracker.reserve(s.allowance.owner, size, s.allowance.limit) {
+		return s.allowance.exceeded()
+	}
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		if s.allowance != nil {
+			s.allowance.tracker.release(s.allowance.owner, size)
 		}
+		return err
+	}
+	atomic.AddInt64(&s.egress.bytes, size)
+	return nil
+}
+
+func (t *Textile) preUsageFunc(ctx context.Context, method string) (context.Context, error) {
+	start := t.now()
+	ctx, err := t.evaluateAccess(ctx, method)
+	if err != nil {
+		t.exportDecision(ctx, method, start, err)
+		return ctx, err
+	}
+	ctx = t.withAccountRevalidation(ctx, method)
+	return t.exportDecision(ctx, method, start, nil), nil
+}
+
+// evaluateAccess decides whether or not a request to method is allowed based on the
+// owner's billing status and usage. The returned context carries request-scoped usage
+// info, e.g., the bucket owner's available storage. In a dry-run context, the decision
+// is made without side effects, such as collecting new users and customers.
+func (t *Textile) evaluateAccess(ctx context.Context, method string) (context.Context, error) {
+	if t.bc == nil {
+		return ctx, nil
+	}
+	if t.methodExemption(method) != exemptNone {
+		return ctx, nil
 	}
 	account, ok := mdb.AccountFromContext(ctx)
+	if err := t.checkUnknownMethod(ctx, method, account); err != nil {
+		return ctx, err
+	}
 	if !ok {
+		return t.evaluateAnonymousAccess(ctx, method), nil
+	}
+	// Support sessions are treated as billable and unlimited.
+	if actor, ok := t.supportActor(ctx, account); ok {
+		if !isDryRun(ctx) {
+			t.auditSupportSession(ctx, method, account, actor)
+		}
+		return newSupportSessionContext(ctx, actor), nil
+	}
+	if account.Owner() != nil && t.isOwnerIgnoredMethod(method, t.ownerType(ctx, account)) {
 		return ctx, nil
 	}
-	now := time.Now()
+	setSpanOwner(ctx, t.ownerLabel(t.ownerKey(ctx, account)))
+	now := t.now()
+	if err := t.checkMaintenance(ctx, method, account, now); err != nil {
+		return ctx, err
+	}
+	if err := t.checkUsageBacklog(ctx, method, account); err != nil {
+		return ctx, err
+	}
+	dryRun := isDryRun(ctx)
+	if !dryRun {
+		ctx = newRequestIDContext(ctx)
+	}
 
 	// Collect new users.
-	if account.User != nil && account.User.CreatedAt.IsZero() && account.User.Type == mdb.User {
-		var powInfo *mdb.PowInfo
-		if t.pc != nil {
-			ctxAdmin := context.WithValue(ctx, powc.AdminKey, t.conf.PowergateAdminToken)
-			res, err := t.pc.Admin.Users.Create(ctxAdmin)
-			if err != nil {
-				return ctx, err
-			}
-			powInfo = &mdb.PowInfo{ID: res.User.Id, Token: res.User.Token}
-		}
-		user, err := t.collections.Accounts.CreateUser(ctx, account.User.Key, powInfo)
+	if !dryRun && account.User != nil && account.User.CreatedAt.IsZero() && account.User.Type == mdb.User {
+		user, err := t.collectUser(ctx, account.User.Key)
 		if err != nil {
 			return ctx, err
 		}
+		if err := t.provisionDefaultBucket(ctx, user); err != nil {
+			return ctx, err
+		}
 		ctx = mdb.NewAccountContext(ctx, user, account.Org)
 		account, _ = mdb.AccountFromContext(ctx)
 	}
 
 	// Collect new customers.
-	cus, err := t.bc.GetCustomer(ctx, account.Owner().Key)
+	ownerKey := t.ownerKey(ctx, account)
+	if t.headroom != nil {
+		t.headroom.touch(ownerKey)
+	}
+	cacheable := t.isDecisionCacheable(method, dryRun, now)
+	if cacheable {
+		if d, ok := t.decisions.get(ownerKey.String(), method, now); ok {
+			return t.reuseDecision(ctx, method, account, d, now)
+		}
+	}
+	cus, err := t.bc.GetCustomer(ctx, ownerKey)
 	if err != nil {
 		if strings.Contains(err.Error(), mongo.ErrNoDocuments.Error()) {
-			email, err := t.getAccountCtxEmail(ctx, account)
-			if err != nil {
-				return ctx, err
-			}
-			var opts []billing.Option
-			if account.Owner().Type == mdb.User {
-				key, ok := mdb.APIKeyFromContext(ctx)
-				if !ok {
-					return ctx, status.Error(codes.PermissionDenied, "Bad API key")
-				}
-				parent, err := t.collections.Accounts.Get(ctx, key.Owner)
-				if err != nil {
-					return nil, fmt.Errorf("parent for %s not found: %s", account.Owner().Key, key.Owner)
+			if dryRun {
+				// New customers start with a full allowance.
+				if t.ownerType(ctx, account) == mdb.User {
+					if _, ok := t.userParentKey(ctx); !ok {
+						return ctx, t.deny(ctx, method, account, denialPermission,
+							status.Error(codes.PermissionDenied, "Bad API key"))
+					}
 				}
-				email, err := t.getAccountCtxEmail(ctx, mdb.AccountCtxForAccount(parent))
-				if err != nil {
-					return ctx, err
-				}
-				opts = append(opts, billing.WithParent(parent.Key, email, parent.Type))
-			}
-			if _, err := t.bc.CreateCustomer(
-				ctx,
-				account.Owner().Key,
-				email,
-				account.Owner().Username,
-				account.Owner().Type,
-				opts...,
-			); err != nil {
-				return ctx, err
+				return ctx, nil
 			}
-			cus, err = t.bc.GetCustomer(ctx, account.Owner().Key)
-			if err != nil {
+			if cus, err = t.newCustomer(ctx, method, account, ownerKey); err != nil {
 				return ctx, err
 			}
 		} else {
 			return ctx, err
 		}
 	}
-	if err := common.StatusCheck(cus.SubscriptionStatus); err != nil {
-		return ctx, status.Error(codes.FailedPrecondition, err.Error())
+	if err := t.checkSubscription(ownerKey, method, cus, now); err != nil {
+		return ctx, t.cacheDecision(cacheable, ownerKey, method, cus, t.deny(ctx, method, account,
+			denialSuspension, status.Error(codes.FailedPrecondition, err.Error())), now)
+	}
+	if err := t.checkLoad(ctx, method, account, cus, now); err != nil {
+		return ctx, err
+	}
+	// Promoted methods are free, so quotas don't apply.
+	if t.isPromoted(method, now) {
+		return newPromotionContext(ctx), nil
 	}
+	cus = t.applyPendingUsage(ownerKey, cus)
+	cus = t.applyResellerMultiplier(cus)
+	cus = t.applyTrialPolicy(cus)
+	// New accounts can explore before quotas are enforced.
+	exempt := t.isNewAccount(account.Owner(), now)
 
-	if usageExhausted(cus, "network_egress", now) {
-		err = fmt.Errorf("network egress exhausted: %v", common.ErrExceedsFreeQuota)
-		return ctx, status.Error(codes.ResourceExhausted, err.Error())
+	// All exhausted quotas are evaluated so the one reported follows the configured priority.
+	policy, hasPolicy := t.methodPolicy(method)
+	var egressGrace bool
+	for _, e := range t.quotaExhaustions(method, ownerKey, cus, policy, exempt, now) {
+		if e.key == "network_egress" && t.warnEgressLimit(ctx, ownerKey, cus, dryRun) {
+			egressGrace = true
+			continue
+		}
+		err = t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, e.err.Error()))
+		if e.cacheable {
+			err = t.cacheDecision(cacheable, ownerKey, method, cus, err, now)
+		}
+		return ctx, err
+	}
+	if !exempt {
+		if err := t.checkEgressTier(ctx, method, account, cus); err != nil {
+			return ctx, err
+		}
+	}
+	if t.inflight != nil && !exempt && isEgressStreamMethod(method) {
+		deny := func(err error) error {
+			return t.deny(ctx, method, account, denialQuota, err)
+		}
+		if egressGrace {
+			// Warned requests are limited to the grace allowance.
+			ctx = newEgressAllowanceContext(ctx, &egressAllowance{
+				owner:    ownerKey.String(),
+				limit:    t.conf.EgressWarningGrace,
+				tracker:  t.inflight,
+				throttle: t.throttle,
+				deny:     deny,
+			})
+		} else if limit, ok := t.egressLimit(cus, now); ok {
+			key := ownerKey.String()
+			if t.inflight.get(key) >= limit {
+				return ctx, t.deny(ctx, method, account, denialQuota, errEgressInFlight)
+			}
+			ctx = newEgressAllowanceContext(ctx, &egressAllowance{
+				owner:    key,
+				limit:    limit,
+				tracker:  t.inflight,
+				throttle: t.throttle,
+				deny:     deny,
+			})
+		}
+	}
+	if t.egressBursts != nil && !exempt && !dryRun && isEgressStreamMethod(method) {
+		t.syncEgressBurstDay(ownerKey, cus)
+		ctx = newEgressAdmissionContext(ctx, t.newEgressAdmission(ctx, method, account, ownerKey))
+	}
+	if t.conf.EgressPrecount && !dryRun && isEgressStreamMethod(method) {
+		ctx = newEgressPrecounterContext(ctx, t.newEgressPrecounter(ctx, method, ownerKey))
+	}
+
+	if len(t.conf.UsagePrices) > 0 && !dryRun {
+		ctx = newUsageCostContext(ctx, cus.Billable)
 	}
 
 	// @todo: Attach egress info that can be used to fail-fast in PullPath?
-	switch method {
-	case "/api.bucketsd.pb.APIService/Create",
-		"/api.bucketsd.pb.APIService/PushPath",
-		"/api.bucketsd.pb.APIService/PushPaths",
-		"/api.bucketsd.pb.APIService/SetPath",
-		"/api.bucketsd.pb.APIService/Remove",
-		"/api.bucketsd.pb.APIService/RemovePath",
-		"/api.bucketsd.pb.APIService/PushPathAccessRoles":
+	if !hasPolicy {
+		return ctx, nil
+	}
+	if policy.BucketLimit {
+		if ctx, err = t.checkBucketLimit(ctx, method, account, cus.Billable); err != nil {
+			return ctx, err
+		}
+	}
+	if policy.Storage {
 		owner := &buckets.BucketOwner{
 			StorageUsed: cus.DailyUsage["stored_data"].Total,
 		}
 		if cus.Billable {
 			owner.StorageAvailable = int64(math.MaxInt64)
+			if limit, ok := t.conf.BillableStorageCaps[ownerKey.String()]; ok {
+				owner.StorageAvailable = limit - owner.StorageUsed
+				if owner.StorageAvailable < 0 {
+					owner.StorageAvailable = 0
+				}
+			}
+		} else if exempt || t.freeUnlimited(cus, "stored_data") {
+			owner.StorageAvailable = int64(math.MaxInt64)
 		} else if now.Unix() < cus.GracePeriodEnd {
 			owner.StorageAvailable = cus.DailyUsage["stored_data"].Grace
 		} else {
 			owner.StorageAvailable = cus.DailyUsage["stored_data"].Free
 		}
+		// Reservations are checked against the full allowance when they're made.
+		if !policy.ReservesStorage {
+			owner.StorageAvailable = t.applyStorageReservations(ctx, ownerKey, owner.StorageAvailable)
+		}
+		owner.StorageAvailable = t.applyPendingUploads(ownerKey, owner.StorageAvailable, now)
 		ctx = buckets.NewBucketOwnerContext(ctx, owner)
-	case
-		"/threads.pb.API/Verify",
-		"/threads.pb.API/Has",
-		"/threads.pb.API/Find",
-		"/threads.pb.API/FindByID",
-		"/threads.pb.API/ReadTransaction",
-		"/threads.pb.API/Listen":
-		if usageExhausted(cus, "instance_reads", now) {
-			err = fmt.Errorf("threaddb reads exhausted: %v", common.ErrExceedsFreeQuota)
-			return ctx, status.Error(codes.ResourceExhausted, err.Error())
-		}
-	case "/threads.pb.API/Create",
-		"/threads.pb.API/Save",
-		"/threads.pb.API/Delete",
-		"/threads.pb.API/WriteTransaction":
-		if usageExhausted(cus, "instance_writes", now) {
-			err = fmt.Errorf("threaddb writes exhausted: %v", common.ErrExceedsFreeQuota)
-			return ctx, status.Error(codes.ResourceExhausted, err.Error())
+		if t.softCaps != nil && !cus.Billable && !dryRun {
+			ctx = newStorageSoftCapContext(ctx)
+		}
+	}
+	if policy.Quota != "" {
+		// Burst limits are checked for each request, so only the outcome of the quota check is cached.
+		t.cacheDecision(cacheable, ownerKey, method, cus, nil, now)
+		if policy.Burst {
+			t.syncBurstDay(ownerKey, cus, policy.Quota)
+			if err := t.checkBurst(ctx, method, account, policy.Quota, now); err != nil {
+				return ctx, err
+			}
+		}
+		if err := t.checkReadWriteRatio(ctx, method, account, policy.Quota, cus.Billable, now); err != nil {
+			return ctx, err
+		}
+	}
+	if policy.ListenMeter {
+		// Reads consumed by the stream are recorded by post according to the metering mode.
+		ctx = newListenMeterContext(ctx, t.newListenMeter(now))
+		if t.conf.ListenRevalidateInterval > 0 && !dryRun {
+			ctx = withStreamRevalidation(ctx, t.conf.ListenRevalidateInterval, func(ctx context.Context) error {
+				return t.revalidateListen(ctx, method, account)
+			})
 		}
 	}
 	return ctx, nil
 }
 
-func usageExhausted(cus *pb.GetCustomerResponse, key string, now time.Time) bool {
-	if !cus.Billable && cus.DailyUsage[key].Free == 0 {
+// applyStorageReservations removes storage reserved by an owner's other requests from available.
+// A reservation token in the request metadata is consumed, making its storage available to the request.
+func (t *Textile) applyStorageReservations(ctx context.Context, key thread.PubKey, available int64) int64 {
+	if t.reservations == nil {
+		return available
+	}
+	if token, ok := apic.StorageReservationFromMD(ctx); ok && !isDryRun(ctx) {
+		if _, ok := t.reservations.Consume(key.String(), token); !ok {
+			log.Warnf("storage reservation for %s was not found or has expired", key)
+		}
+	}
+	available -= t.reservations.Reserved(key.String())
+	if available < 0 {
+		available = 0
+	}
+	return available
+}
+
+// checkBurst returns an error if the owner has exceeded the burst limit for a usage key.
+func (t *Textile) checkBurst(
+	ctx context.Context,
+	method string,
+	account *mdb.AccountCtx,
+	key string,
+	now time.Time,
+) error {
+	if t.bursts == nil {
+		return nil
+	}
+	allow := t.bursts.allow
+	if isDryRun(ctx) {
+		allow = t.bursts.peek
+	}
+	if ok, reset := allow(t.ownerKey(ctx, account).String(), key, now); !ok {
+		err := fmt.Errorf("%s burst limit exceeded, window resets at %s", key, reset.UTC().Format(time.RFC3339))
+		return t.deny(ctx, method, account, denialQuota, status.Error(codes.ResourceExhausted, err.Error()))
+	}
+	return nil
+}
+
+// usageExhausted returns whether or not a non-billable customer has used up their allowance of key.
+// Keys the customer has no usage for aren't metered, e.g., a product added after their subscription was created.
+func (t *Textile) usageExhausted(cus *pb.GetCustomerResponse, key string, now time.Time) bool {
+	u, ok := cus.DailyUsage[key]
+	if !ok || u == nil || t.freeUnlimited(cus, key) {
+		return false
+	}
+	if !cus.Billable && u.GetFree() == 0 {
 		if now.Unix() >= cus.GracePeriodEnd {
 			return true // Grace period ended
-		} else if cus.DailyUsage[key].Grace == 0 {
+		} else if u.GetGrace() == 0 {
 			return true // Still in grace period, but reached the hard cap
 		}
 	}
@@ -216,36 +500,89 @@ func (t *Textile) postUsageFunc(ctx context.Context, method string) error {
 	if t.bc == nil {
 		return nil
 	}
-	for _, ignored := range authIgnoredMethods {
-		if method == ignored {
-			return nil
-		}
+	if t.methodExemption(method) != exemptNone {
+		return nil
+	}
+	if record, ok := decisionRecordFromContext(ctx); ok {
+		defer record.finish(nil)
+	}
+	if isPromotedRequest(ctx) || isSupportSessionRequest(ctx) {
+		return nil
 	}
 	account, ok := mdb.AccountFromContext(ctx)
 	if !ok {
-		return nil
+		return t.postAnonymousUsage(ctx, method)
+	}
+	ownerKey := t.ownerKey(ctx, account)
+	cost, _ := usageCostFromContext(ctx)
+	record, _ := decisionRecordFromContext(ctx)
+	defer t.setCostTrailer(ctx)
+	metadata := t.ownerMetadataOptions(account.Owner())
+	if egress, ok := streamEgressFromContext(ctx); ok {
+		// Precounted egress was billed when it was reported, so only the difference from the bytes
+		// that were sent is billed, which is negative if the read failed or was aborted.
+		sent := t.billableEgress(egress, atomic.LoadInt64(&egress.bytes))
+		if adjustment := sent - egress.source.Precounted(); adjustment != 0 {
+			opts := append(usageKeyOptions(ctx, method, ownerKey, "network_egress"), metadata...)
+			usage := map[string]int64{
+				"network_egress": adjustment,
+			}
+			// The request context may already be canceled if the client disconnected.
+			ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+			defer cancel()
+			if err := t.recordUsage(ctx, ownerKey, usage, opts...); err != nil {
+				return err
+			}
+			cost.add(usage)
+			record.add(usage)
+		}
+	}
+	if meter, ok := listenMeterFromContext(ctx); ok {
+		if reads := meter.reads(t.now()); reads > 0 {
+			opts := append(usageKeyOptions(ctx, method, ownerKey, "instance_reads"), metadata...)
+			usage := map[string]int64{
+				"instance_reads": reads,
+			}
+			// Listen streams usually end when the client cancels the request context.
+			ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+			defer cancel()
+			if err := t.recordUsage(ctx, ownerKey, usage, opts...); err != nil {
+				return err
+			}
+			cost.add(usage)
+			record.add(usage)
+		}
 	}
 	owner, ok := buckets.BucketOwnerFromContext(ctx)
 	if !ok {
-		return nil
+		return t.verifyStorageOwner(ctx, method, account)
 	}
-	switch method {
-	case "/api.bucketsd.pb.APIService/Create",
-		"/api.bucketsd.pb.APIService/PushPath",
-		"/api.bucketsd.pb.APIService/PushPaths",
-		"/api.bucketsd.pb.APIService/SetPath",
-		"/api.bucketsd.pb.APIService/Remove",
-		"/api.bucketsd.pb.APIService/RemovePath",
-		"/api.bucketsd.pb.APIService/PushPathAccessRoles":
-		if _, err := t.bc.IncCustomerUsage(
-			ctx,
-			account.Owner().Key,
-			map[string]int64{
-				"stored_data": owner.StorageDelta,
-			},
-		); err != nil {
+	// Storage in excluded buckets and requests without an effective change are not billed to the owner.
+	if isStorageDeltaMethod(method) && !owner.StorageExcluded && !owner.Unchanged {
+		if err := t.verifyStorageDelta(method, ownerKey, owner.StorageDelta); err != nil {
+			return err
+		}
+		// Chunks of a deferred upload are reported together when the upload is finalized.
+		usage := make(map[string]int64)
+		if stored, report := t.deferUploadDelta(ctx, method, ownerKey, owner.StorageDelta); report {
+			usage["stored_data"] = stored
+		}
+		if owner.ObjectDelta != 0 {
+			usage["object_count"] = owner.ObjectDelta
+		}
+		if len(usage) == 0 {
+			return nil
+		}
+		opts := append(usageKeyOptions(ctx, method, ownerKey, "stored_data"), metadata...)
+		if owner.BucketKey != "" {
+			opts = append(opts, billing.WithBucketKey(owner.BucketKey))
+		}
+		if err := t.recordScopedUsage(ctx, ownerKey, owner.StorageScope, usage, opts...); err != nil {
 			return err
 		}
+		cost.add(usage)
+		record.add(usage)
+		t.notifyStorageSoftCap(ctx, account, ownerKey, owner)
 	}
 
 	if t.bc != nil {
@@ -258,7 +595,7 @@ func (t *Textile) postUsageFunc(ctx context.Context, method string) error {
 		case "/threads.pb.API/NewDB":
 			tp = analytics.ThreadDbCreated
 		}
-		t.bc.TrackEvent(ctx, account.Owner().Key, account.Owner().Type, true, tp, map[string]string{
+		t.bc.TrackEvent(ctx, ownerKey, t.ownerType(ctx, account), true, tp, map[string]string{
 			"member":          account.User.Key.String(),
 			"member_username": account.User.Username,
 			"member_email":    account.User.Email,
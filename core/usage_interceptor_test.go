@@ -0,0 +1,528 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/analytics"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	createMethod     = "/api.bucketsd.pb.APIService/Create"
+	pullPathMethod   = "/api.bucketsd.pb.APIService/PullPath"
+	pushPathMethod   = "/api.bucketsd.pb.APIService/PushPath"
+	pushPathsMethod  = "/api.bucketsd.pb.APIService/PushPaths"
+	removeMethod     = "/api.bucketsd.pb.APIService/Remove"
+	removePathMethod = "/api.bucketsd.pb.APIService/RemovePath"
+	setPathMethod    = "/api.bucketsd.pb.APIService/SetPath"
+	findMethod       = "/threads.pb.API/Find"
+
+	reserveStorageMethod = "/api.bucketsd.pb.APIService/ReserveStorage"
+
+	mib = 1024 * 1024
+	gib = 1024 * mib
+)
+
+func TestStreamServerInterceptor_PartialEgress(t *testing.T) {
+	errDisconnected := errors.New("client disconnected")
+	stream := &testServerStream{ctx: context.Background(), failAfter: 2, failErr: errDisconnected}
+
+	var billed int64
+	var posted bool
+	pre := func(ctx context.Context, _ string) (context.Context, error) {
+		return ctx, nil
+	}
+	post := func(ctx context.Context, _ string) error {
+		posted = true
+		egress, ok := streamEgressFromContext(ctx)
+		require.True(t, ok)
+		billed = egress.bytes
+		return nil
+	}
+
+	chunk := &bpb.PullPathResponse{Chunk: make([]byte, 1024)}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		for i := 0; i < 5; i++ {
+			if err := ss.SendMsg(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(pre, post)(nil, stream, info, handler)
+	require.True(t, errors.Is(err, errDisconnected))
+	assert.True(t, posted)
+	assert.Equal(t, int64(2*(proto.Size(chunk)+msgHeaderLen)), billed)
+}
+
+func TestStreamServerInterceptor_Egress(t *testing.T) {
+	stream := &testServerStream{ctx: context.Background()}
+
+	var billed int64
+	pre := func(ctx context.Context, _ string) (context.Context, error) {
+		return ctx, nil
+	}
+	post := func(ctx context.Context, _ string) error {
+		egress, ok := streamEgressFromContext(ctx)
+		require.True(t, ok)
+		billed = egress.bytes
+		return nil
+	}
+
+	small := &bpb.PullPathResponse{Chunk: make([]byte, 10)}
+	large := &bpb.PullPathResponse{Chunk: make([]byte, 1<<16)}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		if err := ss.SendMsg(small); err != nil {
+			return err
+		}
+		return ss.SendMsg(large)
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: pullPathMethod, IsServerStream: true}
+	err := streamServerInterceptor(pre, post)(nil, stream, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, int64(proto.Size(small)+proto.Size(large)+2*msgHeaderLen), billed)
+}
+
+func TestStreamServerInterceptor_NotMetered(t *testing.T) {
+	stream := &testServerStream{ctx: context.Background(), failAfter: 1, failErr: errors.New("boom")}
+
+	var posted bool
+	pre := func(ctx context.Context, _ string) (context.Context, error) {
+		return ctx, nil
+	}
+	post := func(ctx context.Context, _ string) error {
+		posted = true
+		return nil
+	}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		if err := ss.SendMsg(&bpb.PullPathResponse{}); err != nil {
+			return err
+		}
+		return ss.SendMsg(&bpb.PullPathResponse{})
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/api.bucketsd.pb.APIService/PushPath"}
+	err := streamServerInterceptor(pre, post)(nil, stream, info, handler)
+	require.Error(t, err)
+	assert.False(t, posted)
+}
+
+// testServerStream is a grpc.ServerStream that fails sends after failAfter
+// messages when failAfter is greater than zero.
+type testServerStream struct {
+	ctx       context.Context
+	sent      int
+	failAfter int
+	failErr   error
+}
+
+func (s *testServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *testServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *testServerStream) SetTrailer(metadata.MD)       {}
+func (s *testServerStream) Context() context.Context     { return s.ctx }
+func (s *testServerStream) RecvMsg(interface{}) error    { return nil }
+
+func (s *testServerStream) SendMsg(interface{}) error {
+	if s.failAfter > 0 && s.sent >= s.failAfter {
+		return s.failErr
+	}
+	s.sent++
+	return nil
+}
+
+func TestPreUsageFunc_BillableStorageCap(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	uncapped := newTestDev(t)
+	cus := newTestCustomer(uncapped.Key)
+	cus.Billable = true
+	cus.DailyUsage["stored_data"].Total = 100 * gib
+	bc.setCustomer(cus)
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(uncapped), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(math.MaxInt64), owner.StorageAvailable)
+
+	capped := newTestDev(t)
+	cus = newTestCustomer(capped.Key)
+	cus.Billable = true
+	cus.DailyUsage["stored_data"].Total = 8 * gib
+	bc.setCustomer(cus)
+	tx.conf.BillableStorageCaps = map[string]int64{capped.Key.String(): 10 * gib}
+
+	ctx, err = tx.preUsageFunc(newTestAccountContext(capped), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(2*gib), owner.StorageAvailable)
+
+	cus.DailyUsage["stored_data"].Total = 10 * gib
+	_, err = tx.preUsageFunc(newTestAccountContext(capped), pushPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Removals are still allowed so the owner can get back under the cap.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(capped), removePathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(0), owner.StorageAvailable)
+}
+
+func TestPreUsageFunc_PendingUsage(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, usage: newUsageBatcher(bc, time.Hour, 1)}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_reads"].Total = 49990
+	cus.DailyUsage["instance_reads"].Free = 10
+	bc.setCustomer(cus)
+
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.NoError(t, err)
+
+	// Billed usage is under the free quota, but pending usage puts it over.
+	tx.usage.add(dev.Key, map[string]int64{"instance_reads": 20})
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, int64(10), cus.DailyUsage["instance_reads"].Free)
+
+	// Enforcement is unchanged once pending usage is flushed.
+	tx.usage.flush(context.Background())
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, int64(50010), cus.DailyUsage["instance_reads"].Total)
+}
+
+func TestPreUsageFunc_PendingStorage(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, usage: newUsageBatcher(bc, time.Hour, 1)}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["stored_data"].Total = gib
+	bc.setCustomer(cus)
+	tx.usage.add(dev.Key, map[string]int64{"stored_data": gib})
+
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(2*gib), owner.StorageUsed)
+}
+
+func TestPreUsageFunc_StorageReservation(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, reservations: buckets.NewStorageReservations(time.Hour)}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["stored_data"].Free = 3 * gib
+	bc.setCustomer(cus)
+
+	// Reservations are checked against the full allowance.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), reserveStorageMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(3*gib), owner.StorageAvailable)
+	res, ok := tx.reservations.Reserve(dev.Key.String(), 2*gib, owner.StorageAvailable)
+	require.True(t, ok)
+
+	// Other requests can't use reserved storage.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(gib), owner.StorageAvailable)
+
+	// The upload with the reservation token gets the full allowance and consumes the reservation.
+	md := metadata.Pairs("x-textile-storage-reservation", res.Token)
+	ctx = metadata.NewIncomingContext(newTestAccountContext(dev), md)
+	ctx, err = tx.preUsageFunc(ctx, pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(3*gib), owner.StorageAvailable)
+	assert.Equal(t, int64(0), tx.reservations.Reserved(dev.Key.String()))
+}
+
+func TestPostUsageFunc_ExcludedBucket(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// A write to a normal bucket is billed.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+
+	// A write to an excluded bucket is not.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageExcluded = true
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	assert.Len(t, bc.getIncs(), 1)
+}
+
+func TestPostUsageFunc_CompactPathOverwrites(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, usage: newUsageBatcher(bc, time.Hour, 1)}
+
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Each overwrite of the path unpins the old file and pins the new one.
+	for _, delta := range []int64{mib, mib / 2, -mib / 4, mib / 4} {
+		ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+		require.NoError(t, err)
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageScope = "bucket/file.txt"
+		owner.StorageDelta = delta
+		require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	}
+	assert.Empty(t, bc.getIncs())
+
+	tx.usage.flush(context.Background())
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib+mib/2), incs[0].usage["stored_data"])
+}
+
+func newTestDev(t *testing.T) *mdb.Account {
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	return &mdb.Account{
+		Type:      mdb.Dev,
+		Key:       thread.NewLibp2pPubKey(pk),
+		Username:  "dev",
+		Email:     "dev@textile.io",
+		CreatedAt: time.Now(),
+	}
+}
+
+func newTestAccountContext(account *mdb.Account) context.Context {
+	return mdb.NewAccountContext(context.Background(), account, nil)
+}
+
+// newTestCustomer returns an active free-tier customer with no usage.
+func newTestCustomer(key thread.PubKey) *pb.GetCustomerResponse {
+	return &pb.GetCustomerResponse{
+		Key:                key.String(),
+		SubscriptionStatus: "active",
+		DailyUsage: map[string]*pb.Usage{
+			"stored_data":     {Free: 5 * gib, Grace: 1000 * gib},
+			"network_egress":  {Free: 10 * gib, Grace: 1000 * gib},
+			"instance_reads":  {Free: 50000, Grace: 1000000},
+			"instance_writes": {Free: 20000, Grace: 1000000},
+		},
+	}
+}
+
+type testUsageInc struct {
+	key   string
+	usage map[string]int64
+
+	idempotencyKey string
+	labels         map[string]string
+	bucketKey      string
+}
+
+// testBillingClient is an in-memory billingClient.
+type testBillingClient struct {
+	lk        sync.Mutex
+	customers map[string]*pb.GetCustomerResponse
+	incs      []testUsageInc
+	usageKeys map[string]struct{}
+}
+
+var _ billingClient = (*testBillingClient)(nil)
+
+func newTestBillingClient() *testBillingClient {
+	return &testBillingClient{
+		customers: make(map[string]*pb.GetCustomerResponse),
+		usageKeys: make(map[string]struct{}),
+	}
+}
+
+func (c *testBillingClient) setCustomer(cus *pb.GetCustomerResponse) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	c.customers[cus.Key] = cus
+}
+
+func (c *testBillingClient) getIncs() []testUsageInc {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	return append([]testUsageInc(nil), c.incs...)
+}
+
+func (c *testBillingClient) CreateCustomer(
+	_ context.Context,
+	key thread.PubKey,
+	email string,
+	_ string,
+	accountType mdb.AccountType,
+	_ ...billing.Option,
+) (string, error) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if _, ok := c.customers[key.String()]; ok {
+		return "", errors.New("customer already exists")
+	}
+	cus := newTestCustomer(key)
+	cus.Email = email
+	cus.AccountType = int32(accountType)
+	c.customers[key.String()] = cus
+	return "cus_" + key.String(), nil
+}
+
+func (c *testBillingClient) GetCustomer(_ context.Context, key thread.PubKey) (*pb.GetCustomerResponse, error) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	cus, ok := c.customers[key.String()]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return cus, nil
+}
+
+func (c *testBillingClient) IncCustomerUsage(
+	_ context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	args := &billing.UsageOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	cus, ok := c.customers[key.String()]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	res := &pb.IncCustomerUsageResponse{DailyUsage: make(map[string]*pb.Usage)}
+	if args.IdempotencyKey != "" {
+		// Like billingd, ignore usage that was already applied under the same key.
+		usageKey := key.String() + "/" + args.IdempotencyKey
+		if _, ok := c.usageKeys[usageKey]; ok {
+			for k := range productUsage {
+				if u, ok := cus.DailyUsage[k]; ok {
+					res.DailyUsage[k] = u
+				}
+			}
+			return res, nil
+		}
+		c.usageKeys[usageKey] = struct{}{}
+	}
+	for k, inc := range productUsage {
+		if u, ok := cus.DailyUsage[k]; ok {
+			u.Total += inc
+			u.Free -= inc
+			if u.Free < 0 {
+				u.Free = 0
+			}
+			u.Grace -= inc
+			if u.Grace < 0 {
+				u.Grace = 0
+			}
+			res.DailyUsage[k] = u
+		}
+	}
+	c.incs = append(c.incs, testUsageInc{
+		key:            key.String(),
+		usage:          productUsage,
+		idempotencyKey: args.IdempotencyKey,
+		labels:         args.Labels,
+		bucketKey:      args.BucketKey,
+	})
+	return res, nil
+}
+
+func (c *testBillingClient) SetCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+) (*pb.SetCustomerUsageResponse, error) {
+	cus, err := c.GetCustomer(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	inc := make(map[string]int64)
+	for k, total := range productUsage {
+		if u, ok := cus.DailyUsage[k]; ok {
+			inc[k] = total - u.Total
+		}
+	}
+	res, err := c.IncCustomerUsage(ctx, key, inc)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetCustomerUsageResponse{DailyUsage: res.DailyUsage}, nil
+}
+
+func (c *testBillingClient) DeleteCustomer(_ context.Context, key thread.PubKey) error {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if _, ok := c.customers[key.String()]; !ok {
+		return mongo.ErrNoDocuments
+	}
+	delete(c.customers, key.String())
+	return nil
+}
+
+func (c *testBillingClient) TrackEvent(
+	context.Context,
+	thread.PubKey,
+	mdb.AccountType,
+	bool,
+	analytics.Event,
+	map[string]string,
+) error {
+	return nil
+}
+
+func (c *testBillingClient) Close() error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/util"
+)
+
+// newRequestIDContext identifies the request in ctx so that the usage it reports can be deduped by billingd.
+// The ID is always generated by the server. A client-supplied request ID must not be used here since
+// a client could reuse one to have all later usage for the same method deduped away.
+func newRequestIDContext(ctx context.Context) context.Context {
+	if _, ok := requestIDFromContext(ctx); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, usageCtxKey("requestID"), util.MakeToken(16))
+}
+
+func requestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(usageCtxKey("requestID")).(string)
+	return id, ok
+}
+
+// usageIdempotencyKey returns a deterministic key for a usage event reported for a request.
+// event distinguishes multiple reports made for the same request, e.g., egress and stored data.
+func usageIdempotencyKey(requestID, method string, owner thread.PubKey, event string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{requestID, method, owner.String(), event}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// usageKeyOptions returns billing options that key a usage event reported for the request in ctx.
+func usageKeyOptions(ctx context.Context, method string, owner thread.PubKey, event string) []billing.UsageOption {
+	id, ok := requestIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return []billing.UsageOption{billing.WithIdempotencyKey(usageIdempotencyKey(id, method, owner, event))}
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+)
+
+func TestUsageIdempotencyKey(t *testing.T) {
+	dev := newTestDev(t)
+	other := newTestDev(t)
+	key := usageIdempotencyKey("req", pushPathMethod, dev.Key, "stored_data")
+	assert.Equal(t, key, usageIdempotencyKey("req", pushPathMethod, dev.Key, "stored_data"))
+	assert.NotEqual(t, key, usageIdempotencyKey("req2", pushPathMethod, dev.Key, "stored_data"))
+	assert.NotEqual(t, key, usageIdempotencyKey("req", removePathMethod, dev.Key, "stored_data"))
+	assert.NotEqual(t, key, usageIdempotencyKey("req", pushPathMethod, other.Key, "stored_data"))
+	assert.NotEqual(t, key, usageIdempotencyKey("req", pushPathMethod, dev.Key, "network_egress"))
+}
+
+func TestPostUsageFunc_RetriedRequestBilledOnce(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+
+	// Usage for the same request is reported twice, e.g., by an internal retry.
+	ctx, err := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok := buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	for i := 0; i < 2; i++ {
+		require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	}
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.NotEmpty(t, incs[0].idempotencyKey)
+	assert.Equal(t, int64(mib), cus.DailyUsage["stored_data"].Total)
+
+	// A new request is billed.
+	ctx, err = tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+	require.NoError(t, err)
+	owner, ok = buckets.BucketOwnerFromContext(ctx)
+	require.True(t, ok)
+	owner.StorageDelta = mib
+	require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	assert.Len(t, bc.getIncs(), 2)
+	assert.Equal(t, int64(2*mib), cus.DailyUsage["stored_data"].Total)
+}
+
+func TestPostUsageFunc_ClientRequestIDNotUsedForKey(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+
+	// Two different requests carrying the same client request ID are both billed.
+	for i := 0; i < 2; i++ {
+		ctx := metadata.NewIncomingContext(newTestAccountContext(dev),
+			metadata.Pairs("x-textile-request-id", "req-1"))
+		ctx, err := tx.preUsageFunc(ctx, pushPathMethod)
+		require.NoError(t, err)
+		owner, ok := buckets.BucketOwnerFromContext(ctx)
+		require.True(t, ok)
+		owner.StorageDelta = mib
+		require.NoError(t, tx.postUsageFunc(ctx, pushPathMethod))
+	}
+	incs := bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.NotEqual(t, incs[0].idempotencyKey, incs[1].idempotencyKey)
+	assert.Equal(t, int64(2*mib), cus.DailyUsage["stored_data"].Total)
+}
+
+func TestStatsHandler_RetriedRequestBilledOnce(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	h := &StatsHandler{t: tx}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	bc.setCustomer(cus)
+
+	// Stats for the same request are reported twice.
+	ctx := newRequestIDContext(context.Background())
+	for i := 0; i < 2; i++ {
+		rs := &requestStats{key: dev.Key, egress: 100, writes: 1, usageKey: usageKeyOptions(ctx, saveMethod, dev.Key, "stats")}
+		h.HandleRPC(context.WithValue(ctx, statsCtxKey("requestStats"), rs), &stats.End{})
+		require.Eventually(t, func() bool {
+			return len(bc.getIncs()) == 1
+		}, time.Second, time.Millisecond*10)
+	}
+	time.Sleep(time.Millisecond * 50)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.NotEmpty(t, incs[0].idempotencyKey)
+	assert.Equal(t, int64(100), cus.DailyUsage["network_egress"].Total)
+	assert.Equal(t, int64(1), cus.DailyUsage["instance_writes"].Total)
+
+	// A different request with the same client request ID is billed.
+	ctx = newRequestIDContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-textile-request-id", "req-1")))
+	rs := &requestStats{key: dev.Key, egress: 100, writes: 1, usageKey: usageKeyOptions(ctx, saveMethod, dev.Key, "stats")}
+	h.HandleRPC(context.WithValue(ctx, statsCtxKey("requestStats"), rs), &stats.End{})
+	require.Eventually(t, func() bool {
+		return len(bc.getIncs()) == 2
+	}, time.Second, time.Millisecond*10)
+	assert.Equal(t, int64(200), cus.DailyUsage["network_egress"].Total)
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"context"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// labeledBillingClient tags all usage reported to billingd with a static set of labels,
+// e.g., the region and environment of this instance.
+type labeledBillingClient struct {
+	billingClient
+	labels map[string]string
+}
+
+func newLabeledBillingClient(bc billingClient, labels map[string]string) billingClient {
+	return &labeledBillingClient{billingClient: bc, labels: labels}
+}
+
+func (c *labeledBillingClient) IncCustomerUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	productUsage map[string]int64,
+	opts ...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	opts = append([]billing.UsageOption{billing.WithLabels(c.labels)}, opts...)
+	return c.billingClient.IncCustomerUsage(ctx, key, productUsage, opts...)
+}
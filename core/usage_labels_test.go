@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc"
+)
+
+func TestPostUsageFunc_UsageLabels(t *testing.T) {
+	bc := newTestBillingClient()
+	labels := map[string]string{"region": "eu-west-1", "environment": "production"}
+	tx := &Textile{bc: newLabeledBillingClient(bc, labels)}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	intercept := unaryServerInterceptor(tx.preUsageFunc, tx.postUsageFunc)
+	_, err := intercept(newTestAccountContext(dev), nil, &grpc.UnaryServerInfo{FullMethod: pushPathMethod},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			owner, ok := buckets.BucketOwnerFromContext(ctx)
+			require.True(t, ok)
+			owner.StorageDelta = mib
+			return nil, nil
+		})
+	require.NoError(t, err)
+
+	incs := bc.getIncs()
+	require.NotEmpty(t, incs)
+	for _, inc := range incs {
+		assert.Equal(t, labels, inc.labels)
+		// Idempotency keys are still attached.
+		assert.NotEmpty(t, inc.idempotencyKey)
+	}
+}
+
+func TestUsageBatcher_UsageLabels(t *testing.T) {
+	bc := newTestBillingClient()
+	labels := map[string]string{"region": "us-east-1"}
+	b := newUsageBatcher(newLabeledBillingClient(bc, labels), time.Hour, 1)
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	b.add(dev.Key, map[string]int64{"network_egress": 100})
+	b.flush(context.Background())
+
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, labels, incs[0].labels)
+}
+
+func TestLabeledBillingClient_Override(t *testing.T) {
+	bc := newTestBillingClient()
+	lbc := newLabeledBillingClient(bc, map[string]string{"region": "us-east-1", "environment": "production"})
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Labels passed by the caller take precedence over the instance labels.
+	_, err := lbc.IncCustomerUsage(context.Background(), dev.Key, map[string]int64{"network_egress": 100},
+		billing.WithLabels(map[string]string{"region": "us-west-2"}))
+	require.NoError(t, err)
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, map[string]string{"region": "us-west-2", "environment": "production"}, incs[0].labels)
+}
@@ -0,0 +1,135 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// MethodPolicy describes how a request to a method is checked and prepared for metering
+// before it's handled.
+type MethodPolicy struct {
+	// Quota is a usage key that must not be exhausted for the request to be allowed, e.g., instance_reads.
+	Quota string `json:"quota,omitempty"`
+	// Burst enforces the burst limit for Quota.
+	Burst bool `json:"burst,omitempty"`
+	// BucketLimit enforces the owner's bucket limit.
+	BucketLimit bool `json:"bucket_limit,omitempty"`
+	// Storage attaches the owner's available storage to the request so that stored data can be metered.
+	Storage bool `json:"storage,omitempty"`
+	// FreesStorage allows the request when the owner's storage cap is exhausted, e.g., for removals.
+	FreesStorage bool `json:"frees_storage,omitempty"`
+	// ReservesStorage indicates the request makes a storage reservation, which is checked against the
+	// owner's full allowance instead of the allowance left after other reservations.
+	ReservesStorage bool `json:"reserves_storage,omitempty"`
+	// ListenMeter meters the request as a threaddb Listen stream.
+	ListenMeter bool `json:"listen_meter,omitempty"`
+}
+
+// UsagePolicy maps methods to their MethodPolicy. Methods without a policy are not checked
+// beyond the owner's subscription status and network egress.
+type UsagePolicy map[string]MethodPolicy
+
+// quotaKeys are the usage keys that can be used as a MethodPolicy quota, along with the
+// description used when they're exhausted.
+var quotaKeys = map[string]string{
+	"stored_data":          "stored data",
+	"network_egress":       "network egress",
+	"instance_reads":       "threaddb reads",
+	"instance_writes":      "threaddb writes",
+	"instance_cheap_reads": "threaddb cheap reads",
+}
+
+// DefaultUsagePolicy returns the usage policy used when Config.UsagePolicy is not set.
+func DefaultUsagePolicy() UsagePolicy {
+	policy := UsagePolicy{
+		"/api.bucketsd.pb.APIService/Create": {
+			BucketLimit: true,
+			Storage:     true,
+		},
+		"/api.bucketsd.pb.APIService/ReserveStorage": {
+			Storage:         true,
+			ReservesStorage: true,
+		},
+		"/api.bucketsd.pb.APIService/Remove": {
+			Storage:      true,
+			FreesStorage: true,
+		},
+		"/api.bucketsd.pb.APIService/RemovePath": {
+			Storage:      true,
+			FreesStorage: true,
+		},
+	}
+	for _, m := range []string{
+		"/api.bucketsd.pb.APIService/PushPath",
+		"/api.bucketsd.pb.APIService/PushPaths",
+		"/api.bucketsd.pb.APIService/SetPath",
+		"/api.bucketsd.pb.APIService/PushPathAccessRoles",
+	} {
+		policy[m] = MethodPolicy{Storage: true}
+	}
+	for _, m := range []string{
+		"/threads.pb.API/Verify",
+		"/threads.pb.API/Has",
+		"/threads.pb.API/Find",
+		"/threads.pb.API/FindByID",
+		"/threads.pb.API/ReadTransaction",
+	} {
+		policy[m] = MethodPolicy{Quota: "instance_reads", Burst: true}
+	}
+	policy[listenMethod] = MethodPolicy{Quota: "instance_reads", Burst: true, ListenMeter: true}
+	for _, m := range []string{
+		"/threads.pb.API/Create",
+		"/threads.pb.API/Save",
+		"/threads.pb.API/Delete",
+		"/threads.pb.API/WriteTransaction",
+	} {
+		policy[m] = MethodPolicy{Quota: "instance_writes", Burst: true}
+	}
+	return policy
+}
+
+// defaultUsagePolicy is used by Textiles without a configured usage policy.
+var defaultUsagePolicy = DefaultUsagePolicy()
+
+// LoadUsagePolicy reads a JSON-encoded usage policy from path, e.g., to change which methods
+// are metered without a rebuild. The loaded policy replaces the default policy.
+func LoadUsagePolicy(path string) (UsagePolicy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading usage policy: %v", err)
+	}
+	var policy UsagePolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("decoding usage policy: %v", err)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// validate returns an error if a method policy is inconsistent.
+func (p UsagePolicy) validate() error {
+	for method, mp := range p {
+		if mp.Quota != "" {
+			if _, ok := quotaKeys[mp.Quota]; !ok {
+				return fmt.Errorf("invalid usage policy for %s: unknown quota %s", method, mp.Quota)
+			}
+		} else if mp.Burst {
+			return fmt.Errorf("invalid usage policy for %s: burst requires a quota", method)
+		}
+		if (mp.FreesStorage || mp.ReservesStorage) && !mp.Storage {
+			return fmt.Errorf("invalid usage policy for %s: storage options require storage", method)
+		}
+	}
+	return nil
+}
+
+// usagePolicy returns the configured usage policy, or the default policy if one isn't set.
+func (t *Textile) usagePolicy() UsagePolicy {
+	if t.conf.UsagePolicy != nil {
+		return t.conf.UsagePolicy
+	}
+	return defaultUsagePolicy
+}
@@ -0,0 +1,177 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// legacyUsageMethods are the methods checked by the hardcoded usage switch that
+// DefaultUsagePolicy replaced, grouped by how they were checked.
+var legacyUsageMethods = struct {
+	storage, reads, writes []string
+}{
+	storage: []string{
+		"/api.bucketsd.pb.APIService/Create",
+		"/api.bucketsd.pb.APIService/PushPath",
+		"/api.bucketsd.pb.APIService/PushPaths",
+		"/api.bucketsd.pb.APIService/SetPath",
+		"/api.bucketsd.pb.APIService/Remove",
+		"/api.bucketsd.pb.APIService/RemovePath",
+		"/api.bucketsd.pb.APIService/PushPathAccessRoles",
+		"/api.bucketsd.pb.APIService/ReserveStorage",
+	},
+	reads: []string{
+		"/threads.pb.API/Verify",
+		"/threads.pb.API/Has",
+		"/threads.pb.API/Find",
+		"/threads.pb.API/FindByID",
+		"/threads.pb.API/ReadTransaction",
+		"/threads.pb.API/Listen",
+	},
+	writes: []string{
+		"/threads.pb.API/Create",
+		"/threads.pb.API/Save",
+		"/threads.pb.API/Delete",
+		"/threads.pb.API/WriteTransaction",
+	},
+}
+
+func TestDefaultUsagePolicy(t *testing.T) {
+	policy := DefaultUsagePolicy()
+	require.NoError(t, policy.validate())
+	assert.Len(t, policy, len(legacyUsageMethods.storage)+len(legacyUsageMethods.reads)+len(legacyUsageMethods.writes))
+
+	for _, m := range legacyUsageMethods.storage {
+		p, ok := policy[m]
+		require.True(t, ok, m)
+		assert.Equal(t, MethodPolicy{
+			Storage:         true,
+			BucketLimit:     m == createMethod,
+			FreesStorage:    m == "/api.bucketsd.pb.APIService/Remove" || m == removePathMethod,
+			ReservesStorage: m == reserveStorageMethod,
+		}, p, m)
+	}
+	for _, m := range legacyUsageMethods.reads {
+		p, ok := policy[m]
+		require.True(t, ok, m)
+		assert.Equal(t, MethodPolicy{Quota: "instance_reads", Burst: true, ListenMeter: m == listenMethod}, p, m)
+	}
+	for _, m := range legacyUsageMethods.writes {
+		p, ok := policy[m]
+		require.True(t, ok, m)
+		assert.Equal(t, MethodPolicy{Quota: "instance_writes", Burst: true}, p, m)
+	}
+}
+
+func TestPreUsageFunc_DefaultUsagePolicy(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+	contains := func(methods []string, m string) bool {
+		for _, x := range methods {
+			if x == m {
+				return true
+			}
+		}
+		return false
+	}
+	methods := append(append(append([]string{}, legacyUsageMethods.storage...),
+		legacyUsageMethods.reads...), legacyUsageMethods.writes...)
+	methods = append(methods, "/threads.pb.API/NewDB")
+
+	for _, m := range methods {
+		if m == createMethod {
+			continue // Requires a bucket counter
+		}
+		isStorage := contains(legacyUsageMethods.storage, m)
+		isRead := contains(legacyUsageMethods.reads, m)
+		isWrite := contains(legacyUsageMethods.writes, m)
+
+		// Storage is attached for storage methods.
+		dev := newTestDev(t)
+		bc.setCustomer(newTestCustomer(dev.Key))
+		ctx, err := tx.preUsageFunc(newTestAccountContext(dev), m)
+		require.NoError(t, err, m)
+		_, ok := buckets.BucketOwnerFromContext(ctx)
+		assert.Equal(t, isStorage, ok, m)
+		_, ok = listenMeterFromContext(ctx)
+		assert.Equal(t, m == listenMethod, ok, m)
+
+		// Exhausted reads and writes deny read and write methods.
+		for key, denied := range map[string]bool{"instance_reads": isRead, "instance_writes": isWrite} {
+			dev := newTestDev(t)
+			cus := newTestCustomer(dev.Key)
+			cus.DailyUsage[key].Free = 0
+			bc.setCustomer(cus)
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), m)
+			if denied {
+				require.Error(t, err, m)
+				assert.Equal(t, codes.ResourceExhausted, status.Code(err), m)
+			} else {
+				require.NoError(t, err, m)
+			}
+		}
+	}
+
+	// Denials keep the legacy messages.
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_writes"].Free = 0
+	bc.setCustomer(cus)
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Save")
+	require.Error(t, err)
+	assert.Contains(t, status.Convert(err).Message(), "threaddb writes exhausted")
+}
+
+func TestPreUsageFunc_CustomUsagePolicy(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, conf: Config{
+		UsagePolicy: UsagePolicy{
+			findMethod: {Quota: "instance_writes"},
+		},
+	}}
+
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["instance_writes"].Free = 0
+	bc.setCustomer(cus)
+
+	// Find is checked against writes, and methods missing from the policy are not checked.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Save")
+	require.NoError(t, err)
+}
+
+func TestLoadUsagePolicy(t *testing.T) {
+	dir := t.TempDir()
+
+	// The default policy survives a round trip through JSON.
+	data, err := json.Marshal(DefaultUsagePolicy())
+	require.NoError(t, err)
+	path := filepath.Join(dir, "policy.json")
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+	policy, err := LoadUsagePolicy(path)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultUsagePolicy(), policy)
+
+	invalid := map[string]string{
+		"quota":   `{"/threads.pb.API/Find": {"quota": "instance_deletes"}}`,
+		"burst":   `{"/threads.pb.API/Find": {"burst": true}}`,
+		"storage": `{"/api.bucketsd.pb.APIService/Remove": {"frees_storage": true}}`,
+	}
+	for name, p := range invalid {
+		path := filepath.Join(dir, name+".json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(p), 0644))
+		_, err := LoadUsagePolicy(path)
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,173 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/util"
+)
+
+const (
+	// defaultUsageDeadLetterMaxAge is the default age after which dead-lettered usage is dropped.
+	defaultUsageDeadLetterMaxAge = time.Hour * 24 * 7
+
+	// maxUsageReplayBackoff caps the delay between replay attempts of a dead letter.
+	maxUsageReplayBackoff = time.Hour * 6
+
+	// usageReplayBatchSize is the max number of dead letters replayed on each interval.
+	usageReplayBatchSize = 100
+)
+
+// usageDeadLetterStore persists usage that could not be sent to billingd.
+type usageDeadLetterStore interface {
+	Create(
+		ctx context.Context,
+		id string,
+		key thread.PubKey,
+		usage map[string]int64,
+		lastErr string,
+	) (*mdb.UsageDeadLetter, error)
+	ListDue(ctx context.Context, now time.Time, limit int64) ([]mdb.UsageDeadLetter, error)
+	Reschedule(ctx context.Context, id string, next time.Time, lastErr string) error
+	Delete(ctx context.Context, id string) error
+}
+
+var _ usageDeadLetterStore = (*mdb.UsageDeadLetters)(nil)
+
+// deadLetterUsage stores usage that failed to send with cause so that it can be replayed.
+// id is used as the idempotency key when the usage is replayed. A new one is made if empty.
+func deadLetterUsage(
+	ctx context.Context,
+	store usageDeadLetterStore,
+	id string,
+	key thread.PubKey,
+	usage map[string]int64,
+	cause error,
+) error {
+	if id == "" {
+		id = util.MakeToken(32)
+	}
+	if _, err := store.Create(ctx, id, key, usage, cause.Error()); err != nil {
+		return err
+	}
+	log.Warnf("usage for %s was dead-lettered as %s: %v", key, id, cause)
+	return nil
+}
+
+// usageReplayer periodically retries dead-lettered usage with exponential backoff.
+// Dead letters older than maxAge are dropped.
+type usageReplayer struct {
+	store    usageDeadLetterStore
+	bc       billingClient
+	clock    Clock
+	interval time.Duration
+	maxAge   time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newUsageReplayer(
+	store usageDeadLetterStore,
+	bc billingClient,
+	clock Clock,
+	interval time.Duration,
+	maxAge time.Duration,
+) *usageReplayer {
+	if maxAge <= 0 {
+		maxAge = defaultUsageDeadLetterMaxAge
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &usageReplayer{
+		store:    store,
+		bc:       bc,
+		clock:    clock,
+		interval: interval,
+		maxAge:   maxAge,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// start begins replaying dead letters on the replayer's interval.
+func (r *usageReplayer) start() {
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				r.replay(r.ctx)
+			}
+		}
+	}()
+}
+
+// replay retries dead letters that are due. Replayed usage is sent under the dead letter's ID,
+// so that billingd ignores it if the original increment was actually applied.
+func (r *usageReplayer) replay(ctx context.Context) {
+	now := r.clock.Now()
+	due, err := r.store.ListDue(ctx, now, usageReplayBatchSize)
+	if err != nil {
+		log.Errorf("usage replayer: listing dead letters: %v", err)
+		return
+	}
+	for _, dl := range due {
+		if now.Sub(dl.CreatedAt) > r.maxAge {
+			log.Errorf("usage replayer: dropping usage for %s after %d attempts: %s", dl.Key, dl.Attempts+1, dl.LastError)
+			if err := r.store.Delete(ctx, dl.ID); err != nil {
+				log.Errorf("usage replayer: deleting dead letter %s: %v", dl.ID, err)
+			}
+			continue
+		}
+		sctx, cancel := context.WithTimeout(ctx, statsTimeout)
+		_, err := r.bc.IncCustomerUsage(sctx, dl.Key, dl.Usage, billing.WithIdempotencyKey(dl.ID))
+		cancel()
+		var perr *partialUsageError
+		if errors.As(err, &perr) {
+			// The rest of the usage was applied, so only the failed usage is dead-lettered again.
+			if derr := deadLetterUsage(ctx, r.store, perr.idempotencyKey, dl.Key, perr.failed, err); derr != nil {
+				log.Errorf("usage replayer: dead-lettering usage for %s: %v", dl.Key, derr)
+			} else {
+				err = nil
+			}
+		}
+		if err != nil {
+			next := now.Add(r.backoff(dl.Attempts + 1))
+			if err := r.store.Reschedule(ctx, dl.ID, next, err.Error()); err != nil {
+				log.Errorf("usage replayer: rescheduling dead letter %s: %v", dl.ID, err)
+			}
+			continue
+		}
+		if err := r.store.Delete(ctx, dl.ID); err != nil {
+			log.Errorf("usage replayer: deleting dead letter %s: %v", dl.ID, err)
+		}
+	}
+}
+
+// backoff returns the delay before a dead letter that has failed attempts times is retried.
+func (r *usageReplayer) backoff(attempts int) time.Duration {
+	d := r.interval
+	for i := 1; i < attempts && d < maxUsageReplayBackoff; i++ {
+		d *= 2
+	}
+	if d > maxUsageReplayBackoff {
+		d = maxUsageReplayBackoff
+	}
+	return d
+}
+
+// close stops the replayer.
+func (r *usageReplayer) close() {
+	r.cancel()
+	<-r.done
+}
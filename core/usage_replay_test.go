@@ -0,0 +1,199 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestUsageBatcher_DeadLetterReplay(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := newTestDeadLetterStore(clock)
+	dev := newTestDev(t)
+	bc := &blockingBillingClient{
+		testBillingClient: newTestBillingClient(),
+		fail:              map[string]bool{dev.Key.String(): true},
+	}
+	bc.setCustomer(newTestCustomer(dev.Key))
+	b := newUsageBatcher(bc, time.Hour, 1)
+	b.deadLetters = store
+
+	// Usage that fails on every attempt lands in the dead-letter store.
+	b.add(dev.Key, map[string]int64{"network_egress": 10})
+	for i := 0; i < maxUsageFlushAttempts; i++ {
+		b.flush(context.Background())
+	}
+	assert.Empty(t, b.queues)
+	letters := store.list()
+	require.Len(t, letters, 1)
+	assert.True(t, dev.Key.Equals(letters[0].Key))
+	assert.Equal(t, int64(10), letters[0].Usage["network_egress"])
+
+	// The dead letter is replayed once billingd recovers.
+	r := newUsageReplayer(store, bc, clock, time.Minute, 0)
+	bc.setFail(dev.Key, false)
+	r.replay(context.Background())
+	assert.Empty(t, store.list())
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(10), incs[0].usage["network_egress"])
+	assert.Equal(t, letters[0].ID, incs[0].idempotencyKey)
+}
+
+func TestRecordUsage_DeadLetterReplay(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := newTestDeadLetterStore(clock)
+	dev := newTestDev(t)
+	bc := &blockingBillingClient{
+		testBillingClient: newTestBillingClient(),
+		fail:              map[string]bool{dev.Key.String(): true},
+	}
+	bc.setCustomer(newTestCustomer(dev.Key))
+	tx := &Textile{bc: bc, clock: clock, deadLetters: store}
+
+	err := tx.recordUsage(context.Background(), dev.Key, map[string]int64{
+		"stored_data": mib,
+	}, billing.WithIdempotencyKey("key1"))
+	require.NoError(t, err)
+	letters := store.list()
+	require.Len(t, letters, 1)
+	assert.Equal(t, "key1", letters[0].ID)
+
+	// Replays back off exponentially while billingd is failing.
+	r := newUsageReplayer(store, bc, clock, time.Minute, 0)
+	r.replay(context.Background())
+	require.Len(t, store.list(), 1)
+	assert.Equal(t, clock.Now().Add(time.Minute), store.list()[0].NextAttemptAt)
+	clock.advance(time.Minute)
+	r.replay(context.Background())
+	assert.Equal(t, clock.Now().Add(2*time.Minute), store.list()[0].NextAttemptAt)
+
+	// Not yet due.
+	bc.setFail(dev.Key, false)
+	r.replay(context.Background())
+	assert.Empty(t, bc.getIncs())
+
+	clock.advance(2 * time.Minute)
+	r.replay(context.Background())
+	assert.Empty(t, store.list())
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, int64(mib), incs[0].usage["stored_data"])
+}
+
+func TestUsageReplayer_MaxAge(t *testing.T) {
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := newTestDeadLetterStore(clock)
+	dev := newTestDev(t)
+	bc := newTestBillingClient()
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	_, err := store.Create(context.Background(), "key1", dev.Key, map[string]int64{"network_egress": 10}, "boom")
+	require.NoError(t, err)
+
+	r := newUsageReplayer(store, bc, clock, time.Minute, time.Hour)
+	clock.advance(2 * time.Hour)
+	r.replay(context.Background())
+	assert.Empty(t, store.list())
+	assert.Empty(t, bc.getIncs())
+}
+
+func TestUsageReplayer_Backoff(t *testing.T) {
+	r := newUsageReplayer(nil, nil, nil, time.Minute, 0)
+	assert.Equal(t, time.Minute, r.backoff(1))
+	assert.Equal(t, 2*time.Minute, r.backoff(2))
+	assert.Equal(t, 8*time.Minute, r.backoff(4))
+	assert.Equal(t, maxUsageReplayBackoff, r.backoff(100))
+}
+
+// testDeadLetterStore is an in-memory usageDeadLetterStore.
+type testDeadLetterStore struct {
+	clock Clock
+
+	lk      sync.Mutex
+	letters map[string]*mdb.UsageDeadLetter
+}
+
+var _ usageDeadLetterStore = (*testDeadLetterStore)(nil)
+
+func newTestDeadLetterStore(clock Clock) *testDeadLetterStore {
+	return &testDeadLetterStore{clock: clock, letters: make(map[string]*mdb.UsageDeadLetter)}
+}
+
+func (s *testDeadLetterStore) list() []mdb.UsageDeadLetter {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	var list []mdb.UsageDeadLetter
+	for _, dl := range s.letters {
+		list = append(list, *dl)
+	}
+	return list
+}
+
+func (s *testDeadLetterStore) Create(
+	_ context.Context,
+	id string,
+	key thread.PubKey,
+	usage map[string]int64,
+	lastErr string,
+) (*mdb.UsageDeadLetter, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	if _, ok := s.letters[id]; ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	now := s.clock.Now()
+	dl := &mdb.UsageDeadLetter{
+		ID:            id,
+		Key:           key,
+		Usage:         usage,
+		LastError:     lastErr,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	s.letters[id] = dl
+	return dl, nil
+}
+
+func (s *testDeadLetterStore) ListDue(_ context.Context, now time.Time, _ int64) ([]mdb.UsageDeadLetter, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	var list []mdb.UsageDeadLetter
+	for _, dl := range s.letters {
+		if !dl.NextAttemptAt.After(now) {
+			list = append(list, *dl)
+		}
+	}
+	return list, nil
+}
+
+func (s *testDeadLetterStore) Reschedule(_ context.Context, id string, next time.Time, lastErr string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	dl, ok := s.letters[id]
+	if !ok {
+		return mongo.ErrNoDocuments
+	}
+	dl.Attempts++
+	dl.NextAttemptAt = next
+	dl.LastError = lastErr
+	return nil
+}
+
+func (s *testDeadLetterStore) Delete(_ context.Context, id string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	if _, ok := s.letters[id]; !ok {
+		return mongo.ErrNoDocuments
+	}
+	delete(s.letters, id)
+	return nil
+}
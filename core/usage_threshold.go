@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// defaultUsageReportTTL is the default max time a sub-threshold delta is held before it's reported.
+const defaultUsageReportTTL = time.Minute
+
+// heldUsage is an owner's usage deltas that have not been reported.
+type heldUsage struct {
+	key   thread.PubKey
+	usage map[string]int64
+	since time.Time
+}
+
+// usageThresholder holds usage deltas that are smaller than their key's threshold, accumulating them
+// for each owner until their sum reaches the threshold, e.g., to reduce billingd writes for small
+// network egress deltas. Unlike rounding, the full delta is eventually reported: held usage is
+// reported once it's older than the TTL, and when the thresholder is closed.
+type usageThresholder struct {
+	thresholds map[string]int64
+	ttl        time.Duration
+	now        func() time.Time
+	report     func(key thread.PubKey, usage map[string]int64)
+
+	lk     sync.Mutex
+	owners map[string]*heldUsage
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newUsageThresholder(
+	thresholds map[string]int64,
+	ttl time.Duration,
+	now func() time.Time,
+	report func(key thread.PubKey, usage map[string]int64),
+) *usageThresholder {
+	if ttl <= 0 {
+		ttl = defaultUsageReportTTL
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &usageThresholder{
+		thresholds: thresholds,
+		ttl:        ttl,
+		now:        now,
+		report:     report,
+		owners:     make(map[string]*heldUsage),
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+}
+
+// start reports held usage that's older than the TTL.
+func (u *usageThresholder) start() {
+	go func() {
+		defer close(u.done)
+		ticker := time.NewTicker(u.ttl / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-u.ctx.Done():
+				return
+			case <-ticker.C:
+				u.flush(u.now().Add(-u.ttl))
+			}
+		}
+	}()
+}
+
+// hold removes deltas from usage that don't reach their key's threshold when added to the owner's
+// held deltas, which are held instead. Held deltas of keys that reach their threshold are added to usage.
+func (u *usageThresholder) hold(key thread.PubKey, usage map[string]int64) {
+	u.lk.Lock()
+	defer u.lk.Unlock()
+	held := u.owners[key.String()]
+	for k, delta := range usage {
+		threshold, ok := u.thresholds[k]
+		if !ok || threshold <= 0 {
+			continue
+		}
+		if held != nil {
+			delta += held.usage[k]
+			delete(held.usage, k)
+		}
+		if delta >= threshold || delta <= -threshold {
+			usage[k] = delta
+			continue
+		}
+		delete(usage, k)
+		if delta == 0 {
+			continue
+		}
+		if held == nil {
+			held = &heldUsage{key: key, usage: make(map[string]int64), since: u.now()}
+			u.owners[key.String()] = held
+		}
+		held.usage[k] = delta
+	}
+	if held != nil && len(held.usage) == 0 {
+		delete(u.owners, key.String())
+	}
+}
+
+// flush reports the usage of owners that has been held since before.
+// All held usage is reported if before is zero.
+func (u *usageThresholder) flush(before time.Time) {
+	u.lk.Lock()
+	var due []*heldUsage
+	for owner, held := range u.owners {
+		if before.IsZero() || held.since.Before(before) {
+			due = append(due, held)
+			delete(u.owners, owner)
+		}
+	}
+	u.lk.Unlock()
+	for _, held := range due {
+		u.report(held.key, held.usage)
+	}
+}
+
+// close stops the thresholder and reports all held usage.
+func (u *usageThresholder) close() {
+	u.cancel()
+	<-u.done
+	u.flush(time.Time{})
+}
+
+// holdUsage holds an owner's sub-threshold usage deltas in place, see usageThresholder.
+// True is returned if all of the usage is held, in which case there's nothing to report.
+func (t *Textile) holdUsage(key thread.PubKey, usage map[string]int64) bool {
+	if t.thresholder == nil {
+		return false
+	}
+	t.thresholder.hold(key, usage)
+	return len(usage) == 0
+}
+
+// reportHeldUsage reports usage that was held by the thresholder.
+func (t *Textile) reportHeldUsage(key thread.PubKey, usage map[string]int64) {
+	t.consumeDecisions(key, usage)
+	defer t.watchers.notify(key.String())
+	if t.usage != nil {
+		t.usage.add(key, usage)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+	defer cancel()
+	if err := t.incUsage(ctx, key, usage); err != nil {
+		log.Errorf("reporting held usage for %s: %v", key, err)
+	}
+}
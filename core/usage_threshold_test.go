@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordUsage_Threshold(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{bc: bc, clock: clock}
+	tx.thresholder = newUsageThresholder(map[string]int64{"network_egress": mib}, time.Minute, tx.now, tx.reportHeldUsage)
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	ctx := context.Background()
+
+	// Sub-threshold egress is held, but keys without a threshold are reported.
+	for i := 0; i < 3; i++ {
+		err := tx.recordUsage(ctx, dev.Key, map[string]int64{"network_egress": 300 * 1024, "instance_reads": 1})
+		require.NoError(t, err)
+	}
+	incs := bc.getIncs()
+	require.Len(t, incs, 3)
+	for _, inc := range incs {
+		assert.Equal(t, map[string]int64{"instance_reads": 1}, inc.usage)
+	}
+
+	// The accumulated egress is reported once it reaches the threshold.
+	require.NoError(t, tx.recordUsage(ctx, dev.Key, map[string]int64{"network_egress": 300 * 1024}))
+	incs = bc.getIncs()
+	require.Len(t, incs, 4)
+	assert.Equal(t, map[string]int64{"network_egress": 4 * 300 * 1024}, incs[3].usage)
+	assert.Empty(t, tx.thresholder.owners)
+}
+
+func TestUsageThresholder_Flush(t *testing.T) {
+	bc := newTestBillingClient()
+	clock := newTestClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	tx := &Textile{bc: bc, clock: clock}
+	tx.thresholder = newUsageThresholder(map[string]int64{"network_egress": mib}, time.Minute, tx.now, tx.reportHeldUsage)
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+	other := newTestDev(t)
+	bc.setCustomer(newTestCustomer(other.Key))
+	ctx := context.Background()
+
+	require.NoError(t, tx.recordUsage(ctx, dev.Key, map[string]int64{"network_egress": 1024}))
+	clock.advance(time.Second * 30)
+	require.NoError(t, tx.recordUsage(ctx, other.Key, map[string]int64{"network_egress": 2048}))
+	assert.Empty(t, bc.getIncs())
+
+	// Held usage is reported once it's older than the TTL.
+	clock.advance(time.Second * 31)
+	tx.thresholder.flush(tx.now().Add(-time.Minute))
+	incs := bc.getIncs()
+	require.Len(t, incs, 1)
+	assert.Equal(t, dev.Key.String(), incs[0].key)
+	assert.Equal(t, int64(1024), incs[0].usage["network_egress"])
+
+	// Remaining usage is reported when the thresholder is closed.
+	tx.thresholder.start()
+	tx.thresholder.close()
+	incs = bc.getIncs()
+	require.Len(t, incs, 2)
+	assert.Equal(t, other.Key.String(), incs[1].key)
+	assert.Equal(t, int64(2048), incs[1].usage["network_egress"])
+}
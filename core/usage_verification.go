@@ -0,0 +1,67 @@
+package core
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+)
+
+// mismatchLogger logs a message with structured key-value pairs, e.g., log.Errorw.
+type mismatchLogger func(msg string, keysAndValues ...interface{})
+
+// sampleUsageVerification returns whether or not the next usage report should be verified.
+func (t *Textile) sampleUsageVerification() bool {
+	rate := t.conf.UsageVerifySampleRate
+	return rate >= 1 || (rate > 0 && rand.Float64() < rate)
+}
+
+// incVerifiedUsage sends usage for an owner to billingd and confirms that the owner's totals moved
+// by the reported amounts, logging discrepancies, e.g., usage that was dropped by billingd.
+// Usage reported concurrently for the same owner, or usage that billingd ignores because it was
+// already applied under the same idempotency key, is also logged as a discrepancy.
+func (t *Textile) incVerifiedUsage(
+	ctx context.Context,
+	key thread.PubKey,
+	usage map[string]int64,
+	opts ...billing.UsageOption,
+) error {
+	cus, err := t.bc.GetCustomer(ctx, key)
+	if err != nil {
+		log.Warnf("verifying usage for %s: %v", key, err)
+		_, err = t.bc.IncCustomerUsage(ctx, key, usage, opts...)
+		return err
+	}
+	before := make(map[string]int64, len(usage))
+	for k := range usage {
+		before[k] = cus.DailyUsage[k].GetTotal()
+	}
+	if _, err := t.bc.IncCustomerUsage(ctx, key, usage, opts...); err != nil {
+		return err
+	}
+	cus, err = t.bc.GetCustomer(ctx, key)
+	if err != nil {
+		log.Warnf("verifying usage for %s: %v", key, err)
+		return nil
+	}
+	logMismatch := t.logMismatch
+	if logMismatch == nil {
+		logMismatch = log.Errorw
+	}
+	for k, expected := range usage {
+		if expected == 0 {
+			continue
+		}
+		if recorded := cus.DailyUsage[k].GetTotal() - before[k]; recorded != expected {
+			logMismatch("reported usage was not recorded as expected",
+				"owner", t.ownerLabel(key),
+				"key", k,
+				"expected", strconv.FormatInt(expected, 10),
+				"recorded", strconv.FormatInt(recorded, 10),
+			)
+		}
+	}
+	return nil
+}
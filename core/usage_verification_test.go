@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	billing "github.com/textileio/textile/v2/api/billingd/client"
+	"github.com/textileio/textile/v2/api/billingd/pb"
+)
+
+// droppingBillingClient acknowledges usage without recording it, like a billingd that drops increments.
+type droppingBillingClient struct {
+	billingClient
+}
+
+func (c *droppingBillingClient) IncCustomerUsage(
+	context.Context,
+	thread.PubKey,
+	map[string]int64,
+	...billing.UsageOption,
+) (*pb.IncCustomerUsageResponse, error) {
+	return &pb.IncCustomerUsageResponse{}, nil
+}
+
+func TestRecordUsage_Verification(t *testing.T) {
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{bc: bc, logMismatch: logger.log, conf: Config{UsageVerifySampleRate: 1}}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Recorded usage matches.
+	usage := map[string]int64{"network_egress": mib, "instance_reads": 0}
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, usage))
+	assert.Empty(t, logger.entries)
+
+	// Dropped usage is detected.
+	tx.bc = &droppingBillingClient{billingClient: bc}
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, usage))
+	require.Len(t, logger.entries, 1)
+	assert.Equal(t, map[string]string{
+		"owner":    dev.Key.String(),
+		"key":      "network_egress",
+		"expected": "1048576",
+		"recorded": "0",
+	}, logger.entries[0])
+}
+
+func TestRecordUsage_VerificationUnsampled(t *testing.T) {
+	bc := newTestBillingClient()
+	logger := &testDenialLogger{}
+	tx := &Textile{bc: &droppingBillingClient{billingClient: bc}, logMismatch: logger.log}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	// Usage isn't verified when sampling is disabled.
+	require.NoError(t, tx.recordUsage(context.Background(), dev.Key, map[string]int64{"network_egress": mib}))
+	assert.Empty(t, logger.entries)
+}
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	upb "github.com/textileio/textile/v2/api/usersd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// usageWatchers notifies the WatchUsage streams of an owner when their usage changes.
+// Each watcher has a single pending notification so that a slow client coalesces changes
+// and is sent the latest snapshot instead of blocking usage recording.
+type usageWatchers struct {
+	lk     sync.Mutex
+	owners map[string]map[chan struct{}]struct{}
+}
+
+func newUsageWatchers() *usageWatchers {
+	return &usageWatchers{owners: make(map[string]map[chan struct{}]struct{})}
+}
+
+// subscribe returns a channel that receives a notification when owner's usage changes.
+// The returned func must be called when the watcher exits.
+func (w *usageWatchers) subscribe(owner string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	w.lk.Lock()
+	defer w.lk.Unlock()
+	subs, ok := w.owners[owner]
+	if !ok {
+		subs = make(map[chan struct{}]struct{})
+		w.owners[owner] = subs
+	}
+	subs[ch] = struct{}{}
+	return ch, func() {
+		w.lk.Lock()
+		defer w.lk.Unlock()
+		delete(w.owners[owner], ch)
+		if len(w.owners[owner]) == 0 {
+			delete(w.owners, owner)
+		}
+	}
+}
+
+// notify signals the watchers of owner without blocking.
+func (w *usageWatchers) notify(owner string) {
+	if w == nil {
+		return
+	}
+	w.lk.Lock()
+	defer w.lk.Unlock()
+	for ch := range w.owners[owner] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// WatchUsage sends the daily usage of the owner in ctx, and again each time it changes,
+// until ctx is done.
+func (t *Textile) WatchUsage(ctx context.Context, send func(*upb.WatchUsageResponse) error) error {
+	if t.bc == nil || t.watchers == nil {
+		return status.Error(codes.Unimplemented, "Usage is not enabled")
+	}
+	account, ok := mdb.AccountFromContext(ctx)
+	if !ok || account.Owner() == nil {
+		return status.Error(codes.Unauthenticated, "Account is required")
+	}
+	ownerKey := t.ownerKey(ctx, account)
+	changes, unsubscribe := t.watchers.subscribe(ownerKey.String())
+	defer unsubscribe()
+	for {
+		cus, err := t.bc.GetCustomer(ctx, ownerKey)
+		if err != nil {
+			if !strings.Contains(err.Error(), mongo.ErrNoDocuments.Error()) {
+				return err
+			}
+		} else {
+			cus = t.applyPendingUsage(ownerKey, cus)
+			cus = t.applyResellerMultiplier(cus)
+			if err := send(&upb.WatchUsageResponse{DailyUsage: cus.DailyUsage}); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	upb "github.com/textileio/textile/v2/api/usersd/pb"
+)
+
+func TestTextile_WatchUsage(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, watchers: newUsageWatchers()}
+	dev := newTestDev(t)
+	bc.setCustomer(newTestCustomer(dev.Key))
+
+	ctx, cancel := context.WithCancel(newTestAccountContext(dev))
+	reads := make(chan int64)
+	send := func(res *upb.WatchUsageResponse) error {
+		reads <- res.DailyUsage["instance_reads"].Total
+		return nil
+	}
+	done := make(chan error)
+	go func() {
+		done <- tx.WatchUsage(ctx, send)
+	}()
+	recv := func() int64 {
+		select {
+		case total := <-reads:
+			return total
+		case <-time.After(5 * time.Second):
+			t.Fatal("snapshot was not sent")
+			return 0
+		}
+	}
+
+	// The current usage is sent when the watch starts.
+	assert.Equal(t, int64(0), recv())
+
+	// Each increment pushes a new snapshot.
+	require.NoError(t, tx.recordUsage(ctx, dev.Key, map[string]int64{"instance_reads": 3}))
+	assert.Equal(t, int64(3), recv())
+	require.NoError(t, tx.recordUsage(ctx, dev.Key, map[string]int64{"instance_reads": 2}))
+	assert.Equal(t, int64(5), recv())
+
+	// The watch ends when the client disconnects.
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not end")
+	}
+	assert.Empty(t, tx.watchers.owners)
+}
+
+func TestUsageWatchers_Coalesce(t *testing.T) {
+	w := newUsageWatchers()
+	changes, unsubscribe := w.subscribe("owner")
+	defer unsubscribe()
+
+	// Notifications for a slow watcher don't block and are coalesced.
+	w.notify("owner")
+	w.notify("owner")
+	w.notify("other")
+	<-changes
+	select {
+	case <-changes:
+		t.Fatal("notifications were not coalesced")
+	default:
+	}
+}
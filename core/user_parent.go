@@ -0,0 +1,112 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// userParentFallback is how users whose parent account doesn't exist become customers.
+type userParentFallback int
+
+const (
+	// userParentFallbackNone fails customer creation for users with a missing parent.
+	userParentFallbackNone userParentFallback = iota
+	// userParentFallbackPlaceholder creates a placeholder parent customer for the missing parent.
+	userParentFallbackPlaceholder
+	// userParentFallbackDefault attaches users with a missing parent to UserParentFallbackKey.
+	userParentFallbackDefault
+	// userParentFallbackStandalone creates users with a missing parent as standalone customers.
+	userParentFallbackStandalone
+)
+
+func parseUserParentFallback(mode string) (userParentFallback, error) {
+	switch strings.ToLower(mode) {
+	case "", "none":
+		return userParentFallbackNone, nil
+	case "placeholder":
+		return userParentFallbackPlaceholder, nil
+	case "default":
+		return userParentFallbackDefault, nil
+	case "standalone":
+		return userParentFallbackStandalone, nil
+	default:
+		return 0, fmt.Errorf("invalid user parent fallback: %s", mode)
+	}
+}
+
+// ParentResolver returns the key of the account that a user without an API key belongs to.
+// False is returned if the parent can't be resolved.
+type ParentResolver func(ctx context.Context) (thread.PubKey, bool)
+
+// NewUserParentContext adds the key of the account that a user belongs to, e.g., as derived
+// from a JWT, to ctx.
+func NewUserParentContext(ctx context.Context, parent thread.PubKey) context.Context {
+	return context.WithValue(ctx, usageCtxKey("userParent"), parent)
+}
+
+// UserParentFromContext returns the user parent key from ctx.
+// It's the default ParentResolver.
+func UserParentFromContext(ctx context.Context) (thread.PubKey, bool) {
+	parent, ok := ctx.Value(usageCtxKey("userParent")).(thread.PubKey)
+	return parent, ok
+}
+
+// userParentKey returns the key of the account that the user in ctx belongs to.
+// The API key owner is used if present. Otherwise, the parent is resolved with
+// the configured resolver if users without an API key are allowed.
+func (t *Textile) userParentKey(ctx context.Context) (thread.PubKey, bool) {
+	if key, ok := mdb.APIKeyFromContext(ctx); ok {
+		return key.Owner, true
+	}
+	if !t.conf.AllowUsersWithoutAPIKey {
+		return nil, false
+	}
+	resolve := t.conf.UserParentResolver
+	if resolve == nil {
+		resolve = UserParentFromContext
+	}
+	parent, ok := resolve(ctx)
+	if !ok || parent == nil {
+		return nil, false
+	}
+	return parent, true
+}
+
+// userParent returns the parent account for a new user customer along with the email used for
+// its billing customer. A nil account means the user is created as a standalone customer.
+// If the parent account doesn't exist, the configured fallback is applied.
+func (t *Textile) userParent(ctx context.Context, user, parentKey thread.PubKey) (*mdb.Account, string, error) {
+	parent, err := t.users.Get(ctx, parentKey)
+	if err == nil {
+		email, err := t.getAccountCtxEmail(ctx, mdb.AccountCtxForAccount(parent))
+		if err != nil {
+			return nil, "", err
+		}
+		return parent, email, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, "", err
+	}
+	switch t.orphans {
+	case userParentFallbackPlaceholder:
+		log.Warnf("parent for %s not found, using placeholder: %s", user, parentKey)
+		return &mdb.Account{Type: mdb.Org, Key: parentKey}, "", nil
+	case userParentFallbackDefault:
+		if t.conf.UserParentFallbackKey == nil {
+			return nil, "", fmt.Errorf("parent for %s not found and no fallback parent is set: %s", user, parentKey)
+		}
+		log.Warnf("parent for %s not found, using fallback parent: %s", user, parentKey)
+		return t.userParent(ctx, user, t.conf.UserParentFallbackKey)
+	case userParentFallbackStandalone:
+		log.Warnf("parent for %s not found, creating standalone customer: %s", user, parentKey)
+		return nil, "", nil
+	default:
+		return nil, "", fmt.Errorf("parent for %s not found: %s", user, parentKey)
+	}
+}
@@ -0,0 +1,141 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+func TestUserParentKey_APIKey(t *testing.T) {
+	tx := &Textile{}
+	parent := newTestDev(t)
+	ctx := mdb.NewAPIKeyContext(context.Background(), &mdb.APIKey{Owner: parent.Key, Type: mdb.UserKey})
+	key, ok := tx.userParentKey(ctx)
+	require.True(t, ok)
+	assert.True(t, parent.Key.Equals(key))
+
+	// Users without an API key are not allowed by default.
+	_, ok = tx.userParentKey(NewUserParentContext(context.Background(), parent.Key))
+	assert.False(t, ok)
+}
+
+func TestUserParentKey_Alternate(t *testing.T) {
+	tx := &Textile{conf: Config{AllowUsersWithoutAPIKey: true}}
+	parent := newTestDev(t)
+
+	// The default resolver uses the parent in the request context.
+	key, ok := tx.userParentKey(NewUserParentContext(context.Background(), parent.Key))
+	require.True(t, ok)
+	assert.True(t, parent.Key.Equals(key))
+	_, ok = tx.userParentKey(context.Background())
+	assert.False(t, ok)
+
+	// A custom resolver replaces the default.
+	other := newTestDev(t)
+	tx.conf.UserParentResolver = func(context.Context) (thread.PubKey, bool) {
+		return other.Key, true
+	}
+	key, ok = tx.userParentKey(NewUserParentContext(context.Background(), parent.Key))
+	require.True(t, ok)
+	assert.True(t, other.Key.Equals(key))
+
+	// The API key owner takes precedence.
+	ctx := mdb.NewAPIKeyContext(context.Background(), &mdb.APIKey{Owner: parent.Key, Type: mdb.UserKey})
+	key, ok = tx.userParentKey(ctx)
+	require.True(t, ok)
+	assert.True(t, parent.Key.Equals(key))
+}
+
+func TestCheckAccess_UserWithoutAPIKey(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc}
+
+	user := newTestDev(t)
+	user.Type = mdb.User
+	parent := newTestDev(t)
+	ctx := NewUserParentContext(newTestAccountContext(user), parent.Key)
+	res, err := tx.CheckAccess(ctx, pushPathMethod)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, string(denialPermission), res.Reason)
+
+	tx.conf.AllowUsersWithoutAPIKey = true
+	res, err = tx.CheckAccess(ctx, pushPathMethod)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestUserParent_Fallback(t *testing.T) {
+	users := newTestUserStore()
+	tx := &Textile{users: users}
+	user := newTestDev(t)
+	user.Type = mdb.User
+	existing := newTestDev(t)
+	users.users[existing.Key.String()] = existing
+	missing := newTestDev(t)
+
+	// Existing parents are used regardless of the fallback.
+	for _, mode := range []string{"none", "placeholder", "default", "standalone"} {
+		var err error
+		tx.orphans, err = parseUserParentFallback(mode)
+		require.NoError(t, err)
+		parent, email, err := tx.userParent(context.Background(), user.Key, existing.Key)
+		require.NoError(t, err)
+		assert.True(t, existing.Key.Equals(parent.Key))
+		assert.Equal(t, existing.Email, email)
+	}
+
+	// Customer creation fails by default.
+	tx.orphans = userParentFallbackNone
+	_, _, err := tx.userParent(context.Background(), user.Key, missing.Key)
+	require.Error(t, err)
+
+	// A placeholder parent is created for the missing parent.
+	tx.orphans = userParentFallbackPlaceholder
+	parent, email, err := tx.userParent(context.Background(), user.Key, missing.Key)
+	require.NoError(t, err)
+	assert.True(t, missing.Key.Equals(parent.Key))
+	assert.Equal(t, mdb.Org, parent.Type)
+	assert.Empty(t, email)
+
+	// The user is attached to the default parent, which must be set.
+	tx.orphans = userParentFallbackDefault
+	_, _, err = tx.userParent(context.Background(), user.Key, missing.Key)
+	require.Error(t, err)
+	tx.conf.UserParentFallbackKey = existing.Key
+	parent, email, err = tx.userParent(context.Background(), user.Key, missing.Key)
+	require.NoError(t, err)
+	assert.True(t, existing.Key.Equals(parent.Key))
+	assert.Equal(t, existing.Email, email)
+
+	// The user is created without a parent.
+	tx.orphans = userParentFallbackStandalone
+	parent, _, err = tx.userParent(context.Background(), user.Key, missing.Key)
+	require.NoError(t, err)
+	assert.Nil(t, parent)
+}
+
+func TestPreUsageFunc_MissingUserParent(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, users: newTestUserStore()}
+	user := newTestDev(t)
+	user.Type = mdb.User
+	missing := newTestDev(t)
+	ctx := mdb.NewAPIKeyContext(newTestAccountContext(user), &mdb.APIKey{Owner: missing.Key, Type: mdb.UserKey})
+
+	_, err := tx.preUsageFunc(ctx, pushPathMethod)
+	require.Error(t, err)
+	_, err = bc.GetCustomer(context.Background(), user.Key)
+	require.Error(t, err)
+
+	tx.orphans = userParentFallbackStandalone
+	_, err = tx.preUsageFunc(ctx, pushPathMethod)
+	require.NoError(t, err)
+	cus, err := bc.GetCustomer(context.Background(), user.Key)
+	require.NoError(t, err)
+	assert.Equal(t, int32(mdb.User), cus.AccountType)
+}
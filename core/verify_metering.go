@@ -0,0 +1,67 @@
+package core
+
+// verifyMethod is threaddb's Verify, a cheap integrity check that's metered as a read by default.
+const verifyMethod = "/threads.pb.API/Verify"
+
+// readMeteringFree is the Config.VerifyMetering and Config.HasMetering mode that doesn't meter requests.
+const readMeteringFree = "free"
+
+// readMeteringKey returns the usage key that a cheap read metered with mode is metered against,
+// or an empty string if it's free.
+func readMeteringKey(mode string) string {
+	switch mode {
+	case "":
+		return "instance_reads"
+	case readMeteringFree:
+		return ""
+	default:
+		return mode
+	}
+}
+
+// verifyUsageKey returns the usage key that Verify is metered against, or an empty string if it's free.
+func (t *Textile) verifyUsageKey() string {
+	return readMeteringKey(t.conf.VerifyMetering)
+}
+
+// methodPolicy returns the usage policy for method, adjusted for the Verify and Has metering modes.
+// Free requests aren't checked. Requests metered against a key other than instance_reads
+// are checked against that key if it can be used as a quota, and otherwise only metered.
+func (t *Textile) methodPolicy(method string) (MethodPolicy, bool) {
+	policy, ok := t.usagePolicy()[method]
+	var mode string
+	switch method {
+	case verifyMethod:
+		mode = t.conf.VerifyMetering
+	case hasMethod:
+		mode = t.conf.HasMetering
+	}
+	if mode == "" || mode == "instance_reads" {
+		return policy, ok
+	}
+	key := readMeteringKey(mode)
+	if _, known := quotaKeys[key]; !known {
+		return MethodPolicy{}, false
+	}
+	return MethodPolicy{Quota: key, Burst: policy.Burst}, true
+}
+
+// threadsUsage returns the usage recorded for a request's threaddb reads and writes.
+func (t *Textile) threadsUsage(rs *requestStats) map[string]int64 {
+	reads := rs.reads
+	if !t.conf.FreeWriteTransactionReads {
+		reads += rs.writeReads
+	}
+	usage := map[string]int64{
+		"network_egress":  rs.egress,
+		"instance_reads":  reads,
+		"instance_writes": rs.writes,
+	}
+	if key := t.verifyUsageKey(); key != "" && rs.verifies > 0 {
+		usage[key] += rs.verifies
+	}
+	if key := t.hasUsageKey(); key != "" && rs.has > 0 {
+		usage[key] += rs.has
+	}
+	return usage
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_VerifyMetering(t *testing.T) {
+	tests := []struct {
+		name     string
+		metering string
+		code     codes.Code
+	}{
+		{name: "default", metering: "", code: codes.ResourceExhausted},
+		{name: "instance_reads", metering: "instance_reads", code: codes.ResourceExhausted},
+		{name: "free", metering: readMeteringFree, code: codes.OK},
+		{name: "unchecked key", metering: "instance_verifies", code: codes.OK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, conf: Config{VerifyMetering: tc.metering}}
+			dev := newTestDev(t)
+			cus := newTestCustomer(dev.Key)
+			cus.DailyUsage["instance_reads"].Free = 0
+			bc.setCustomer(cus)
+
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), verifyMethod)
+			assert.Equal(t, tc.code, status.Code(err))
+
+			// Other reads are still checked.
+			_, err = tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+			require.Error(t, err)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+	}
+}
+
+func TestThreadsUsage_VerifyMetering(t *testing.T) {
+	rs := &requestStats{reads: 2, writes: 1, verifies: 3}
+
+	tx := &Textile{}
+	assert.Equal(t, map[string]int64{
+		"network_egress":  0,
+		"instance_reads":  5,
+		"instance_writes": 1,
+	}, tx.threadsUsage(rs))
+
+	tx.conf.VerifyMetering = readMeteringFree
+	assert.Equal(t, map[string]int64{
+		"network_egress":  0,
+		"instance_reads":  2,
+		"instance_writes": 1,
+	}, tx.threadsUsage(rs))
+
+	tx.conf.VerifyMetering = "instance_verifies"
+	assert.Equal(t, map[string]int64{
+		"network_egress":    0,
+		"instance_reads":    2,
+		"instance_writes":   1,
+		"instance_verifies": 3,
+	}, tx.threadsUsage(rs))
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	tpb "github.com/textileio/go-threads/api/pb"
+	"google.golang.org/grpc/stats"
+)
+
+func TestStatsHandler_WriteTransactionReads(t *testing.T) {
+	tests := []struct {
+		name  string
+		free  bool
+		reads int64
+	}{
+		{name: "metered", reads: 3},
+		{name: "free", free: true, reads: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := &Textile{bc: newTestBillingClient(), conf: Config{FreeWriteTransactionReads: tc.free}}
+			h := &StatsHandler{t: tx}
+			dev := newTestDev(t)
+			rs := &requestStats{key: dev.Key, skipEgress: true}
+			ctx := context.WithValue(context.Background(), statsCtxKey("requestStats"), rs)
+
+			// A WriteTransaction that finds two instances and saves one.
+			h.HandleRPC(ctx, &stats.OutPayload{Payload: &tpb.WriteTransactionReply{
+				Option: &tpb.WriteTransactionReply_FindReply{FindReply: &tpb.FindReply{Instances: [][]byte{{}, {}}}},
+			}})
+			h.HandleRPC(ctx, &stats.OutPayload{Payload: &tpb.WriteTransactionReply{
+				Option: &tpb.WriteTransactionReply_SaveReply{SaveReply: &tpb.SaveReply{}},
+			}})
+			// Reads made outside of a WriteTransaction are always metered.
+			h.HandleRPC(ctx, &stats.OutPayload{Payload: &tpb.ReadTransactionReply{
+				Option: &tpb.ReadTransactionReply_FindByIDReply{FindByIDReply: &tpb.FindByIDReply{}},
+			}})
+
+			usage := tx.threadsUsage(rs)
+			assert.Equal(t, tc.reads, usage["instance_reads"])
+			assert.Equal(t, int64(1), usage["instance_writes"])
+		})
+	}
+}
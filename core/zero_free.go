@@ -0,0 +1,14 @@
+package core
+
+import "github.com/textileio/textile/v2/api/billingd/pb"
+
+// freeUnlimited returns whether or not a customer's free allowance of key is unlimited,
+// i.e., key is configured to treat a zero Free allowance as unlimited and the customer's is zero.
+func (t *Textile) freeUnlimited(cus *pb.GetCustomerResponse, key string) bool {
+	for _, k := range t.conf.ZeroFreeUnlimited {
+		if k == key {
+			return cus.DailyUsage[key].GetFree() == 0
+		}
+	}
+	return false
+}
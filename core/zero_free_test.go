@@ -0,0 +1,73 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreUsageFunc_ZeroFree(t *testing.T) {
+	tests := []struct {
+		name      string
+		unlimited []string
+	}{
+		{name: "blocked"},
+		{name: "unlimited", unlimited: []string{"instance_reads", "stored_data"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bc := newTestBillingClient()
+			tx := &Textile{bc: bc, inflight: newInflightEgress(), conf: Config{ZeroFreeUnlimited: tc.unlimited}}
+			dev := newTestDev(t)
+			cus := newTestCustomer(dev.Key)
+			cus.DailyUsage["instance_reads"].Free = 0
+			cus.DailyUsage["stored_data"].Free = 0
+			bc.setCustomer(cus)
+
+			_, err := tx.preUsageFunc(newTestAccountContext(dev), findMethod)
+			ctx, err2 := tx.preUsageFunc(newTestAccountContext(dev), pushPathMethod)
+			require.NoError(t, err2)
+			owner, ok := buckets.BucketOwnerFromContext(ctx)
+			require.True(t, ok)
+			if tc.unlimited == nil {
+				require.Error(t, err)
+				assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+				assert.Equal(t, int64(0), owner.StorageAvailable)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(math.MaxInt64), owner.StorageAvailable)
+			}
+
+			// Keys that aren't configured are unaffected.
+			_, err = tx.preUsageFunc(newTestAccountContext(dev), "/threads.pb.API/Save")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPreUsageFunc_ZeroFreeEgress(t *testing.T) {
+	bc := newTestBillingClient()
+	tx := &Textile{bc: bc, inflight: newInflightEgress()}
+	dev := newTestDev(t)
+	cus := newTestCustomer(dev.Key)
+	cus.DailyUsage["network_egress"].Free = 0
+	bc.setCustomer(cus)
+
+	// By default, a zero free egress allowance is exhausted.
+	_, err := tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// When configured, it's unlimited and requests aren't given an egress allowance.
+	tx.conf.ZeroFreeUnlimited = []string{"network_egress"}
+	_, err = tx.preUsageFunc(newTestAccountContext(dev), pullPathMethod)
+	require.NoError(t, err)
+	limit, ok := tx.egressLimit(cus, tx.now())
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), limit)
+}
@@ -14,12 +14,14 @@ var log = logging.Logger("email")
 type Client struct {
 	inviteTmpl  string
 	confirmTmpl string
+	softCapTmpl string
 	client      *cio.APIClient
 }
 
 type Config struct {
 	ConfirmTmpl string
 	InviteTmpl  string
+	SoftCapTmpl string
 	APIKey      string
 	Debug       bool
 }
@@ -40,6 +42,7 @@ func NewClient(conf Config) (*Client, error) {
 	return &Client{
 		inviteTmpl:  conf.InviteTmpl,
 		confirmTmpl: conf.ConfirmTmpl,
+		softCapTmpl: conf.SoftCapTmpl,
 		client:      client,
 	}, nil
 }
@@ -94,3 +97,28 @@ func (c *Client) InviteAddress(ctx context.Context, id, org, from, to, url, toke
 	log.Debug("sent invite to %s from %s to %s", org, from, to)
 	return nil
 }
+
+// StorageSoftCap prompts an owner whose stored data crossed a soft cap to upgrade.
+func (c *Client) StorageSoftCap(ctx context.Context, id, username, email string, used, softCap int64) error {
+	if c.client == nil || c.softCapTmpl == "" {
+		return nil
+	}
+	request := cio.SendEmailRequest{
+		To:                     email,
+		TransactionalMessageID: c.softCapTmpl,
+		Identifiers: map[string]string{
+			"id": id,
+		},
+		MessageData: map[string]interface{}{
+			"username": username,
+			"used":     used,
+			"soft_cap": softCap,
+		},
+	}
+	if _, err := c.client.SendEmail(ctx, &request); err != nil {
+		return err
+	}
+
+	log.Debugf("sent storage soft cap notice for %s to %s", username, email)
+	return nil
+}
@@ -39,6 +39,7 @@ type Account struct {
 	Token     thread.Token
 	Members   []Member
 	PowInfo   *PowInfo
+	Metadata  map[string]string
 	CreatedAt time.Time
 }
 
@@ -360,6 +361,22 @@ func (a *Accounts) SetToken(ctx context.Context, key thread.PubKey, token thread
 	return nil
 }
 
+// SetMetadata replaces the metadata of an account, e.g., a cost center or plan name.
+func (a *Accounts) SetMetadata(ctx context.Context, key thread.PubKey, metadata map[string]string) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"metadata": metadata}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (a *Accounts) UpdatePowInfo(ctx context.Context, key thread.PubKey, powInfo *PowInfo) (*Account, error) {
 	id, err := key.MarshalBinary()
 	if err != nil {
@@ -638,6 +655,14 @@ func decodeAccount(raw bson.M) (*Account, error) {
 			}
 		}
 	}
+	var metadata map[string]string
+	// Metadata is null after it's cleared with SetMetadata.
+	if rmeta, ok := raw["metadata"].(bson.M); ok {
+		metadata = make(map[string]string, len(rmeta))
+		for k, m := range rmeta {
+			metadata[k] = m.(string)
+		}
+	}
 	var created time.Time
 	if v, ok := raw["created_at"]; ok {
 		created = v.(primitive.DateTime).Time()
@@ -652,6 +677,7 @@ func decodeAccount(raw bson.M) (*Account, error) {
 		Token:     token,
 		Members:   mems,
 		PowInfo:   decodePowInfo(raw),
+		Metadata:  metadata,
 		CreatedAt: created,
 	}, nil
 }
@@ -235,6 +235,30 @@ func TestAccounts_SetToken(t *testing.T) {
 	assert.NotEmpty(t, got.Token)
 }
 
+func TestAccounts_SetMetadata(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	assert.Empty(t, created.Metadata)
+
+	err = col.SetMetadata(context.Background(), created.Key, map[string]string{"cost_center": "eng"})
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cost_center": "eng"}, got.Metadata)
+
+	// Cleared metadata is stored as null.
+	err = col.SetMetadata(context.Background(), created.Key, nil)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.Empty(t, got.Metadata)
+}
+
 func TestAccounts_UpdatePowInfo(t *testing.T) {
 	db := newDB(t)
 	col, err := NewAccounts(context.Background(), db)
@@ -24,11 +24,12 @@ type Collections struct {
 	Accounts *Accounts
 	Invites  *Invites
 
-	Threads         *Threads
-	APIKeys         *APIKeys
-	IPNSKeys        *IPNSKeys
-	BucketArchives  *BucketArchives
-	ArchiveTracking *ArchiveTracking
+	Threads          *Threads
+	APIKeys          *APIKeys
+	UsageDeadLetters *UsageDeadLetters
+	IPNSKeys         *IPNSKeys
+	BucketArchives   *BucketArchives
+	ArchiveTracking  *ArchiveTracking
 }
 
 // NewCollections gets or create store instances for active collections.
@@ -63,6 +64,10 @@ func NewCollections(ctx context.Context, uri, database string, hub bool) (*Colle
 		if err != nil {
 			return nil, err
 		}
+		c.UsageDeadLetters, err = NewUsageDeadLetters(ctx, db)
+		if err != nil {
+			return nil, err
+		}
 	}
 	c.ArchiveTracking, err = NewArchiveTracking(ctx, db)
 	if err != nil {
@@ -105,6 +105,18 @@ func (t *Threads) Get(ctx context.Context, id thread.ID, owner thread.PubKey) (*
 	return decodeThread(raw)
 }
 
+func (t *Threads) GetByID(ctx context.Context, id thread.ID) (*Thread, error) {
+	res := t.col.FindOne(ctx, bson.M{"_id.thread": id.Bytes()})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeThread(raw)
+}
+
 func (t *Threads) GetByName(ctx context.Context, name string, owner thread.PubKey) (*Thread, error) {
 	ownerID, err := owner.MarshalBinary()
 	if err != nil {
@@ -55,6 +55,26 @@ func TestThreads_Get(t *testing.T) {
 	assert.True(t, created.IsDB)
 }
 
+func TestThreads_GetByID(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewThreads(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(ctx, thread.NewIDV1(thread.Raw, 32), thread.NewLibp2pPubKey(owner), false)
+	require.NoError(t, err)
+
+	got, err := col.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Owner, got.Owner)
+	assert.Equal(t, created.ID, got.ID)
+
+	_, err = col.GetByID(ctx, thread.NewIDV1(thread.Raw, 32))
+	require.Error(t, err)
+}
+
 func TestThreads_GetByName(t *testing.T) {
 	db := newDB(t)
 	ctx := context.Background()
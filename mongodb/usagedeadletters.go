@@ -0,0 +1,163 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UsageDeadLetter is a usage increment that could not be sent to billingd.
+// Its ID is the idempotency key used when the increment is replayed.
+type UsageDeadLetter struct {
+	ID            string
+	Key           thread.PubKey
+	Usage         map[string]int64
+	Attempts      int
+	LastError     string
+	CreatedAt     time.Time
+	NextAttemptAt time.Time
+}
+
+type UsageDeadLetters struct {
+	col *mongo.Collection
+}
+
+func NewUsageDeadLetters(ctx context.Context, db *mongo.Database) (*UsageDeadLetters, error) {
+	d := &UsageDeadLetters{col: db.Collection("usagedeadletters")}
+	_, err := d.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{primitive.E{Key: "next_attempt_at", Value: 1}},
+		},
+	})
+	return d, err
+}
+
+// Create adds a dead letter for usage that failed to send with lastErr.
+// The dead letter is due for replay immediately.
+func (d *UsageDeadLetters) Create(
+	ctx context.Context,
+	id string,
+	key thread.PubKey,
+	usage map[string]int64,
+	lastErr string,
+) (*UsageDeadLetter, error) {
+	now := time.Now()
+	doc := &UsageDeadLetter{
+		ID:            id,
+		Key:           key,
+		Usage:         usage,
+		LastError:     lastErr,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	keyID, err := key.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.col.InsertOne(ctx, bson.M{
+		"_id":             doc.ID,
+		"key_id":          keyID,
+		"usage":           doc.Usage,
+		"attempts":        doc.Attempts,
+		"last_error":      doc.LastError,
+		"created_at":      doc.CreatedAt,
+		"next_attempt_at": doc.NextAttemptAt,
+	}); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ListDue returns up to limit dead letters that are due for replay as of now, oldest first.
+func (d *UsageDeadLetters) ListDue(ctx context.Context, now time.Time, limit int64) ([]UsageDeadLetter, error) {
+	opts := options.Find().SetSort(bson.D{primitive.E{Key: "next_attempt_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cursor, err := d.col.Find(ctx, bson.M{"next_attempt_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []UsageDeadLetter
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeUsageDeadLetter(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Reschedule records a failed replay attempt and sets when the dead letter is next due.
+func (d *UsageDeadLetters) Reschedule(ctx context.Context, id string, next time.Time, lastErr string) error {
+	res, err := d.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$inc": bson.M{"attempts": 1},
+		"$set": bson.M{"next_attempt_at": next, "last_error": lastErr},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (d *UsageDeadLetters) Delete(ctx context.Context, id string) error {
+	res, err := d.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func decodeUsageDeadLetter(raw bson.M) (*UsageDeadLetter, error) {
+	key := &thread.Libp2pPubKey{}
+	err := key.UnmarshalBinary(raw["key_id"].(primitive.Binary).Data)
+	if err != nil {
+		return nil, err
+	}
+	usage := make(map[string]int64)
+	if v, ok := raw["usage"].(bson.M); ok {
+		for k, n := range v {
+			usage[k] = n.(int64)
+		}
+	}
+	var lastErr string
+	if v, ok := raw["last_error"]; ok {
+		lastErr = v.(string)
+	}
+	var created, next time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["next_attempt_at"]; ok {
+		next = v.(primitive.DateTime).Time()
+	}
+	return &UsageDeadLetter{
+		ID:            raw["_id"].(string),
+		Key:           key,
+		Usage:         usage,
+		Attempts:      int(raw["attempts"].(int32)),
+		LastError:     lastErr,
+		CreatedAt:     created,
+		NextAttemptAt: next,
+	}, nil
+}
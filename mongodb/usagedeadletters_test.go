@@ -0,0 +1,82 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestUsageDeadLetters_Create(t *testing.T) {
+	db := newDB(t)
+	col, err := NewUsageDeadLetters(context.Background(), db)
+	require.NoError(t, err)
+
+	_, key, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), "id1", thread.NewLibp2pPubKey(key), map[string]int64{
+		"network_egress": 1024,
+	}, "unavailable")
+	require.NoError(t, err)
+	assert.Equal(t, "id1", created.ID)
+	assert.Equal(t, 0, created.Attempts)
+
+	_, err = col.Create(context.Background(), "id1", thread.NewLibp2pPubKey(key), nil, "unavailable")
+	require.Error(t, err)
+}
+
+func TestUsageDeadLetters_ListDue(t *testing.T) {
+	db := newDB(t)
+	col, err := NewUsageDeadLetters(context.Background(), db)
+	require.NoError(t, err)
+
+	_, key, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), "id1", thread.NewLibp2pPubKey(key), map[string]int64{
+		"network_egress": 1024,
+	}, "unavailable")
+	require.NoError(t, err)
+
+	list, err := col.ListDue(context.Background(), time.Now(), 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, created.ID, list[0].ID)
+	assert.True(t, created.Key.Equals(list[0].Key))
+	assert.Equal(t, int64(1024), list[0].Usage["network_egress"])
+
+	err = col.Reschedule(context.Background(), created.ID, time.Now().Add(time.Hour), "still unavailable")
+	require.NoError(t, err)
+	list, err = col.ListDue(context.Background(), time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	list, err = col.ListDue(context.Background(), time.Now().Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, 1, list[0].Attempts)
+	assert.Equal(t, "still unavailable", list[0].LastError)
+}
+
+func TestUsageDeadLetters_Delete(t *testing.T) {
+	db := newDB(t)
+	col, err := NewUsageDeadLetters(context.Background(), db)
+	require.NoError(t, err)
+
+	_, key, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), "id1", thread.NewLibp2pPubKey(key), map[string]int64{
+		"network_egress": 1024,
+	}, "unavailable")
+	require.NoError(t, err)
+
+	err = col.Delete(context.Background(), created.ID)
+	require.NoError(t, err)
+	err = col.Delete(context.Background(), created.ID)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}